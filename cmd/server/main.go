@@ -1,16 +1,21 @@
 // Package main is the entry point for the service. It wires all dependencies
-// using samber/do v2, starts the HTTP server, and handles graceful shutdown
-// on SIGINT/SIGTERM.
+// using samber/do v2, starts the HTTP server, handles graceful shutdown on
+// SIGINT/SIGTERM, and reloads hot-swappable config on SIGHUP.
 package main
 
 import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	nethttp "net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -22,7 +27,10 @@ import (
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/clients/acl"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/app"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/config"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/flags"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/health"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/httpclient"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/logging"
@@ -33,9 +41,14 @@ import (
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
+const otelShutdownTimeout = 5 * time.Second
+
+// DI names for the admin listener's handler and server, registered
+// alongside the unnamed public API handler and server. do names must be
+// unique across types.
 const (
-	serverShutdownTimeout = 15 * time.Second
-	otelShutdownTimeout   = 5 * time.Second
+	adminHandlerName = "admin-handler"
+	adminServerName  = "admin-server"
 )
 
 func main() {
@@ -52,12 +65,23 @@ func run() error {
 	}
 
 	// Bootstrap: config, logger, telemetry.
-	cfg, err := config.Load(profile)
+	loadConfig := func() (*config.Config, error) {
+		return config.Load(profile, configSourceOptions()...)
+	}
+	cfg, err := loadConfig()
 	if err != nil {
 		return fmt.Errorf("loading config: %w", err)
 	}
 
-	logger := logging.New(cfg.Log.Level, cfg.Log.Format, os.Stderr)
+	if err := todo.RegisterExtraCategories(cfg.Todo.ExtraCategories...); err != nil {
+		return fmt.Errorf("registering todo.extra_categories: %w", err)
+	}
+	if err := validateDefaultSorts(cfg); err != nil {
+		return err
+	}
+
+	logger, logLevel := logging.NewWithLevel(cfg.Log.Level, cfg.Log.Format, os.Stderr,
+		logging.WithRedactFields(cfg.Log.RedactFields...))
 
 	ctx := context.Background()
 	otel, err := initTelemetry(ctx, cfg)
@@ -70,48 +94,80 @@ func run() error {
 
 	do.ProvideValue(injector, cfg)
 	do.ProvideValue(injector, logger)
+	do.ProvideValue(injector, logLevel)
 	do.ProvideValue(injector, otel.metrics)
 
 	registerDependencies(injector, cfg, logger)
 
-	// Resolve the server (eagerly wires the full graph).
-	server, err := do.Invoke[*adapthttp.Server](injector)
+	// Resolve the servers (eagerly wires the full graph).
+	server, err := resolveServer(injector, logger)
+	if err != nil {
+		return err
+	}
+	adminServer, err := do.InvokeNamed[*adapthttp.Server](injector, adminServerName)
 	if err != nil {
-		return fmt.Errorf("resolving server: %w", err)
+		return fmt.Errorf("resolving admin server: %w", err)
 	}
 
-	// Register health checkers after the graph is wired.
-	registry := do.MustInvoke[ports.HealthRegistry](injector)
-	httpClient := do.MustInvoke[*httpclient.Client](injector)
-	registry.Register(httpClient)
+	if cfg.Startup.ProbeDownstream {
+		client := do.MustInvoke[*httpclient.Client](injector)
+		if err := probeDownstream(ctx, client, cfg.Startup.ProbeTimeout, logger); err != nil {
+			return err
+		}
+	}
 
-	// Start server in background.
-	serverErr := make(chan error, 1)
-	go func() {
-		serverErr <- server.Start()
-	}()
+	// The config checker follows SIGHUP reloads, so it is registered here
+	// where the active config is tracked.
+	registry := do.MustInvoke[ports.HealthRegistry](injector)
+	var activeCfg atomic.Pointer[config.Config]
+	activeCfg.Store(cfg)
+	registry.Register(config.NewHealthChecker(activeCfg.Load, profile))
+
+	// Start the API and admin servers in background.
+	servers := []*adapthttp.Server{server, adminServer}
+	serverErr := make(chan error, len(servers))
+	for _, s := range servers {
+		go func() {
+			serverErr <- s.Start()
+		}()
+	}
 
-	// Wait for shutdown signal or server error.
-	quit := make(chan os.Signal, 1)
+	// Wait for shutdown signal or server error. The channel is buffered for
+	// two so a second signal during the drain is not dropped.
+	quit := make(chan os.Signal, 2)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
-	select {
-	case sig := <-quit:
-		logger.Info("received shutdown signal", slog.String("signal", sig.String()))
-	case err := <-serverErr:
-		return fmt.Errorf("server failed: %w", err)
+	// SIGHUP reloads the config and applies the hot-reloadable fields.
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	active := cfg
+wait:
+	for {
+		select {
+		case <-hup:
+			logger.Info("received SIGHUP, reloading config")
+			active, _ = reloadConfig(active, loadConfig, logLevel, logger)
+			activeCfg.Store(active)
+		case sig := <-quit:
+			logger.Info("received shutdown signal", slog.String("signal", sig.String()))
+			break wait
+		case err := <-serverErr:
+			return fmt.Errorf("server failed: %w", err)
+		}
 	}
 
-	// Graceful shutdown: drain HTTP requests.
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
-	defer cancel()
-
-	if err := server.Shutdown(shutdownCtx); err != nil {
+	// Graceful shutdown: drain both servers under one deadline, or exit at
+	// once on a second signal.
+	group := serverGroup{server, adminServer}
+	if err := drainServer(group, cfg.Server.ShutdownTimeout, quit, forceExit, logger); err != nil {
 		logger.Error("server shutdown error", slog.Any("error", err))
 	}
 
-	// Wait for Start() goroutine to return.
-	<-serverErr
+	// Wait for every Start() goroutine to return.
+	for range servers {
+		<-serverErr
+	}
 
 	// Flush telemetry.
 	otelCtx, otelCancel := context.WithTimeout(context.Background(), otelShutdownTimeout)
@@ -125,6 +181,196 @@ func run() error {
 	return nil
 }
 
+// hotReloadable lists the config keys a SIGHUP reload applies to the running
+// process. Changes to any other key are logged and take effect on restart.
+var hotReloadable = map[string]bool{
+	"log.level": true,
+}
+
+// reloadConfig loads a fresh config and applies its hot-reloadable changes
+// relative to current, returning the config now in effect: current with
+// those fields updated. If the reloaded config fails to load or validate,
+// nothing is applied and current is returned with the error.
+func reloadConfig(current *config.Config, load func() (*config.Config, error), logLevel *slog.LevelVar, logger *slog.Logger) (*config.Config, error) {
+	next, err := load()
+	if err != nil {
+		logger.Error("config reload rejected, keeping current config", slog.Any("error", err))
+		return current, err
+	}
+
+	applied := *current
+	var pending []string
+	for _, key := range config.ChangedKeys(current, next) {
+		if !hotReloadable[key] {
+			pending = append(pending, key)
+			continue
+		}
+		switch key {
+		case "log.level":
+			if err := logging.SetLevel(logLevel, next.Log.Level); err != nil {
+				logger.Error("config reload: applying log.level failed", slog.Any("error", err))
+				continue
+			}
+			applied.Log.Level = next.Log.Level
+		}
+		logger.Info("config reload applied change", slog.String("key", key))
+	}
+
+	if len(pending) > 0 {
+		logger.Warn("config reload ignored changes that require a restart",
+			slog.Any("keys", pending),
+		)
+	}
+	return &applied, nil
+}
+
+// validateDefaultSorts checks todo.default_sort and project.default_sort
+// against the domain's sortable fields, which the config package cannot see.
+func validateDefaultSorts(cfg *config.Config) error {
+	if err := (todo.Filter{Sort: cfg.Todo.DefaultSort}).Validate(); err != nil {
+		return fmt.Errorf("validating todo.default_sort: %w", err)
+	}
+	if err := (project.Filter{Sort: cfg.Project.DefaultSort}).Validate(); err != nil {
+		return fmt.Errorf("validating project.default_sort: %w", err)
+	}
+	return nil
+}
+
+// newAuditLogger returns the logger for audit records, writing to
+// audit.output: "stderr", "stdout", or a file opened for appending. Records
+// are always JSON and tagged log_type=audit, so they can be told apart from
+// the application log on a shared stream. An empty output returns a nil
+// logger, which disables auditing.
+func newAuditLogger(cfg config.AuditConfig) (*slog.Logger, error) {
+	var w io.Writer
+	switch cfg.Output {
+	case "":
+		return nil, nil
+	case "stderr":
+		w = os.Stderr
+	case "stdout":
+		w = os.Stdout
+	default:
+		f, err := os.OpenFile(filepath.Clean(cfg.Output), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("opening audit.output: %w", err)
+		}
+		w = f
+	}
+	return logging.New("info", "json", w).With(slog.String("log_type", "audit")), nil
+}
+
+// probeDownstream pings the downstream once, bounded by timeout, so a
+// deploy that cannot reach it fails before serving.
+func probeDownstream(ctx context.Context, pinger ports.Pinger, timeout time.Duration, logger *slog.Logger) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := pinger.Ping(ctx); err != nil {
+		return fmt.Errorf("startup probe: downstream unreachable: %w", err)
+	}
+	logger.Info("startup probe: downstream reachable")
+	return nil
+}
+
+// configSourceOptions selects where config.Load reads YAML from.
+// APP_CONFIG_ENV_ONLY=true skips YAML entirely; APP_CONFIG_FILE names a
+// single merged file. Without either, configs/base.yaml and the profile file
+// are used.
+func configSourceOptions() []config.Option {
+	var opts []config.Option
+	if os.Getenv("APP_CONFIG_ENV_ONLY") == "true" {
+		opts = append(opts, config.WithEnvOnly())
+	}
+	if path := os.Getenv("APP_CONFIG_FILE"); path != "" {
+		opts = append(opts, config.WithFile(path))
+	}
+	return opts
+}
+
+// resolveServer invokes the server, which wires the full dependency graph.
+// On success the provided and resolved services are logged at DEBUG; on
+// failure the error, which names the missing service and the invocation
+// chain that needed it, is logged before being returned.
+func resolveServer(injector do.Injector, logger *slog.Logger) (*adapthttp.Server, error) {
+	server, err := do.Invoke[*adapthttp.Server](injector)
+	if err != nil {
+		logger.Error("dependency resolution failed",
+			slog.String("service", do.NameOf[*adapthttp.Server]()),
+			slog.Any("error", err),
+		)
+		return nil, fmt.Errorf("resolving server: %w", err)
+	}
+
+	logger.Debug("dependency graph resolved",
+		slog.Any("provided", serviceNames(injector.ListProvidedServices())),
+		slog.Any("invoked", serviceNames(injector.ListInvokedServices())),
+	)
+	return server, nil
+}
+
+// serviceNames returns the sorted service names (their type names, as
+// registered by do.Provide) from a do service listing.
+func serviceNames(services []do.ServiceDescription) []string {
+	names := make([]string, len(services))
+	for i, s := range services {
+		names[i] = s.Service
+	}
+	slices.Sort(names)
+	return names
+}
+
+// errForcedShutdown is returned by drainServer when a second signal cut the
+// drain short.
+var errForcedShutdown = errors.New("shutdown forced by second signal")
+
+// shutdowner is the part of *adapthttp.Server that drainServer needs.
+type shutdowner interface {
+	Shutdown(ctx context.Context) error
+}
+
+// serverGroup shuts several servers down concurrently, so they drain in
+// parallel under the caller's single deadline.
+type serverGroup []shutdowner
+
+// Shutdown shuts every server down and returns their joined errors.
+func (g serverGroup) Shutdown(ctx context.Context) error {
+	errs := make([]error, len(g))
+	var wg sync.WaitGroup
+	for i, s := range g {
+		wg.Go(func() {
+			errs[i] = s.Shutdown(ctx)
+		})
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// forceExit terminates the process without waiting for the drain.
+func forceExit() { os.Exit(1) }
+
+// drainServer shuts the server down, giving in-flight requests up to timeout
+// to finish. If another signal arrives on signals before the drain ends,
+// onForce is called (os.Exit in production) and errForcedShutdown returned.
+func drainServer(server shutdowner, timeout time.Duration, signals <-chan os.Signal, onForce func(), logger *slog.Logger) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- server.Shutdown(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case sig := <-signals:
+		logger.Warn("received second signal, forcing exit", slog.String("signal", sig.String()))
+		onForce()
+		return errForcedShutdown
+	}
+}
+
 // otelProviders bundles OpenTelemetry provider lifecycle. When telemetry is
 // disabled, tracer is nil and meter/metrics hold no-op implementations.
 type otelProviders struct {
@@ -165,6 +411,7 @@ func initTelemetry(ctx context.Context, cfg *config.Config) (*otelProviders, err
 		cfg.Telemetry.ServiceName,
 		cfg.Telemetry.Exporter,
 		cfg.Telemetry.Endpoint,
+		telemetry.WithExportTimeout(cfg.Telemetry.ExportTimeout),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("init tracer: %w", err)
@@ -174,6 +421,8 @@ func initTelemetry(ctx context.Context, cfg *config.Config) (*otelProviders, err
 		cfg.Telemetry.ServiceName,
 		cfg.Telemetry.Exporter,
 		cfg.Telemetry.Endpoint,
+		telemetry.WithExportTimeout(cfg.Telemetry.ExportTimeout),
+		telemetry.WithMetricInterval(cfg.Telemetry.MetricInterval),
 	)
 	if err != nil {
 		_ = tp.Shutdown(ctx)
@@ -194,24 +443,73 @@ func initTelemetry(ctx context.Context, cfg *config.Config) (*otelProviders, err
 	}, nil
 }
 
+// newJWKSKeySet returns a key set for identity.jwt.jwks_url, fetched through
+// its own instrumented client so the issuer has a separate circuit breaker
+// and never receives the downstream API's OAuth token or HMAC signature.
+func newJWKSKeySet(cfg *config.Config, metrics *telemetry.Metrics, logger *slog.Logger) *httpclient.KeySet {
+	clientCfg := cfg.Client
+	clientCfg.BaseURL = cfg.Identity.JWT.JWKSURL
+	clientCfg.OAuth = config.OAuthConfig{}
+	clientCfg.HMAC = config.HMACConfig{}
+	client := httpclient.New(&clientCfg, "jwks", metrics, logger)
+	return httpclient.NewKeySet(client, cfg.Identity.JWT.JWKSURL, cfg.Identity.JWT.JWKSCacheTTL)
+}
+
 func registerDependencies(injector *do.RootScope, cfg *config.Config, logger *slog.Logger) {
 	do.Provide(injector, func(i do.Injector) (*httpclient.Client, error) {
 		metrics := do.MustInvoke[*telemetry.Metrics](i)
-		return httpclient.New(&cfg.Client, "todo-api", metrics, logger), nil
+		return httpclient.New(&cfg.Client, "todo-api", metrics, logger,
+			httpclient.WithHealthPath(cfg.Client.HealthPath),
+		), nil
 	})
 
 	do.Provide(injector, func(i do.Injector) (ports.TodoClient, error) {
 		client := do.MustInvoke[*httpclient.Client](i)
-		return acl.NewTodoClient(client, logger), nil
+		return acl.NewTodoClient(client, logger,
+			acl.WithMaxResponseBytes(cfg.Client.MaxResponseBytes),
+			acl.WithStrictTimestamps(cfg.Client.StrictTimestamps),
+		), nil
 	})
 
 	do.Provide(injector, func(i do.Injector) (ports.ProjectService, error) {
 		todoClient := do.MustInvoke[ports.TodoClient](i)
-		return app.NewProjectService(todoClient, logger), nil
+		metrics := do.MustInvoke[*telemetry.Metrics](i)
+		audit, err := newAuditLogger(cfg.Audit)
+		if err != nil {
+			return nil, err
+		}
+		return app.NewProjectService(todoClient, logger,
+			app.WithDegradeReads(cfg.Service.DegradeReads),
+			app.WithPartialProjectReads(cfg.Service.PartialProjectReads),
+			app.WithMaxTodosPerProject(cfg.Todo.MaxPerProject),
+			app.WithDefaultProjectSort(cfg.Project.DefaultSort),
+			app.WithMetrics(metrics),
+			app.WithAuditLogger(audit),
+			app.WithOwnershipEnforcement(cfg.Project.EnforceOwnership),
+		), nil
 	})
 
-	do.Provide(injector, func(_ do.Injector) (ports.HealthRegistry, error) {
-		return health.New(), nil
+	do.Provide(injector, func(i do.Injector) (ports.TodoService, error) {
+		todoClient := do.MustInvoke[ports.TodoClient](i)
+		metrics := do.MustInvoke[*telemetry.Metrics](i)
+		return app.NewTodoService(todoClient, logger,
+			app.WithTodoMetrics(metrics),
+			app.WithDefaultTodoSort(cfg.Todo.DefaultSort),
+			app.WithTodoOwnershipEnforcement(cfg.Project.EnforceOwnership),
+		), nil
+	})
+
+	// The downstream client is checked passively (breaker state) unless
+	// client.active_health_check makes readiness ping client.health_path; an
+	// empty path keeps the check passive. Results are reused for
+	// server.health_cache_ttl.
+	do.Provide(injector, func(i do.Injector) (ports.HealthRegistry, error) {
+		registry := health.New(
+			health.WithActivePing(cfg.Client.ActiveHealthCheck && cfg.Client.HealthPath != ""),
+			health.WithCacheTTL(cfg.Server.HealthCacheTTL),
+		)
+		registry.Register(do.MustInvoke[*httpclient.Client](i))
+		return registry, nil
 	})
 
 	do.Provide(injector, func(i do.Injector) (*handlers.ProjectHandler, error) {
@@ -219,23 +517,73 @@ func registerDependencies(injector *do.RootScope, cfg *config.Config, logger *sl
 		return handlers.NewProjectHandler(svc), nil
 	})
 
+	do.Provide(injector, func(i do.Injector) (*handlers.TodoHandler, error) {
+		svc := do.MustInvoke[ports.TodoService](i)
+		return handlers.NewTodoHandler(svc), nil
+	})
+
 	do.Provide(injector, func(i do.Injector) (*handlers.HealthHandler, error) {
 		registry := do.MustInvoke[ports.HealthRegistry](i)
 		return handlers.NewHealthHandler(registry), nil
 	})
 
+	do.Provide(injector, func(i do.Injector) (*handlers.AdminHandler, error) {
+		logLevel := do.MustInvoke[*slog.LevelVar](i)
+		return handlers.NewAdminHandler(logLevel, logger, do.MustInvoke[*httpclient.Client](i)), nil
+	})
+
 	do.Provide(injector, func(i do.Injector) (nethttp.Handler, error) {
 		projH := do.MustInvoke[*handlers.ProjectHandler](i)
-		healthH := do.MustInvoke[*handlers.HealthHandler](i)
+		todoH := do.MustInvoke[*handlers.TodoHandler](i)
 		metrics := do.MustInvoke[*telemetry.Metrics](i)
 
-		return adapthttp.NewRouter(projH, healthH,
+		trustedProxies, err := middleware.ParseTrustedProxies(cfg.Server.TrustedProxies)
+		if err != nil {
+			return nil, err
+		}
+		rateLimitBypass, err := middleware.ParseTrustedProxies(cfg.Server.RateLimit.Bypass)
+		if err != nil {
+			return nil, err
+		}
+		var jwtKeys middleware.KeySet
+		if cfg.Identity.Source == middleware.IdentitySourceJWT && cfg.Identity.JWT.JWKSURL != "" {
+			jwtKeys = newJWKSKeySet(cfg, metrics, logger)
+		}
+
+		return adapthttp.NewRouter(projH, todoH,
 			middleware.Recovery(logger),
+			middleware.StartTime(),
+			middleware.PrettyJSON(cfg.Server.PrettyJSON),
 			middleware.RequestID(),
 			middleware.CorrelationID(),
+			middleware.MaxQueryLength(cfg.Server.MaxQueryLength),
+			middleware.FeatureFlags(flags.NewProvider(cfg.Flags.Defaults,
+				flags.WithHeaderOverride(cfg.Flags.HeaderOverride))),
 			middleware.AppContext(),
 			middleware.OpenTelemetry(metrics),
-			middleware.Logging(logger),
+			middleware.Logging(logger, trustedProxies...),
+			middleware.RateLimit(middleware.RateLimitConfig{
+				RequestsPerSecond: cfg.Server.RateLimit.RequestsPerSecond,
+				Burst:             cfg.Server.RateLimit.BurstSize,
+				TrustedProxies:    trustedProxies,
+				Bypass:            rateLimitBypass,
+				MaxClients:        cfg.Server.RateLimit.MaxClients,
+				IdleTTL:           cfg.Server.RateLimit.IdleTTL,
+			}),
+			middleware.Identity(middleware.IdentityConfig{
+				Source: cfg.Identity.Source,
+				Header: cfg.Identity.Header,
+				JWT: middleware.JWTConfig{
+					Keys:     jwtKeys,
+					Secret:   []byte(cfg.Identity.JWT.Secret),
+					Issuer:   cfg.Identity.JWT.Issuer,
+					Audience: cfg.Identity.JWT.Audience,
+					Claim:    cfg.Identity.JWT.Claim,
+				},
+				Required: cfg.Identity.Required,
+			}),
+			middleware.ConcurrencyLimit(cfg.Server.MaxConcurrentRequests),
+			middleware.DecompressRequest(),
 			middleware.Timeout(cfg.Server.WriteTimeout),
 		), nil
 	})
@@ -244,4 +592,31 @@ func registerDependencies(injector *do.RootScope, cfg *config.Config, logger *sl
 		handler := do.MustInvoke[nethttp.Handler](i)
 		return adapthttp.NewServer(cfg.Server, handler, logger), nil
 	})
+
+	// Admin listener: health and operational routes on server.admin_port.
+	do.ProvideNamed(injector, adminHandlerName, func(i do.Injector) (nethttp.Handler, error) {
+		healthH := do.MustInvoke[*handlers.HealthHandler](i)
+		adminH := do.MustInvoke[*handlers.AdminHandler](i)
+
+		trustedProxies, err := middleware.ParseTrustedProxies(cfg.Server.TrustedProxies)
+		if err != nil {
+			return nil, err
+		}
+
+		return adapthttp.NewAdminRouter(healthH, adminH, cfg.Server.EnablePprof,
+			middleware.Recovery(logger),
+			middleware.StartTime(),
+			middleware.PrettyJSON(cfg.Server.PrettyJSON),
+			middleware.RequestID(),
+			middleware.Logging(logger, trustedProxies...),
+		), nil
+	})
+
+	do.ProvideNamed(injector, adminServerName, func(i do.Injector) (*adapthttp.Server, error) {
+		handler := do.MustInvokeNamed[nethttp.Handler](i, adminHandlerName)
+		adminCfg := cfg.Server
+		adminCfg.Port = cfg.Server.AdminPort
+		adminCfg.WriteTimeout = cfg.Server.AdminWriteTimeout
+		return adapthttp.NewServer(adminCfg, handler, logger), nil
+	})
 }
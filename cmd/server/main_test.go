@@ -0,0 +1,511 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/samber/do/v2"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/config"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/httpclient"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/telemetry"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
+)
+
+// blockingServer is a shutdowner whose Shutdown waits for its context, like a
+// server with a stuck in-flight request.
+type blockingServer struct{}
+
+func (blockingServer) Shutdown(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// quickServer is a shutdowner that drains immediately.
+type quickServer struct{}
+
+func (quickServer) Shutdown(context.Context) error { return nil }
+
+// shutdownFunc adapts a function to the shutdowner interface.
+type shutdownFunc func(context.Context) error
+
+func (f shutdownFunc) Shutdown(ctx context.Context) error { return f(ctx) }
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.DiscardHandler)
+}
+
+func TestDrainServer_SecondSignalForcesExit(t *testing.T) {
+	t.Parallel()
+
+	signals := make(chan os.Signal, 1)
+	signals <- syscall.SIGTERM
+
+	forced := false
+	start := time.Now()
+	err := drainServer(blockingServer{}, time.Minute, signals, func() { forced = true }, discardLogger())
+
+	if !errors.Is(err, errForcedShutdown) {
+		t.Errorf("drainServer() error = %v, want errForcedShutdown", err)
+	}
+	if !forced {
+		t.Error("onForce was not called")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("drainServer() took %v, want it to return promptly", elapsed)
+	}
+}
+
+func TestDrainServer_CompletesWithoutSecondSignal(t *testing.T) {
+	t.Parallel()
+
+	forced := false
+	err := drainServer(quickServer{}, time.Minute, make(chan os.Signal), func() { forced = true }, discardLogger())
+
+	if err != nil {
+		t.Errorf("drainServer() error = %v, want nil", err)
+	}
+	if forced {
+		t.Error("onForce should not be called when the drain completes")
+	}
+}
+
+func TestDrainServer_TimeoutBoundsDrain(t *testing.T) {
+	t.Parallel()
+
+	err := drainServer(blockingServer{}, 10*time.Millisecond, make(chan os.Signal), func() {}, discardLogger())
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("drainServer() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestServerGroup_ShutsDownAll(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	counting := shutdownFunc(func(context.Context) error {
+		calls.Add(1)
+		return nil
+	})
+	failing := shutdownFunc(func(context.Context) error {
+		calls.Add(1)
+		return errors.New("admin drain failed")
+	})
+
+	err := serverGroup{counting, failing}.Shutdown(context.Background())
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("Shutdown calls = %d, want 2", got)
+	}
+	if err == nil || !strings.Contains(err.Error(), "admin drain failed") {
+		t.Errorf("Shutdown() error = %v, want it to carry the failing server's error", err)
+	}
+}
+
+func TestServerGroup_DrainsConcurrently(t *testing.T) {
+	t.Parallel()
+
+	// A stuck server must not keep the other from shutting down: both share
+	// the one deadline.
+	err := drainServer(serverGroup{blockingServer{}, quickServer{}}, 10*time.Millisecond,
+		make(chan os.Signal), func() {}, discardLogger())
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("drainServer() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestAdminListener_ServesHealthOffTheAPIPort(t *testing.T) {
+	t.Parallel()
+
+	metrics, err := telemetry.NewMetrics(sdkmetric.NewMeterProvider(), "test")
+	if err != nil {
+		t.Fatalf("NewMetrics() error = %v", err)
+	}
+
+	injector := do.New()
+	do.ProvideValue(injector, metrics)
+	do.ProvideValue(injector, new(slog.LevelVar))
+	registerDependencies(injector, config.Defaults(), discardLogger())
+
+	api := httptest.NewServer(do.MustInvoke[nethttp.Handler](injector))
+	t.Cleanup(api.Close)
+	admin := httptest.NewServer(do.MustInvokeNamed[nethttp.Handler](injector, adminHandlerName))
+	t.Cleanup(admin.Close)
+
+	get := func(base, path string) int {
+		t.Helper()
+		req, err := nethttp.NewRequestWithContext(context.Background(), nethttp.MethodGet, base+path, nethttp.NoBody)
+		if err != nil {
+			t.Fatalf("creating request: %v", err)
+		}
+		resp, err := nethttp.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if got := get(admin.URL, "/health/live"); got != nethttp.StatusOK {
+		t.Errorf("admin GET /health/live status = %d, want %d", got, nethttp.StatusOK)
+	}
+	if got := get(api.URL, "/health/live"); got != nethttp.StatusNotFound {
+		t.Errorf("API GET /health/live status = %d, want %d", got, nethttp.StatusNotFound)
+	}
+	if got := get(admin.URL, "/api/v1/todos"); got != nethttp.StatusNotFound {
+		t.Errorf("admin GET /api/v1/todos status = %d, want %d", got, nethttp.StatusNotFound)
+	}
+}
+
+func TestReadiness_ActiveHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		active         bool
+		healthPath     string
+		downstreamCode int
+		wantStatus     int
+	}{
+		{name: "passive ignores failing ping", active: false, healthPath: "/healthz", downstreamCode: nethttp.StatusInternalServerError, wantStatus: nethttp.StatusOK},
+		{name: "active fails when ping fails", active: true, healthPath: "/healthz", downstreamCode: nethttp.StatusInternalServerError, wantStatus: nethttp.StatusServiceUnavailable},
+		{name: "configured path ready on 200", active: true, healthPath: "/status/ready", downstreamCode: nethttp.StatusOK, wantStatus: nethttp.StatusOK},
+		{name: "configured path not ready on 503", active: true, healthPath: "/status/ready", downstreamCode: nethttp.StatusServiceUnavailable, wantStatus: nethttp.StatusServiceUnavailable},
+		{name: "empty path falls back to passive", active: true, healthPath: "", downstreamCode: nethttp.StatusServiceUnavailable, wantStatus: nethttp.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var pinged atomic.Value
+			downstream := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				pinged.Store(r.URL.Path)
+				w.WriteHeader(tt.downstreamCode)
+			}))
+			t.Cleanup(downstream.Close)
+
+			metrics, err := telemetry.NewMetrics(sdkmetric.NewMeterProvider(), "test")
+			if err != nil {
+				t.Fatalf("NewMetrics() error = %v", err)
+			}
+
+			cfg := config.Defaults()
+			cfg.Client.BaseURL = downstream.URL
+			cfg.Client.ActiveHealthCheck = tt.active
+			cfg.Client.HealthPath = tt.healthPath
+
+			injector := do.New()
+			do.ProvideValue(injector, metrics)
+			do.ProvideValue(injector, new(slog.LevelVar))
+			registerDependencies(injector, cfg, discardLogger())
+
+			admin := do.MustInvokeNamed[nethttp.Handler](injector, adminHandlerName)
+			rec := httptest.NewRecorder()
+			admin.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodGet, "/health/ready", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("GET /health/ready status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			wantPing := ""
+			if tt.active && tt.healthPath != "" {
+				wantPing = tt.healthPath
+			}
+			if got, _ := pinged.Load().(string); got != wantPing {
+				t.Errorf("downstream path requested = %q, want %q", got, wantPing)
+			}
+		})
+	}
+}
+
+func TestPrettyJSON_FollowsProfile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		profile string
+		want    string
+	}{
+		{profile: "local", want: "{\n  \"status\": \"ok\"\n}\n"},
+		{profile: "prod", want: "{\"status\":\"ok\"}\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.profile, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := config.Load(tt.profile, config.WithConfigDir("../../configs"))
+			if err != nil {
+				t.Fatalf("Load(%q) error = %v", tt.profile, err)
+			}
+			metrics, err := telemetry.NewMetrics(sdkmetric.NewMeterProvider(), "test")
+			if err != nil {
+				t.Fatalf("NewMetrics() error = %v", err)
+			}
+
+			injector := do.New()
+			do.ProvideValue(injector, metrics)
+			do.ProvideValue(injector, new(slog.LevelVar))
+			registerDependencies(injector, cfg, discardLogger())
+
+			admin := do.MustInvokeNamed[nethttp.Handler](injector, adminHandlerName)
+			rec := httptest.NewRecorder()
+			admin.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodGet, "/health/live", nil))
+
+			if got := rec.Body.String(); got != tt.want {
+				t.Errorf("GET /health/live body = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveServer_MissingProviderNamesType(t *testing.T) {
+	t.Parallel()
+
+	// registerDependencies expects the bootstrap values (metrics, log level)
+	// to be provided by run; leaving them out must fail with a clear error.
+	injector := do.New()
+	registerDependencies(injector, &config.Config{}, discardLogger())
+
+	_, err := resolveServer(injector, discardLogger())
+	if err == nil {
+		t.Fatal("resolveServer() error = nil, want missing provider error")
+	}
+	if !errors.Is(err, do.ErrServiceNotFound) {
+		t.Errorf("resolveServer() error = %v, want errors.Is do.ErrServiceNotFound", err)
+	}
+	for _, want := range []string{"telemetry.Metrics", "path:"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("resolveServer() error = %q, want it to mention %q", err.Error(), want)
+		}
+	}
+}
+
+func TestResolveServer_LogsResolvedGraph(t *testing.T) {
+	t.Parallel()
+
+	metrics, err := telemetry.NewMetrics(sdkmetric.NewMeterProvider(), "test")
+	if err != nil {
+		t.Fatalf("NewMetrics() error = %v", err)
+	}
+
+	injector := do.New()
+	do.ProvideValue(injector, metrics)
+	do.ProvideValue(injector, new(slog.LevelVar))
+	registerDependencies(injector, &config.Config{}, discardLogger())
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	if _, err := resolveServer(injector, logger); err != nil {
+		t.Fatalf("resolveServer() error = %v, want nil", err)
+	}
+
+	var entry struct {
+		Msg      string   `json:"msg"`
+		Provided []string `json:"provided"`
+		Invoked  []string `json:"invoked"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("decoding log entry %q: %v", buf.String(), err)
+	}
+	if entry.Msg != "dependency graph resolved" {
+		t.Errorf("msg = %q, want %q", entry.Msg, "dependency graph resolved")
+	}
+	want := do.NameOf[ports.ProjectService]()
+	if !slices.Contains(entry.Invoked, want) {
+		t.Errorf("invoked = %v, want it to contain %q", entry.Invoked, want)
+	}
+	if len(entry.Provided) < len(entry.Invoked) {
+		t.Errorf("len(provided) = %d, want >= len(invoked) = %d", len(entry.Provided), len(entry.Invoked))
+	}
+}
+
+func TestReloadConfig_AppliesLogLevel(t *testing.T) {
+	t.Parallel()
+
+	current := config.Defaults()
+	next := config.Defaults()
+	next.Log.Level = "debug"
+	next.Server.Port = 9999 // not hot-reloadable
+
+	logLevel := new(slog.LevelVar)
+	logLevel.Set(slog.LevelInfo)
+
+	got, err := reloadConfig(current, func() (*config.Config, error) { return next, nil }, logLevel, discardLogger())
+	if err != nil {
+		t.Fatalf("reloadConfig() error = %v, want nil", err)
+	}
+	if logLevel.Level() != slog.LevelDebug {
+		t.Errorf("log level = %v, want %v", logLevel.Level(), slog.LevelDebug)
+	}
+	if got.Log.Level != "debug" {
+		t.Errorf("Log.Level = %q, want %q", got.Log.Level, "debug")
+	}
+	if got.Server.Port != current.Server.Port {
+		t.Errorf("Server.Port = %d, want %d (requires restart)", got.Server.Port, current.Server.Port)
+	}
+	if current.Log.Level != "info" {
+		t.Errorf("current.Log.Level = %q, want it left unmodified", current.Log.Level)
+	}
+}
+
+func TestReloadConfig_RejectsInvalidConfig(t *testing.T) {
+	t.Setenv("APP_LOG_LEVEL", "verbose")
+
+	current := config.Defaults()
+	logLevel := new(slog.LevelVar)
+	logLevel.Set(slog.LevelInfo)
+
+	load := func() (*config.Config, error) {
+		return config.Load("local", config.WithEnvOnly())
+	}
+
+	got, err := reloadConfig(current, load, logLevel, discardLogger())
+	if err == nil {
+		t.Fatal("reloadConfig() error = nil, want validation error")
+	}
+	if got != current {
+		t.Error("reloadConfig() returned a new config, want current kept")
+	}
+	if logLevel.Level() != slog.LevelInfo {
+		t.Errorf("log level = %v, want unchanged %v", logLevel.Level(), slog.LevelInfo)
+	}
+}
+
+func TestValidateDefaultSorts(t *testing.T) {
+	t.Parallel()
+
+	if err := validateDefaultSorts(config.Defaults()); err != nil {
+		t.Fatalf("validateDefaultSorts(defaults) error = %v", err)
+	}
+
+	cfg := config.Defaults()
+	cfg.Project.DefaultSort = "-priority"
+	err := validateDefaultSorts(cfg)
+	if err == nil || !strings.Contains(err.Error(), "project.default_sort") {
+		t.Errorf("validateDefaultSorts() error = %v, want project.default_sort rejected", err)
+	}
+}
+
+func TestNewAuditLogger_File(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := newAuditLogger(config.AuditConfig{Output: path})
+	if err != nil {
+		t.Fatalf("newAuditLogger() error = %v", err)
+	}
+	audit.Info("audit", slog.String("operation", "create"))
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading audit file: %v", err)
+	}
+	var rec map[string]any
+	if err := json.Unmarshal(data, &rec); err != nil {
+		t.Fatalf("audit record is not JSON: %v\n%s", err, data)
+	}
+	if rec["log_type"] != "audit" || rec["operation"] != "create" {
+		t.Errorf("record = %v, want log_type=audit and operation=create", rec)
+	}
+}
+
+func TestNewAuditLogger_EmptyDisables(t *testing.T) {
+	t.Parallel()
+
+	audit, err := newAuditLogger(config.AuditConfig{})
+	if err != nil || audit != nil {
+		t.Errorf("newAuditLogger() = %v, %v; want nil, nil", audit, err)
+	}
+}
+
+func TestNewAuditLogger_UnwritablePath(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "missing", "audit.log")
+	if _, err := newAuditLogger(config.AuditConfig{Output: path}); err == nil {
+		t.Error("newAuditLogger() error = nil, want error for a missing directory")
+	}
+}
+
+// TestInitTelemetry_DisabledProvidesMetrics guards the DI graph: consumers
+// tolerate nil metrics, but with telemetry disabled they should still get
+// working no-op instruments rather than relying on that.
+func TestInitTelemetry_DisabledProvidesMetrics(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Defaults()
+	cfg.Telemetry.Enabled = false
+
+	otel, err := initTelemetry(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("initTelemetry() error = %v", err)
+	}
+	t.Cleanup(func() { _ = otel.Shutdown(context.Background()) })
+
+	if otel.metrics == nil {
+		t.Fatal("metrics = nil, want no-op metrics when telemetry is disabled")
+	}
+	if otel.tracer != nil {
+		t.Error("tracer != nil, want nil when telemetry is disabled")
+	}
+}
+
+func TestRun_StartupProbeFailsOnUnreachableDownstream(t *testing.T) {
+	downstream := httptest.NewServer(nethttp.NotFoundHandler())
+	unreachable := downstream.URL
+	downstream.Close()
+
+	t.Setenv("APP_PROFILE", "test")
+	t.Setenv("APP_CONFIG_ENV_ONLY", "true")
+	t.Setenv("APP_CLIENT_BASE_URL", unreachable)
+	t.Setenv("APP_TELEMETRY_ENABLED", "false")
+	t.Setenv("APP_AUDIT_OUTPUT", "")
+	t.Setenv("APP_STARTUP_PROBE_DOWNSTREAM", "true")
+	t.Setenv("APP_STARTUP_PROBE_TIMEOUT", "2s")
+
+	done := make(chan error, 1)
+	go func() { done <- run() }()
+
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "startup probe") {
+			t.Errorf("run() error = %v, want startup probe error", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("run() did not return; want startup probe failure")
+	}
+}
+
+func TestProbeDownstream_Healthy(t *testing.T) {
+	t.Parallel()
+
+	downstream := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusOK)
+	}))
+	t.Cleanup(downstream.Close)
+
+	cfg := config.Defaults()
+	cfg.Client.BaseURL = downstream.URL
+	client := httpclient.New(&cfg.Client, "todo-api", nil, discardLogger())
+
+	if err := probeDownstream(context.Background(), client, time.Second, discardLogger()); err != nil {
+		t.Errorf("probeDownstream() error = %v, want nil", err)
+	}
+}
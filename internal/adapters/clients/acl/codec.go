@@ -0,0 +1,72 @@
+package acl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// Codec encodes request bodies and decodes response bodies on the wire.
+// Translators in the sub-packages still operate on DTO structs; a Codec only
+// changes how those DTOs are serialized (e.g. JSON vs protobuf).
+type Codec interface {
+	// ContentType is sent as both Content-Type and Accept.
+	ContentType() string
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// JSONCodec is the default Codec, using encoding/json. Numbers decoded into
+// an interface value become json.Number rather than float64, so IDs above
+// 2^53 in loosely typed fields keep their precision; DTO fields are typed
+// int64 and are exact either way.
+type JSONCodec struct{}
+
+// ContentType returns "application/json".
+func (JSONCodec) ContentType() string { return "application/json" }
+
+// Marshal encodes v as JSON.
+func (JSONCodec) Marshal(v any) ([]byte, error) { return json.Marshal(v) }
+
+// Unmarshal decodes JSON data into v. Like json.Unmarshal, it rejects
+// anything but whitespace after the value.
+func (JSONCodec) Unmarshal(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("invalid character after top-level JSON value")
+	}
+	return nil
+}
+
+// RequesterOption configures a Requester.
+type RequesterOption func(*Requester)
+
+// WithCodec selects the wire encoding for request and response bodies.
+// The default is JSONCodec.
+func WithCodec(c Codec) RequesterOption {
+	return func(r *Requester) {
+		r.codec = c
+	}
+}
+
+// WithStrictTimestamps logs a warning for each malformed timestamp in a
+// decoded todo instead of silently using the zero time.
+func WithStrictTimestamps(strict bool) RequesterOption {
+	return func(r *Requester) {
+		r.strictTimestamps = strict
+	}
+}
+
+// WithMaxResponseBytes caps how many bytes of a successful response body
+// are read before decoding. Larger bodies fail with [domain.ErrUnavailable].
+// Zero or a negative value disables the limit, which is the default.
+func WithMaxResponseBytes(n int64) RequesterOption {
+	return func(r *Requester) {
+		r.maxResponseBytes = n
+	}
+}
@@ -59,6 +59,9 @@ func TranslateHTTPError(resp *http.Response) error {
 	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
 		return fmt.Errorf("%s: %w", detail, domain.ErrForbidden)
 
+	case resp.StatusCode == http.StatusGatewayTimeout:
+		return fmt.Errorf("%s: %w", detail, domain.ErrTimeout)
+
 	case resp.StatusCode >= http.StatusInternalServerError:
 		return fmt.Errorf("%s: %w", detail, domain.ErrUnavailable)
 
@@ -63,6 +63,11 @@ func TestTranslateHTTPError_StatusMapping(t *testing.T) {
 			statusCode: http.StatusServiceUnavailable,
 			wantErr:    domain.ErrUnavailable,
 		},
+		{
+			name:       "504 maps to ErrTimeout",
+			statusCode: http.StatusGatewayTimeout,
+			wantErr:    domain.ErrTimeout,
+		},
 	}
 
 	for _, tt := range tests {
@@ -7,6 +7,8 @@ type GroupDTO struct {
 	ID          int64  `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	Archived    bool   `json:"archived"`
+	OwnerID     string `json:"owner_id,omitempty"`
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
 }
@@ -15,6 +17,7 @@ type GroupDTO struct {
 type CreateGroupRequestDTO struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	OwnerID     string `json:"owner_id,omitempty"`
 }
 
 // UpdateGroupRequestDTO matches the downstream UpdateGroupRequest schema.
@@ -22,6 +25,7 @@ type CreateGroupRequestDTO struct {
 type UpdateGroupRequestDTO struct {
 	Name        *string `json:"name,omitempty"`
 	Description *string `json:"description,omitempty"`
+	Archived    *bool   `json:"archived,omitempty"`
 }
 
 // GroupListResponseDTO matches the downstream GroupListResponse schema.
@@ -16,6 +16,8 @@ func ToDomainProject(dto GroupDTO) domproject.Project {
 		ID:          dto.ID,
 		Name:        dto.Name,
 		Description: dto.Description,
+		Archived:    dto.Archived,
+		OwnerID:     dto.OwnerID,
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
 	}
@@ -37,6 +39,7 @@ func ToCreateGroupRequest(project *domproject.Project) CreateGroupRequestDTO {
 	return CreateGroupRequestDTO{
 		Name:        project.Name,
 		Description: project.Description,
+		OwnerID:     project.OwnerID,
 	}
 }
 
@@ -46,5 +49,6 @@ func ToUpdateGroupRequest(project *domproject.Project) UpdateGroupRequestDTO {
 	return UpdateGroupRequestDTO{
 		Name:        &project.Name,
 		Description: &project.Description,
+		Archived:    &project.Archived,
 	}
 }
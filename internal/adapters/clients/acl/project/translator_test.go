@@ -21,11 +21,16 @@ func TestToDomainProject(t *testing.T) {
 				ID:          10,
 				Name:        "Sprint 1",
 				Description: "First sprint tasks",
+				Archived:    true,
+				OwnerID:     "user-42",
 				CreatedAt:   "2026-02-12T15:04:05Z",
 				UpdatedAt:   "2026-02-12T16:04:05Z",
 			},
 			verify: func(t *testing.T, got domproject.Project) {
 				t.Helper()
+				if got.OwnerID != "user-42" {
+					t.Errorf("OwnerID = %q, want %q", got.OwnerID, "user-42")
+				}
 				if got.ID != 10 {
 					t.Errorf("ID = %d, want 10", got.ID)
 				}
@@ -35,6 +40,9 @@ func TestToDomainProject(t *testing.T) {
 				if got.Description != "First sprint tasks" {
 					t.Errorf("Description = %q, want %q", got.Description, "First sprint tasks")
 				}
+				if !got.Archived {
+					t.Error("Archived = false, want true")
+				}
 			},
 		},
 		{
@@ -170,6 +178,19 @@ func TestToCreateGroupRequest(t *testing.T) {
 				if got.Description != "First sprint tasks" {
 					t.Errorf("Description = %q, want %q", got.Description, "First sprint tasks")
 				}
+				if got.OwnerID != "" {
+					t.Errorf("OwnerID = %q, want empty", got.OwnerID)
+				}
+			},
+		},
+		{
+			name:    "maps owner",
+			project: &domproject.Project{Name: "Sprint 1", OwnerID: "user-42"},
+			verify: func(t *testing.T, got CreateGroupRequestDTO) {
+				t.Helper()
+				if got.OwnerID != "user-42" {
+					t.Errorf("OwnerID = %q, want %q", got.OwnerID, "user-42")
+				}
 			},
 		},
 	}
@@ -205,6 +226,23 @@ func TestToUpdateGroupRequest(t *testing.T) {
 				if got.Description == nil || *got.Description != "Updated description" {
 					t.Errorf("Description = %v, want %q", got.Description, "Updated description")
 				}
+				if got.Archived == nil || *got.Archived {
+					t.Errorf("Archived = %v, want false", got.Archived)
+				}
+			},
+		},
+		{
+			name: "sends the archived flag",
+			project: &domproject.Project{
+				Name:        "Old Sprint",
+				Description: "Done",
+				Archived:    true,
+			},
+			verify: func(t *testing.T, got UpdateGroupRequestDTO) {
+				t.Helper()
+				if got.Archived == nil || !*got.Archived {
+					t.Errorf("Archived = %v, want true", got.Archived)
+				}
 			},
 		},
 	}
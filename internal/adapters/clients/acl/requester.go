@@ -3,37 +3,54 @@ package acl
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/httpclient"
 )
 
 // Requester centralizes the HTTP request lifecycle for ACL clients:
-// request creation, JSON marshaling, execution via httpclient.Client,
-// response body cleanup on error, status code validation, error
-// translation, and JSON decoding.
+// request creation, body marshaling via the configured [Codec], execution
+// via httpclient.Client, response body cleanup on error, status code
+// validation, error translation, and response decoding.
 type Requester struct {
 	client *httpclient.Client
 	logger *slog.Logger
+	codec  Codec
+
+	// maxResponseBytes bounds decoded response bodies; zero means no limit.
+	maxResponseBytes int64
+	// strictTimestamps is read by clients when building their translators.
+	strictTimestamps bool
 }
 
 // NewRequester creates a Requester backed by the given HTTP client and logger.
-func NewRequester(client *httpclient.Client, logger *slog.Logger) *Requester {
-	return &Requester{client: client, logger: logger}
+// Bodies are encoded with [JSONCodec] unless overridden by [WithCodec].
+func NewRequester(client *httpclient.Client, logger *slog.Logger, opts ...RequesterOption) *Requester {
+	r := &Requester{client: client, logger: logger, codec: JSONCodec{}}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 // Do executes an HTTP request against the configured base URL.
 //
-// It marshals reqBody to JSON (if non-nil), sends the request, checks for a
+// It marshals reqBody with the codec (if non-nil), sends the request, checks for a
 // 2xx status code, and decodes the response body into respBody (if non-nil).
 // For DELETE-style calls where no response body is expected, pass nil for
 // respBody.
 //
 // Any non-2xx status is passed to [TranslateHTTPError] and mapped to the
-// corresponding domain error.
+// corresponding domain error. Transport failures caused by a deadline are
+// wrapped with [domain.ErrTimeout].
 func (r *Requester) Do(ctx context.Context, method, path string, reqBody, respBody any) error {
 	switch method {
 	case http.MethodGet:
@@ -47,6 +64,32 @@ func (r *Requester) Do(ctx context.Context, method, path string, reqBody, respBo
 	}
 }
 
+// DoForm executes a request whose body is form, encoded as
+// application/x-www-form-urlencoded, for downstream endpoints that do not
+// accept the codec's format. Only POST, PUT, and PATCH are supported. The
+// response is handled as in [Requester.Do]: a non-2xx status is translated
+// to a domain error and a 2xx body is decoded into respBody with the codec
+// (if respBody is non-nil).
+func (r *Requester) DoForm(ctx context.Context, method, path string, form url.Values, respBody any) error {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+	default:
+		return fmt.Errorf("unsupported HTTP method for form body: %s", method)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, r.client.BaseURL()+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("creating %s request for %s: %w", method, path, err)
+	}
+	req.Header.Set("Content-Type", formContentType)
+	req.Header.Set("Accept", r.codec.ContentType())
+
+	return r.execute(req, respBody)
+}
+
+// formContentType is the media type of bodies sent by DoForm.
+const formContentType = "application/x-www-form-urlencoded"
+
 // BaseURL returns the base URL from the underlying HTTP client.
 func (r *Requester) BaseURL() string {
 	return r.client.BaseURL()
@@ -59,6 +102,7 @@ func (r *Requester) get(ctx context.Context, path string, respBody any) error {
 	if err != nil {
 		return fmt.Errorf("creating GET request for %s: %w", path, err)
 	}
+	req.Header.Set("Accept", r.codec.ContentType())
 
 	return r.execute(req, respBody)
 }
@@ -66,7 +110,7 @@ func (r *Requester) get(ctx context.Context, path string, respBody any) error {
 func (r *Requester) withBody(ctx context.Context, method, path string, reqBody, respBody any) error {
 	url := r.client.BaseURL() + path
 
-	body, err := json.Marshal(reqBody)
+	body, err := r.codec.Marshal(reqBody)
 	if err != nil {
 		return fmt.Errorf("marshaling %s body for %s: %w", method, path, err)
 	}
@@ -75,7 +119,8 @@ func (r *Requester) withBody(ctx context.Context, method, path string, reqBody,
 	if err != nil {
 		return fmt.Errorf("creating %s request for %s: %w", method, path, err)
 	}
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", r.codec.ContentType())
+	req.Header.Set("Accept", r.codec.ContentType())
 
 	return r.execute(req, respBody)
 }
@@ -119,6 +164,9 @@ func (r *Requester) execute(req *http.Request, respBody any) error {
 			slog.String("url", req.URL.String()),
 			slog.String("error", err.Error()),
 		)
+		if isTimeout(err) {
+			return fmt.Errorf("%s %s: %w: %w", req.Method, req.URL.Path, domain.ErrTimeout, err)
+		}
 		return fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, err)
 	}
 	defer r.closeBody(req.Context(), resp)
@@ -134,7 +182,11 @@ func (r *Requester) execute(req *http.Request, respBody any) error {
 	}
 
 	if respBody != nil {
-		if err := json.NewDecoder(resp.Body).Decode(respBody); err != nil {
+		data, err := r.readBody(resp)
+		if err != nil {
+			return fmt.Errorf("reading response from %s %s: %w", req.Method, req.URL.Path, err)
+		}
+		if err := r.codec.Unmarshal(data, respBody); err != nil {
 			return fmt.Errorf("decoding response from %s %s: %w", req.Method, req.URL.Path, err)
 		}
 	}
@@ -142,6 +194,34 @@ func (r *Requester) execute(req *http.Request, respBody any) error {
 	return nil
 }
 
+// readBody reads the response body, enforcing maxResponseBytes when set.
+// One byte past the limit is read so an oversized body is detected without
+// buffering the rest of it.
+func (r *Requester) readBody(resp *http.Response) ([]byte, error) {
+	if r.maxResponseBytes <= 0 {
+		return io.ReadAll(resp.Body)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, r.maxResponseBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > r.maxResponseBytes {
+		return nil, fmt.Errorf("%w: body exceeds %d bytes", domain.ErrUnavailable, r.maxResponseBytes)
+	}
+	return data, nil
+}
+
+// isTimeout reports whether a transport error was caused by a deadline:
+// the request context expiring, the client timeout firing, or a
+// connection-phase timeout.
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
 // isSuccess returns true for HTTP 2xx status codes.
 func isSuccess(statusCode int) bool {
 	return statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices
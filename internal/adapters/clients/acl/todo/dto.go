@@ -5,25 +5,27 @@ package todo
 // TodoDTO matches the downstream Todo schema.
 // Fields use int64 to match the OpenAPI spec's format: int64 annotation.
 type TodoDTO struct {
-	ID              int64  `json:"id"`
-	Title           string `json:"title"`
-	Description     string `json:"description"`
-	Status          string `json:"status"`
-	Category        string `json:"category"`
-	ProgressPercent int64  `json:"progress_percent"`
-	GroupID         *int64 `json:"group_id,omitempty"`
-	CreatedAt       string `json:"created_at"`
-	UpdatedAt       string `json:"updated_at"`
+	ID              int64   `json:"id"`
+	Title           string  `json:"title"`
+	Description     string  `json:"description"`
+	Status          string  `json:"status"`
+	Category        string  `json:"category"`
+	ProgressPercent int64   `json:"progress_percent"`
+	GroupID         *int64  `json:"group_id,omitempty"`
+	RecurrenceRule  *string `json:"recurrence_rule,omitempty"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
 }
 
 // CreateTodoRequestDTO matches the downstream CreateTodoRequest schema.
 type CreateTodoRequestDTO struct {
-	Title           string `json:"title"`
-	Description     string `json:"description"`
-	Status          string `json:"status,omitempty"`
-	Category        string `json:"category,omitempty"`
-	ProgressPercent int64  `json:"progress_percent,omitempty"`
-	GroupID         *int64 `json:"group_id,omitempty"`
+	Title           string  `json:"title"`
+	Description     string  `json:"description"`
+	Status          string  `json:"status,omitempty"`
+	Category        string  `json:"category,omitempty"`
+	ProgressPercent int64   `json:"progress_percent,omitempty"`
+	GroupID         *int64  `json:"group_id,omitempty"`
+	RecurrenceRule  *string `json:"recurrence_rule,omitempty"`
 }
 
 // UpdateTodoRequestDTO matches the downstream UpdateTodoRequest schema.
@@ -35,6 +37,7 @@ type UpdateTodoRequestDTO struct {
 	Category        *string `json:"category,omitempty"`
 	ProgressPercent *int64  `json:"progress_percent,omitempty"`
 	GroupID         *int64  `json:"group_id,omitempty"`
+	RecurrenceRule  *string `json:"recurrence_rule,omitempty"`
 }
 
 // TodoListResponseDTO matches the downstream TodoListResponse schema.
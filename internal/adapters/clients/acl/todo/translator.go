@@ -1,13 +1,84 @@
 package todo
 
 import (
+	"context"
+	"log/slog"
 	"time"
 
 	domtodo "github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
 )
 
+// Translator converts downstream todo DTOs to domain entities with
+// configurable handling of malformed timestamps. The package-level
+// functions behave like a lenient Translator.
+type Translator struct {
+	logger *slog.Logger
+	strict bool
+}
+
+// TranslatorOption configures a Translator.
+type TranslatorOption func(*Translator)
+
+// WithStrictTimestamps makes the Translator log a warning for each
+// non-empty timestamp that is not valid RFC3339. The field still defaults to
+// the zero time; strict mode only makes the bad data visible.
+func WithStrictTimestamps(strict bool) TranslatorOption {
+	return func(t *Translator) {
+		t.strict = strict
+	}
+}
+
+// NewTranslator creates a Translator that reports through logger. Without
+// options it is lenient: malformed timestamps silently become zero times.
+func NewTranslator(logger *slog.Logger, opts ...TranslatorOption) *Translator {
+	t := &Translator{logger: logger}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// ToDomainTodo converts a downstream TodoDTO like the package-level
+// [ToDomainTodo], warning about malformed timestamps in strict mode.
+func (t *Translator) ToDomainTodo(ctx context.Context, dto *TodoDTO) domtodo.Todo {
+	result := ToDomainTodo(dto)
+	if t.strict {
+		t.checkTimestamp(ctx, dto.ID, "created_at", dto.CreatedAt)
+		t.checkTimestamp(ctx, dto.ID, "updated_at", dto.UpdatedAt)
+	}
+	return result
+}
+
+// ToDomainTodoList converts a downstream TodoListResponseDTO like the
+// package-level [ToDomainTodoList], warning about malformed timestamps in
+// strict mode. The result is never nil.
+func (t *Translator) ToDomainTodoList(ctx context.Context, dto TodoListResponseDTO) []domtodo.Todo {
+	todos := make([]domtodo.Todo, len(dto.Todos))
+	for i := range dto.Todos {
+		todos[i] = t.ToDomainTodo(ctx, &dto.Todos[i])
+	}
+	return todos
+}
+
+// checkTimestamp logs a warning if raw is set but not valid RFC3339. An
+// empty value is treated as absent rather than malformed.
+func (t *Translator) checkTimestamp(ctx context.Context, id int64, field, raw string) {
+	if raw == "" {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, raw); err != nil {
+		t.logger.WarnContext(ctx, "malformed downstream timestamp",
+			slog.Int64("todo_id", id),
+			slog.String("field", field),
+			slog.String("value", raw),
+			slog.Any("error", err),
+		)
+	}
+}
+
 // ToDomainTodo converts a downstream TodoDTO to a domain Todo entity.
-// Maps GroupID to ProjectID and parses RFC3339 timestamps.
+// Maps GroupID to ProjectID and parses RFC3339 timestamps; a malformed
+// timestamp becomes the zero time.
 func ToDomainTodo(dto *TodoDTO) domtodo.Todo {
 	createdAt, _ := time.Parse(time.RFC3339, dto.CreatedAt)
 	updatedAt, _ := time.Parse(time.RFC3339, dto.UpdatedAt)
@@ -20,13 +91,15 @@ func ToDomainTodo(dto *TodoDTO) domtodo.Todo {
 		Category:        domtodo.Category(dto.Category),
 		ProgressPercent: int(dto.ProgressPercent),
 		ProjectID:       dto.GroupID,
+		RecurrenceRule:  dto.RecurrenceRule,
 		CreatedAt:       createdAt,
 		UpdatedAt:       updatedAt,
 	}
 }
 
 // ToDomainTodoList converts a downstream TodoListResponseDTO to a slice of
-// domain Todo entities.
+// domain Todo entities. The result is never nil, even when the downstream
+// sends "todos": null.
 func ToDomainTodoList(dto TodoListResponseDTO) []domtodo.Todo {
 	todos := make([]domtodo.Todo, len(dto.Todos))
 	for i := range dto.Todos {
@@ -45,6 +118,7 @@ func ToCreateTodoRequest(todo *domtodo.Todo) CreateTodoRequestDTO {
 		Category:        todo.Category.String(),
 		ProgressPercent: int64(todo.ProgressPercent),
 		GroupID:         todo.ProjectID,
+		RecurrenceRule:  todo.RecurrenceRule,
 	}
 }
 
@@ -62,5 +136,6 @@ func ToUpdateTodoRequest(todo *domtodo.Todo) UpdateTodoRequestDTO {
 		Category:        &category,
 		ProgressPercent: &progress,
 		GroupID:         todo.ProjectID,
+		RecurrenceRule:  todo.RecurrenceRule,
 	}
 }
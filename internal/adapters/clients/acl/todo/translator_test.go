@@ -1,6 +1,10 @@
 package todo
 
 import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
@@ -131,6 +135,73 @@ func TestToDomainTodo_Timestamps(t *testing.T) {
 	}
 }
 
+func TestTranslator_Timestamps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		strict    bool
+		createdAt string
+		updatedAt string
+		wantWarns int
+	}{
+		{name: "lenient ignores malformed", createdAt: "not-a-date", updatedAt: "2026-13-01"},
+		{name: "strict warns per malformed field", strict: true, createdAt: "not-a-date", updatedAt: "2026-13-01", wantWarns: 2},
+		{name: "strict accepts valid", strict: true, createdAt: "2026-02-12T15:04:05Z", updatedAt: "2026-02-12T16:04:05Z"},
+		{name: "strict treats empty as absent", strict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&buf, nil))
+			tr := NewTranslator(logger, WithStrictTimestamps(tt.strict))
+
+			got := tr.ToDomainTodo(context.Background(), &TodoDTO{
+				ID:        7,
+				CreatedAt: tt.createdAt,
+				UpdatedAt: tt.updatedAt,
+			})
+
+			// Strict mode only reports; malformed values still become zero.
+			want := ToDomainTodo(&TodoDTO{ID: 7, CreatedAt: tt.createdAt, UpdatedAt: tt.updatedAt})
+			if !got.CreatedAt.Equal(want.CreatedAt) || !got.UpdatedAt.Equal(want.UpdatedAt) {
+				t.Errorf("timestamps = %v/%v, want %v/%v", got.CreatedAt, got.UpdatedAt, want.CreatedAt, want.UpdatedAt)
+			}
+
+			if n := strings.Count(buf.String(), "malformed downstream timestamp"); n != tt.wantWarns {
+				t.Errorf("warnings = %d, want %d; log:\n%s", n, tt.wantWarns, buf.String())
+			}
+			if tt.wantWarns > 0 && !strings.Contains(buf.String(), "todo_id=7") {
+				t.Errorf("log = %q, want it to include todo_id=7", buf.String())
+			}
+		})
+	}
+}
+
+func TestTranslator_ToDomainTodoList(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	tr := NewTranslator(slog.New(slog.NewTextHandler(&buf, nil)), WithStrictTimestamps(true))
+
+	got := tr.ToDomainTodoList(context.Background(), TodoListResponseDTO{Todos: []TodoDTO{
+		{ID: 1, CreatedAt: "2026-02-12T15:04:05Z"},
+		{ID: 2, CreatedAt: "yesterday"},
+	}})
+	if len(got) != 2 {
+		t.Fatalf("len = %d, want 2", len(got))
+	}
+	if n := strings.Count(buf.String(), "malformed downstream timestamp"); n != 1 {
+		t.Errorf("warnings = %d, want 1", n)
+	}
+	if empty := tr.ToDomainTodoList(context.Background(), TodoListResponseDTO{}); empty == nil {
+		t.Error("ToDomainTodoList(null) = nil, want empty slice")
+	}
+}
+
 func TestToDomainTodo_ProgressPercent(t *testing.T) {
 	t.Parallel()
 
@@ -189,6 +260,9 @@ func TestToDomainTodoList(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			got := ToDomainTodoList(tt.dto)
+			if got == nil {
+				t.Fatal("ToDomainTodoList() = nil, want non-nil slice")
+			}
 			if len(got) != tt.wantLen {
 				t.Fatalf("len = %d, want %d", len(got), tt.wantLen)
 			}
@@ -346,3 +420,25 @@ func TestToUpdateTodoRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestRecurrenceRule_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	rule := "FREQ=DAILY"
+	td := &domtodo.Todo{Status: domtodo.StatusPending, Category: domtodo.CategoryWork, RecurrenceRule: &rule}
+
+	create := ToCreateTodoRequest(td)
+	if create.RecurrenceRule == nil || *create.RecurrenceRule != rule {
+		t.Errorf("create RecurrenceRule = %v, want %q", create.RecurrenceRule, rule)
+	}
+
+	update := ToUpdateTodoRequest(td)
+	if update.RecurrenceRule == nil || *update.RecurrenceRule != rule {
+		t.Errorf("update RecurrenceRule = %v, want %q", update.RecurrenceRule, rule)
+	}
+
+	got := ToDomainTodo(&TodoDTO{RecurrenceRule: &rule})
+	if got.RecurrenceRule == nil || *got.RecurrenceRule != rule {
+		t.Errorf("domain RecurrenceRule = %v, want %q", got.RecurrenceRule, rule)
+	}
+}
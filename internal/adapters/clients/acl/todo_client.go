@@ -6,6 +6,8 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 
 	aclproject "github.com/jsamuelsen11/go-service-template-v2/internal/adapters/clients/acl/project"
 	acltodo "github.com/jsamuelsen11/go-service-template-v2/internal/adapters/clients/acl/todo"
@@ -19,7 +21,7 @@ import (
 var _ ports.TodoClient = (*TodoClient)(nil)
 
 // TodoClient is the outbound adapter for the downstream TODO API. It
-// implements [ports.TodoClient] (11 CRUD methods for todos and projects).
+// implements [ports.TodoClient] (CRUD methods for todos and projects).
 //
 // All methods translate between our domain types and the downstream API's
 // representations via the ACL translators in sub-packages [acltodo] and
@@ -30,23 +32,27 @@ var _ ports.TodoClient = (*TodoClient)(nil)
 // exponential backoff, OpenTelemetry tracing, and health checking
 // ([ports.HealthChecker]) for every outbound call.
 type TodoClient struct {
-	req *Requester
+	req   *Requester
+	todos *acltodo.Translator
 }
 
 // NewTodoClient creates a TodoClient that sends requests through the given
 // [httpclient.Client]. The client's BaseURL should point to the downstream
 // TODO API root (e.g. "https://todo-api.example.com"). The logger is used
-// for error-level diagnostics on failed or unexpected responses.
-func NewTodoClient(client *httpclient.Client, logger *slog.Logger) *TodoClient {
+// for error-level diagnostics on failed or unexpected responses. Options are
+// passed to the underlying [Requester] (e.g. [WithCodec]).
+func NewTodoClient(client *httpclient.Client, logger *slog.Logger, opts ...RequesterOption) *TodoClient {
+	req := NewRequester(client, logger, opts...)
 	return &TodoClient{
-		req: NewRequester(client, logger),
+		req:   req,
+		todos: acltodo.NewTranslator(logger, acltodo.WithStrictTimestamps(req.strictTimestamps)),
 	}
 }
 
 // --- Todo operations ---
 
 // ListTodos fetches todos from GET /api/v1/todos, optionally filtered by
-// status, category, and project (mapped to group_id). A zero-value
+// status, category, and project (mapped to group_id) and ordered by sort. A zero-value
 // [todo.Filter] returns all todos. Returns the translated domain
 // slice or a domain error on failure.
 func (c *TodoClient) ListTodos(ctx context.Context, filter todo.Filter) ([]todo.Todo, error) {
@@ -56,7 +62,7 @@ func (c *TodoClient) ListTodos(ctx context.Context, filter todo.Filter) ([]todo.
 	if err := c.req.Do(ctx, http.MethodGet, path, nil, &dto); err != nil {
 		return nil, err
 	}
-	return acltodo.ToDomainTodoList(dto), nil
+	return c.todos.ToDomainTodoList(ctx, dto), nil
 }
 
 // GetTodo fetches a single todo by ID from GET /api/v1/todos/{id}.
@@ -68,10 +74,26 @@ func (c *TodoClient) GetTodo(ctx context.Context, id int64) (*todo.Todo, error)
 	if err := c.req.Do(ctx, http.MethodGet, path, nil, &dto); err != nil {
 		return nil, err
 	}
-	result := acltodo.ToDomainTodo(&dto)
+	result := c.todos.ToDomainTodo(ctx, &dto)
 	return &result, nil
 }
 
+// GetTodosByIDs fetches several todos in one call from
+// GET /api/v1/todos?ids=1,2,3. IDs are deduplicated before the request is
+// sent; an empty list returns an empty result without a downstream call.
+// Returns a [domain.ValidationError] if more than [todo.MaxFilterIDs]
+// distinct IDs are requested.
+func (c *TodoClient) GetTodosByIDs(ctx context.Context, ids []int64) ([]todo.Todo, error) {
+	filter := todo.Filter{IDs: ids}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []todo.Todo{}, nil
+	}
+	return c.ListTodos(ctx, filter)
+}
+
 // CreateTodo sends a POST /api/v1/todos with the translated request body
 // and returns the created todo as a domain entity. Returns
 // [domain.ErrValidation] if the downstream rejects the payload.
@@ -82,7 +104,7 @@ func (c *TodoClient) CreateTodo(ctx context.Context, t *todo.Todo) (*todo.Todo,
 	if err := c.req.Do(ctx, http.MethodPost, "/api/v1/todos", reqDTO, &respDTO); err != nil {
 		return nil, err
 	}
-	result := acltodo.ToDomainTodo(&respDTO)
+	result := c.todos.ToDomainTodo(ctx, &respDTO)
 	return &result, nil
 }
 
@@ -97,7 +119,7 @@ func (c *TodoClient) UpdateTodo(ctx context.Context, id int64, t *todo.Todo) (*t
 	if err := c.req.Do(ctx, http.MethodPut, path, reqDTO, &respDTO); err != nil {
 		return nil, err
 	}
-	result := acltodo.ToDomainTodo(&respDTO)
+	result := c.todos.ToDomainTodo(ctx, &respDTO)
 	return &result, nil
 }
 
@@ -110,12 +132,15 @@ func (c *TodoClient) DeleteTodo(ctx context.Context, id int64) error {
 
 // --- Project operations (downstream "groups") ---
 
-// ListProjects fetches all projects from GET /api/v1/groups. Projects are
-// returned without their todos populated. The downstream "group" concept
-// is translated to our domain "project" concept.
-func (c *TodoClient) ListProjects(ctx context.Context) ([]project.Project, error) {
+// ListProjects fetches all projects from GET /api/v1/groups, forwarding the
+// filter's sort as a query parameter. Projects are returned without their
+// todos populated. The downstream "group" concept is translated to our
+// domain "project" concept.
+func (c *TodoClient) ListProjects(ctx context.Context, filter project.Filter) ([]project.Project, error) {
+	path := "/api/v1/groups" + sortQuery(filter.Sort)
+
 	var dto aclproject.GroupListResponseDTO
-	if err := c.req.Do(ctx, http.MethodGet, "/api/v1/groups", nil, &dto); err != nil {
+	if err := c.req.Do(ctx, http.MethodGet, path, nil, &dto); err != nil {
 		return nil, err
 	}
 	return aclproject.ToDomainProjectList(dto), nil
@@ -185,7 +210,7 @@ func (c *TodoClient) GetProjectTodos(ctx context.Context, projectID int64, filte
 	if err := c.req.Do(ctx, http.MethodGet, path, nil, &dto); err != nil {
 		return nil, err
 	}
-	return acltodo.ToDomainTodoList(dto), nil
+	return c.todos.ToDomainTodoList(ctx, dto), nil
 }
 
 // filterQuery converts a [todo.Filter] to a URL query string (including
@@ -201,8 +226,29 @@ func filterQuery(f todo.Filter) string {
 	if f.ProjectID != nil {
 		v.Set("group_id", fmt.Sprintf("%d", *f.ProjectID))
 	}
+	if q := strings.TrimSpace(f.Search); q != "" {
+		v.Set("q", q)
+	}
+	if ids := f.UniqueIDs(); len(ids) > 0 {
+		parts := make([]string, len(ids))
+		for i, id := range ids {
+			parts[i] = strconv.FormatInt(id, 10)
+		}
+		v.Set("ids", strings.Join(parts, ","))
+	}
+	if f.Sort != "" {
+		v.Set("sort", f.Sort)
+	}
 	if len(v) == 0 {
 		return ""
 	}
 	return "?" + v.Encode()
 }
+
+// sortQuery returns "?sort=<sort>" for a non-empty sort, or an empty string.
+func sortQuery(sort string) string {
+	if sort == "" {
+		return ""
+	}
+	return "?" + url.Values{"sort": {sort}}.Encode()
+}
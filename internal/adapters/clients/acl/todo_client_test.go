@@ -1,12 +1,15 @@
 package acl
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -28,6 +31,7 @@ func newTestClient(t *testing.T, baseURL string) *httpclient.Client {
 		BaseURL: baseURL,
 		Timeout: 5 * time.Second,
 		Retry: config.RetryConfig{
+			Enabled:         true,
 			MaxAttempts:     1,
 			InitialInterval: 10 * time.Millisecond,
 			MaxInterval:     10 * time.Millisecond,
@@ -113,6 +117,124 @@ func TestTodoClient_ListTodos_WithFilter(t *testing.T) {
 	}
 }
 
+func TestTodoClient_ListTodos_WithSearch(t *testing.T) {
+	t.Parallel()
+
+	var gotSearch string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSearch = r.URL.Query().Get("q")
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(t, w, map[string]any{"todos": []any{}, "count": 0})
+	}))
+	defer ts.Close()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default())
+	_, err := client.ListTodos(context.Background(), todo.Filter{Search: " quarterly report "})
+	if err != nil {
+		t.Fatalf("ListTodos() error = %v", err)
+	}
+	if gotSearch != "quarterly report" {
+		t.Errorf("q = %q, want %q", gotSearch, "quarterly report")
+	}
+}
+
+func TestTodoClient_ListTodos_ForwardsSort(t *testing.T) {
+	t.Parallel()
+
+	var gotSort string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSort = r.URL.Query().Get("sort")
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(t, w, map[string]any{"todos": []any{}, "count": 0})
+	}))
+	defer ts.Close()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default())
+	if _, err := client.ListTodos(context.Background(), todo.Filter{Sort: "-created_at"}); err != nil {
+		t.Fatalf("ListTodos() error = %v", err)
+	}
+	if gotSort != "-created_at" {
+		t.Errorf("sort = %q, want %q", gotSort, "-created_at")
+	}
+}
+
+func TestTodoClient_GetTodosByIDs(t *testing.T) {
+	t.Parallel()
+
+	var gotPath, gotIDs string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotIDs = r.URL.Query().Get("ids")
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(t, w, map[string]any{
+			"todos": []any{
+				map[string]any{"id": 1, "title": "A", "description": "a", "status": "pending", "category": "work"},
+				map[string]any{"id": 2, "title": "B", "description": "b", "status": "done", "category": "personal"},
+			},
+			"count": 2,
+		})
+	}))
+	defer ts.Close()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default())
+	todos, err := client.GetTodosByIDs(context.Background(), []int64{2, 1, 2})
+	if err != nil {
+		t.Fatalf("GetTodosByIDs() error = %v", err)
+	}
+	if gotPath != "/api/v1/todos" {
+		t.Errorf("path = %q, want %q", gotPath, "/api/v1/todos")
+	}
+	if gotIDs != "2,1" {
+		t.Errorf("ids = %q, want %q", gotIDs, "2,1")
+	}
+	if len(todos) != 2 {
+		t.Errorf("len(todos) = %d, want 2", len(todos))
+	}
+}
+
+func TestTodoClient_GetTodosByIDs_Empty(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("unexpected downstream call for empty id list")
+	}))
+	defer ts.Close()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default())
+	todos, err := client.GetTodosByIDs(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("GetTodosByIDs() error = %v", err)
+	}
+	if len(todos) != 0 {
+		t.Errorf("len(todos) = %d, want 0", len(todos))
+	}
+}
+
+func TestTodoClient_GetTodosByIDs_TooMany(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("unexpected downstream call for oversized id list")
+	}))
+	defer ts.Close()
+
+	ids := make([]int64, todo.MaxFilterIDs+1)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default())
+	_, err := client.GetTodosByIDs(context.Background(), ids)
+
+	var ve *domain.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("GetTodosByIDs() error = %v, want *domain.ValidationError", err)
+	}
+	if _, ok := ve.Fields["ids"]; !ok {
+		t.Errorf("Fields = %v, want key %q", ve.Fields, "ids")
+	}
+}
+
 func TestTodoClient_GetTodo(t *testing.T) {
 	t.Parallel()
 
@@ -144,6 +266,79 @@ func TestTodoClient_GetTodo(t *testing.T) {
 	}
 }
 
+// largeID is above 2^53, where a float64 can no longer represent every
+// integer; decoding it through float64 would yield 9007199254740992.
+const largeID int64 = 9007199254740993
+
+func TestTodoClient_LargeIDsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path != "/api/v1/todos/9007199254740993" {
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+			writeJSON(t, w, map[string]any{
+				"id": largeID, "title": "Big", "description": "Large IDs",
+				"status": "pending", "category": "work", "group_id": largeID,
+				"created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z",
+			})
+		case http.MethodPut:
+			var body map[string]any
+			dec := json.NewDecoder(r.Body)
+			dec.UseNumber()
+			if err := dec.Decode(&body); err != nil {
+				t.Errorf("decoding request body: %v", err)
+			}
+			if got := body["group_id"]; got != json.Number("9007199254740993") {
+				t.Errorf("request group_id = %v, want 9007199254740993", got)
+			}
+			writeJSON(t, w, map[string]any{
+				"id": largeID, "title": "Big", "description": "Large IDs",
+				"status": "done", "category": "work", "group_id": largeID,
+				"created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-02T00:00:00Z",
+			})
+		}
+	}))
+	defer ts.Close()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default())
+	td, err := client.GetTodo(context.Background(), largeID)
+	if err != nil {
+		t.Fatalf("GetTodo() error = %v", err)
+	}
+	if td.ID != largeID || td.ProjectID == nil || *td.ProjectID != largeID {
+		t.Fatalf("GetTodo() ID = %d, ProjectID = %v, want %d for both", td.ID, td.ProjectID, largeID)
+	}
+
+	td.Status = todo.StatusDone
+	updated, err := client.UpdateTodo(context.Background(), td.ID, td)
+	if err != nil {
+		t.Fatalf("UpdateTodo() error = %v", err)
+	}
+	if updated.ID != largeID || *updated.ProjectID != largeID {
+		t.Errorf("UpdateTodo() ID = %d, ProjectID = %d, want %d for both", updated.ID, *updated.ProjectID, largeID)
+	}
+}
+
+func TestJSONCodec_Unmarshal(t *testing.T) {
+	t.Parallel()
+
+	var v map[string]any
+	if err := (JSONCodec{}).Unmarshal([]byte(`{"id": 9007199254740993}`), &v); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := v["id"]; got != json.Number("9007199254740993") {
+		t.Errorf("id = %#v, want json.Number(\"9007199254740993\")", got)
+	}
+
+	if err := (JSONCodec{}).Unmarshal([]byte(`{"id": 1} {"id": 2}`), &v); err == nil {
+		t.Error("Unmarshal() with trailing data error = nil, want error")
+	}
+}
+
 func TestTodoClient_GetTodo_NotFound(t *testing.T) {
 	t.Parallel()
 
@@ -201,6 +396,82 @@ func TestTodoClient_CreateTodo(t *testing.T) {
 	}
 }
 
+// fakeProtoCodec stands in for a protobuf codec: it frames JSON with a magic
+// prefix so tests can tell it apart from the default JSONCodec on the wire.
+type fakeProtoCodec struct{}
+
+const fakeProtoMagic = "PB1:"
+
+func (fakeProtoCodec) ContentType() string { return "application/x-protobuf" }
+
+func (fakeProtoCodec) Marshal(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(fakeProtoMagic), b...), nil
+}
+
+func (fakeProtoCodec) Unmarshal(data []byte, v any) error {
+	rest, ok := bytes.CutPrefix(data, []byte(fakeProtoMagic))
+	if !ok {
+		return errors.New("missing proto magic")
+	}
+	return json.Unmarshal(rest, v)
+}
+
+func TestTodoClient_CreateTodo_CustomCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := fakeProtoCodec{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Type"); got != codec.ContentType() {
+			t.Errorf("Content-Type = %q, want %q", got, codec.ContentType())
+		}
+		if got := r.Header.Get("Accept"); got != codec.ContentType() {
+			t.Errorf("Accept = %q, want %q", got, codec.ContentType())
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		var req map[string]any
+		if err := codec.Unmarshal(body, &req); err != nil {
+			t.Fatalf("request not encoded with codec: %v", err)
+		}
+
+		resp, err := codec.Marshal(map[string]any{
+			"id": 11, "title": req["title"], "description": req["description"],
+			"status": "pending", "category": "personal",
+			"progress_percent": 0,
+			"created_at":       "2025-06-01T00:00:00Z",
+			"updated_at":       "2025-06-01T00:00:00Z",
+		})
+		if err != nil {
+			t.Fatalf("encoding response: %v", err)
+		}
+		w.Header().Set("Content-Type", codec.ContentType())
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(resp)
+	}))
+	defer ts.Close()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default(), WithCodec(codec))
+	created, err := client.CreateTodo(context.Background(), &todo.Todo{
+		Title:       "Proto todo",
+		Description: "Over the wire",
+		Status:      todo.StatusPending,
+		Category:    todo.CategoryPersonal,
+	})
+	if err != nil {
+		t.Fatalf("CreateTodo() error = %v", err)
+	}
+	if created.ID != 11 || created.Title != "Proto todo" {
+		t.Errorf("created = {ID: %d, Title: %q}, want {ID: 11, Title: %q}", created.ID, created.Title, "Proto todo")
+	}
+}
+
 func TestTodoClient_UpdateTodo(t *testing.T) {
 	t.Parallel()
 
@@ -279,6 +550,9 @@ func TestTodoClient_ListProjects(t *testing.T) {
 		if r.URL.Path != "/api/v1/groups" {
 			t.Errorf("unexpected path: %s", r.URL.Path)
 		}
+		if got := r.URL.Query().Get("sort"); got != "name" {
+			t.Errorf("sort = %q, want %q", got, "name")
+		}
 		w.Header().Set("Content-Type", "application/json")
 		writeJSON(t, w, map[string]any{
 			"groups": []map[string]any{{
@@ -292,7 +566,7 @@ func TestTodoClient_ListProjects(t *testing.T) {
 	defer ts.Close()
 
 	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default())
-	projects, err := client.ListProjects(context.Background())
+	projects, err := client.ListProjects(context.Background(), project.Filter{Sort: "name"})
 	if err != nil {
 		t.Fatalf("ListProjects() error = %v", err)
 	}
@@ -437,6 +711,28 @@ func TestTodoClient_GetProjectTodos(t *testing.T) {
 	}
 }
 
+func TestTodoClient_GetProjectTodos_NullTodos(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"todos": null, "count": 0}`))
+	}))
+	defer ts.Close()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default())
+	todos, err := client.GetProjectTodos(context.Background(), 2, todo.Filter{})
+	if err != nil {
+		t.Fatalf("GetProjectTodos() error = %v", err)
+	}
+	if todos == nil {
+		t.Fatal("GetProjectTodos() = nil, want empty slice")
+	}
+	if len(todos) != 0 {
+		t.Errorf("len(todos) = %d, want 0", len(todos))
+	}
+}
+
 // --- Validation error test ---
 
 func TestTodoClient_CreateTodo_ValidationError(t *testing.T) {
@@ -471,6 +767,92 @@ func TestTodoClient_CreateTodo_ValidationError(t *testing.T) {
 
 // --- Server error test ---
 
+func TestTodoClient_GetTodo_DeadlineExceeded(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default())
+	_, err := client.GetTodo(ctx, 1)
+	if !errors.Is(err, domain.ErrTimeout) {
+		t.Errorf("GetTodo() error = %v, want ErrTimeout", err)
+	}
+}
+
+func TestTodoClient_GetTodo_Canceled(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default())
+	_, err := client.GetTodo(ctx, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("GetTodo() error = %v, want context.Canceled", err)
+	}
+	if errors.Is(err, domain.ErrTimeout) {
+		t.Errorf("GetTodo() error = %v, want not ErrTimeout", err)
+	}
+}
+
+func TestTodoClient_GetTodo_ResponseTooLarge(t *testing.T) {
+	t.Parallel()
+
+	const limit = 1 << 10
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// Stream far more than the limit; the client must stop reading early.
+		chunk := bytes.Repeat([]byte(" "), 64<<10)
+		for range 64 {
+			if _, err := w.Write(chunk); err != nil {
+				return
+			}
+		}
+	}))
+	defer ts.Close()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default(), WithMaxResponseBytes(limit))
+	_, err := client.GetTodo(context.Background(), 1)
+	if !errors.Is(err, domain.ErrUnavailable) {
+		t.Errorf("GetTodo() error = %v, want ErrUnavailable", err)
+	}
+}
+
+func TestTodoClient_GetTodo_ResponseWithinLimit(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(t, w, map[string]any{
+			"id": 1, "title": "Small", "status": "pending", "category": "work",
+			"created_at": "2025-01-01T00:00:00Z",
+			"updated_at": "2025-01-01T00:00:00Z",
+		})
+	}))
+	defer ts.Close()
+
+	client := NewTodoClient(newTestClient(t, ts.URL), slog.Default(), WithMaxResponseBytes(1<<10))
+	if _, err := client.GetTodo(context.Background(), 1); err != nil {
+		t.Fatalf("GetTodo() error = %v", err)
+	}
+}
+
 func TestTodoClient_GetTodo_ServerError(t *testing.T) {
 	t.Parallel()
 
@@ -511,6 +893,31 @@ func TestFilterQuery(t *testing.T) {
 			filter: todo.Filter{Category: todo.CategoryWork},
 			want:   "?category=work",
 		},
+		{
+			name:   "search is trimmed and encoded",
+			filter: todo.Filter{Search: "  buy milk "},
+			want:   "?q=buy+milk",
+		},
+		{
+			name:   "blank search is omitted",
+			filter: todo.Filter{Search: "   "},
+			want:   "",
+		},
+		{
+			name:   "search combined with status",
+			filter: todo.Filter{Status: todo.StatusDone, Search: "report"},
+			want:   "?q=report&status=done",
+		},
+		{
+			name:   "ids are comma separated",
+			filter: todo.Filter{IDs: []int64{1, 2, 3}},
+			want:   "?ids=1%2C2%2C3",
+		},
+		{
+			name:   "duplicate ids are dropped in first-seen order",
+			filter: todo.Filter{IDs: []int64{3, 1, 3, 2, 1}},
+			want:   "?ids=3%2C1%2C2",
+		},
 	}
 
 	for _, tt := range tests {
@@ -524,3 +931,60 @@ func TestFilterQuery(t *testing.T) {
 		})
 	}
 }
+
+// --- Requester tests ---
+
+func TestRequester_DoForm(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/oauth/revoke" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/x-www-form-urlencoded" {
+			t.Errorf("Content-Type = %q, want form encoding", ct)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing form: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(t, w, map[string]any{
+			"token": r.PostForm.Get("token"),
+			"tags":  r.PostForm["tag"],
+		})
+	}))
+	defer ts.Close()
+
+	req := NewRequester(newTestClient(t, ts.URL), slog.Default())
+
+	var got struct {
+		Token string   `json:"token"`
+		Tags  []string `json:"tags"`
+	}
+	form := url.Values{"token": {"a b&c"}, "tag": {"x", "y"}}
+	if err := req.DoForm(context.Background(), http.MethodPost, "/oauth/revoke", form, &got); err != nil {
+		t.Fatalf("DoForm() error = %v", err)
+	}
+	if got.Token != "a b&c" || len(got.Tags) != 2 || got.Tags[0] != "x" || got.Tags[1] != "y" {
+		t.Errorf("DoForm() decoded %+v, want the form values echoed", got)
+	}
+}
+
+func TestRequester_DoForm_TranslatesErrors(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	req := NewRequester(newTestClient(t, ts.URL), slog.Default())
+
+	err := req.DoForm(context.Background(), http.MethodPost, "/missing", url.Values{}, nil)
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("DoForm() error = %v, want ErrNotFound", err)
+	}
+	if err := req.DoForm(context.Background(), http.MethodGet, "/missing", url.Values{}, nil); err == nil {
+		t.Error("DoForm(GET) error = nil, want unsupported method")
+	}
+}
@@ -0,0 +1,359 @@
+// Package memory provides an in-memory implementation of [ports.TodoClient]
+// for tests that exercise the application or HTTP layers without standing up
+// an HTTP server for the downstream TODO API.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
+)
+
+// Compile-time check that TodoClient implements ports.TodoClient.
+var _ ports.TodoClient = (*TodoClient)(nil)
+
+// TodoClient is a thread-safe, map-backed [ports.TodoClient]. It mirrors the
+// downstream API's observable behavior: IDs are assigned sequentially on
+// create, timestamps are set by the "server", invalid payloads return
+// [domain.ErrValidation], missing entities return [domain.ErrNotFound], and
+// deleting a project ungroups its todos.
+//
+// Entities are copied on the way in and out, so callers cannot mutate stored
+// state through returned pointers.
+type TodoClient struct {
+	mu            sync.Mutex
+	todos         map[int64]todo.Todo
+	projects      map[int64]project.Project
+	nextTodoID    int64
+	nextProjectID int64
+	clock         domain.Clock
+}
+
+// Option configures a TodoClient.
+type Option func(*TodoClient)
+
+// WithClock sets the clock used for server-assigned timestamps. The default
+// is domain.SystemClock.
+func WithClock(clock domain.Clock) Option {
+	return func(c *TodoClient) {
+		if clock != nil {
+			c.clock = clock
+		}
+	}
+}
+
+// NewTodoClient creates an empty in-memory TodoClient.
+func NewTodoClient(opts ...Option) *TodoClient {
+	c := &TodoClient{
+		todos:         make(map[int64]todo.Todo),
+		projects:      make(map[int64]project.Project),
+		nextTodoID:    1,
+		nextProjectID: 1,
+		clock:         domain.SystemClock{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// now returns the clock's time truncated to whole seconds, matching the
+// RFC 3339 precision of the downstream API.
+func (c *TodoClient) now() time.Time {
+	return c.clock.Now().Truncate(time.Second)
+}
+
+// --- Todo operations ---
+
+// ListTodos returns todos matching filter, ordered by ID. The filter's sort
+// is ignored.
+func (c *TodoClient) ListTodos(_ context.Context, filter todo.Filter) ([]todo.Todo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.matchingTodos(filter), nil
+}
+
+// GetTodo returns a todo by ID or [domain.ErrNotFound].
+func (c *TodoClient) GetTodo(_ context.Context, id int64) (*todo.Todo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t, ok := c.todos[id]
+	if !ok {
+		return nil, todoNotFound(id)
+	}
+	return &t, nil
+}
+
+// GetTodosByIDs returns the stored todos among ids, ordered by ID. Unknown
+// and duplicate IDs are ignored.
+func (c *TodoClient) GetTodosByIDs(_ context.Context, ids []int64) ([]todo.Todo, error) {
+	filter := todo.Filter{IDs: ids}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []todo.Todo{}, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.matchingTodos(filter), nil
+}
+
+// CreateTodo validates t, assigns an ID and timestamps, and stores it.
+// A ProjectID referencing a missing project is a validation error.
+func (c *TodoClient) CreateTodo(_ context.Context, t *todo.Todo) (*todo.Todo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.validateTodo(t); err != nil {
+		return nil, err
+	}
+
+	stored := cloneTodo(*t)
+	stored.ID = c.nextTodoID
+	c.nextTodoID++
+	stored.CreatedAt = c.now()
+	stored.UpdatedAt = stored.CreatedAt
+	c.todos[stored.ID] = stored
+
+	result := cloneTodo(stored)
+	return &result, nil
+}
+
+// UpdateTodo replaces all mutable fields of the todo with id.
+func (c *TodoClient) UpdateTodo(_ context.Context, id int64, t *todo.Todo) (*todo.Todo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	existing, ok := c.todos[id]
+	if !ok {
+		return nil, todoNotFound(id)
+	}
+	if err := c.validateTodo(t); err != nil {
+		return nil, err
+	}
+
+	stored := cloneTodo(*t)
+	stored.ID = id
+	stored.CreatedAt = existing.CreatedAt
+	stored.UpdatedAt = c.now()
+	c.todos[id] = stored
+
+	result := cloneTodo(stored)
+	return &result, nil
+}
+
+// DeleteTodo removes the todo with id.
+func (c *TodoClient) DeleteTodo(_ context.Context, id int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.todos[id]; !ok {
+		return todoNotFound(id)
+	}
+	delete(c.todos, id)
+	return nil
+}
+
+// --- Project operations ---
+
+// ListProjects returns all projects ordered by ID, without todos. The
+// filter's sort is ignored.
+func (c *TodoClient) ListProjects(_ context.Context, _ project.Filter) ([]project.Project, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := sortedKeys(c.projects)
+	projects := make([]project.Project, 0, len(ids))
+	for _, id := range ids {
+		projects = append(projects, c.projects[id])
+	}
+	return projects, nil
+}
+
+// GetProject returns a project by ID (without todos) or [domain.ErrNotFound].
+func (c *TodoClient) GetProject(_ context.Context, id int64) (*project.Project, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p, ok := c.projects[id]
+	if !ok {
+		return nil, projectNotFound(id)
+	}
+	return &p, nil
+}
+
+// CreateProject validates p, assigns an ID and timestamps, and stores it.
+func (c *TodoClient) CreateProject(_ context.Context, p *project.Project) (*project.Project, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	stored := project.Project{
+		ID:          c.nextProjectID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   c.now(),
+	}
+	stored.UpdatedAt = stored.CreatedAt
+	c.nextProjectID++
+	c.projects[stored.ID] = stored
+
+	return &stored, nil
+}
+
+// UpdateProject replaces the name, description, and archived flag of the
+// project with id.
+func (c *TodoClient) UpdateProject(_ context.Context, id int64, p *project.Project) (*project.Project, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	existing, ok := c.projects[id]
+	if !ok {
+		return nil, projectNotFound(id)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing.Name = p.Name
+	existing.Description = p.Description
+	existing.Archived = p.Archived
+	existing.UpdatedAt = c.now()
+	c.projects[id] = existing
+
+	return &existing, nil
+}
+
+// DeleteProject removes the project with id. Its todos become ungrouped.
+func (c *TodoClient) DeleteProject(_ context.Context, id int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.projects[id]; !ok {
+		return projectNotFound(id)
+	}
+	delete(c.projects, id)
+
+	for tid, t := range c.todos {
+		if t.ProjectID != nil && *t.ProjectID == id {
+			t.ProjectID = nil
+			c.todos[tid] = t
+		}
+	}
+	return nil
+}
+
+// GetProjectTodos returns the project's todos matching filter, ordered by ID.
+// The filter's ProjectID is ignored in favor of projectID.
+func (c *TodoClient) GetProjectTodos(_ context.Context, projectID int64, filter todo.Filter) ([]todo.Todo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.projects[projectID]; !ok {
+		return nil, projectNotFound(projectID)
+	}
+	filter.ProjectID = &projectID
+	return c.matchingTodos(filter), nil
+}
+
+// --- helpers ---
+
+// validateTodo applies entity validation plus the downstream's referential
+// check on the project. Caller must hold c.mu.
+func (c *TodoClient) validateTodo(t *todo.Todo) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	if t.ProjectID != nil {
+		if _, ok := c.projects[*t.ProjectID]; !ok {
+			return &domain.ValidationError{
+				Fields: map[string]string{"project_id": fmt.Sprintf("project %d does not exist", *t.ProjectID)},
+			}
+		}
+	}
+	return nil
+}
+
+// matchingTodos returns copies of todos matching filter, ordered by ID.
+// Caller must hold c.mu.
+func (c *TodoClient) matchingTodos(f todo.Filter) []todo.Todo {
+	search := strings.ToLower(strings.TrimSpace(f.Search))
+	var wantIDs map[int64]bool
+	if len(f.IDs) > 0 {
+		wantIDs = make(map[int64]bool, len(f.IDs))
+		for _, id := range f.IDs {
+			wantIDs[id] = true
+		}
+	}
+
+	result := make([]todo.Todo, 0)
+	for _, id := range sortedKeys(c.todos) {
+		t := c.todos[id]
+		if f.Status != "" && t.Status != f.Status {
+			continue
+		}
+		if f.Category != "" && t.Category != f.Category {
+			continue
+		}
+		if f.ProjectID != nil && (t.ProjectID == nil || *t.ProjectID != *f.ProjectID) {
+			continue
+		}
+		if wantIDs != nil && !wantIDs[t.ID] {
+			continue
+		}
+		if search != "" &&
+			!strings.Contains(strings.ToLower(t.Title), search) &&
+			!strings.Contains(strings.ToLower(t.Description), search) {
+			continue
+		}
+		result = append(result, cloneTodo(t))
+	}
+	return result
+}
+
+// cloneTodo returns a copy of t with its pointer fields detached.
+func cloneTodo(t todo.Todo) todo.Todo {
+	if t.ProjectID != nil {
+		id := *t.ProjectID
+		t.ProjectID = &id
+	}
+	if t.RecurrenceRule != nil {
+		rule := *t.RecurrenceRule
+		t.RecurrenceRule = &rule
+	}
+	return t
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys[V any](m map[int64]V) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func todoNotFound(id int64) error {
+	return fmt.Errorf("todo %d: %w", id, domain.ErrNotFound)
+}
+
+func projectNotFound(id int64) error {
+	return fmt.Errorf("project %d: %w", id, domain.ErrNotFound)
+}
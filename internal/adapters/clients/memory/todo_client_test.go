@@ -0,0 +1,284 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/clients/memory"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/app"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/domaintest"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+)
+
+func newTodo(title string, projectID *int64) *todo.Todo {
+	return &todo.Todo{
+		Title:       title,
+		Description: "desc",
+		Status:      todo.StatusPending,
+		Category:    todo.CategoryWork,
+		ProjectID:   projectID,
+	}
+}
+
+func mustCreateProject(t *testing.T, c *memory.TodoClient, name string) *project.Project {
+	t.Helper()
+	p, err := c.CreateProject(context.Background(), &project.Project{Name: name, Description: "desc"})
+	if err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	return p
+}
+
+func TestTodoClient_TodoCRUD(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := memory.NewTodoClient()
+
+	created, err := c.CreateTodo(ctx, newTodo("first", nil))
+	if err != nil {
+		t.Fatalf("CreateTodo() error = %v", err)
+	}
+	if created.ID != 1 {
+		t.Errorf("ID = %d, want 1", created.ID)
+	}
+	if created.CreatedAt.IsZero() {
+		t.Error("CreatedAt not set")
+	}
+
+	second, err := c.CreateTodo(ctx, newTodo("second", nil))
+	if err != nil {
+		t.Fatalf("CreateTodo() error = %v", err)
+	}
+	if second.ID != 2 {
+		t.Errorf("ID = %d, want 2", second.ID)
+	}
+
+	update := newTodo("renamed", nil)
+	update.Status = todo.StatusDone
+	updated, err := c.UpdateTodo(ctx, created.ID, update)
+	if err != nil {
+		t.Fatalf("UpdateTodo() error = %v", err)
+	}
+	if updated.Title != "renamed" || updated.Status != todo.StatusDone {
+		t.Errorf("updated = {%q, %q}, want {renamed, done}", updated.Title, updated.Status)
+	}
+	if !updated.CreatedAt.Equal(created.CreatedAt) {
+		t.Error("UpdateTodo changed CreatedAt")
+	}
+
+	done, err := c.ListTodos(ctx, todo.Filter{Status: todo.StatusDone})
+	if err != nil {
+		t.Fatalf("ListTodos() error = %v", err)
+	}
+	if len(done) != 1 || done[0].ID != created.ID {
+		t.Errorf("ListTodos(done) = %v, want only todo %d", done, created.ID)
+	}
+
+	if err := c.DeleteTodo(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteTodo() error = %v", err)
+	}
+	if _, err := c.GetTodo(ctx, created.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("GetTodo() after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestTodoClient_TimestampsFromClock(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	start := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	clock := domaintest.NewFakeClock(start)
+	c := memory.NewTodoClient(memory.WithClock(clock))
+
+	created, err := c.CreateTodo(ctx, newTodo("first", nil))
+	if err != nil {
+		t.Fatalf("CreateTodo() error = %v", err)
+	}
+	if !created.CreatedAt.Equal(start) || !created.UpdatedAt.Equal(start) {
+		t.Errorf("CreateTodo() timestamps = (%v, %v), want %v", created.CreatedAt, created.UpdatedAt, start)
+	}
+
+	clock.Advance(90 * time.Minute)
+
+	updated, err := c.UpdateTodo(ctx, created.ID, newTodo("renamed", nil))
+	if err != nil {
+		t.Fatalf("UpdateTodo() error = %v", err)
+	}
+	if want := start.Add(90 * time.Minute); !updated.UpdatedAt.Equal(want) {
+		t.Errorf("UpdatedAt = %v, want %v", updated.UpdatedAt, want)
+	}
+	if !updated.CreatedAt.Equal(start) {
+		t.Errorf("CreatedAt = %v, want %v", updated.CreatedAt, start)
+	}
+}
+
+func TestTodoClient_GetTodosByIDs(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := memory.NewTodoClient()
+
+	for _, title := range []string{"a", "b", "c"} {
+		if _, err := c.CreateTodo(ctx, newTodo(title, nil)); err != nil {
+			t.Fatalf("CreateTodo() error = %v", err)
+		}
+	}
+
+	got, err := c.GetTodosByIDs(ctx, []int64{3, 1, 3, 99})
+	if err != nil {
+		t.Fatalf("GetTodosByIDs() error = %v", err)
+	}
+	if len(got) != 2 || got[0].ID != 1 || got[1].ID != 3 {
+		t.Errorf("GetTodosByIDs() = %v, want todos 1 and 3", got)
+	}
+
+	none, err := c.GetTodosByIDs(ctx, nil)
+	if err != nil {
+		t.Fatalf("GetTodosByIDs(nil) error = %v", err)
+	}
+	if len(none) != 0 {
+		t.Errorf("GetTodosByIDs(nil) = %v, want empty", none)
+	}
+}
+
+func TestTodoClient_Errors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := memory.NewTodoClient()
+
+	tests := []struct {
+		name string
+		call func() error
+		want error
+	}{
+		{name: "get missing todo", call: func() error { _, err := c.GetTodo(ctx, 99); return err }, want: domain.ErrNotFound},
+		{name: "update missing todo", call: func() error { _, err := c.UpdateTodo(ctx, 99, newTodo("x", nil)); return err }, want: domain.ErrNotFound},
+		{name: "delete missing todo", call: func() error { return c.DeleteTodo(ctx, 99) }, want: domain.ErrNotFound},
+		{name: "create invalid todo", call: func() error { _, err := c.CreateTodo(ctx, &todo.Todo{}); return err }, want: domain.ErrValidation},
+		{name: "create todo in missing project", call: func() error {
+			pid := int64(42)
+			_, err := c.CreateTodo(ctx, newTodo("x", &pid))
+			return err
+		}, want: domain.ErrValidation},
+		{name: "get missing project", call: func() error { _, err := c.GetProject(ctx, 99); return err }, want: domain.ErrNotFound},
+		{name: "create invalid project", call: func() error { _, err := c.CreateProject(ctx, &project.Project{}); return err }, want: domain.ErrValidation},
+		{name: "project todos of missing project", call: func() error {
+			_, err := c.GetProjectTodos(ctx, 99, todo.Filter{})
+			return err
+		}, want: domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.call(); !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTodoClient_ProjectCRUD(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := memory.NewTodoClient()
+
+	p := mustCreateProject(t, c, "Sprint")
+	updated, err := c.UpdateProject(ctx, p.ID, &project.Project{Name: "Sprint 2", Description: "next", Archived: true})
+	if err != nil {
+		t.Fatalf("UpdateProject() error = %v", err)
+	}
+	if updated.Name != "Sprint 2" {
+		t.Errorf("Name = %q, want %q", updated.Name, "Sprint 2")
+	}
+	if !updated.Archived {
+		t.Error("Archived = false, want true")
+	}
+
+	projects, err := c.ListProjects(ctx, project.Filter{})
+	if err != nil {
+		t.Fatalf("ListProjects() error = %v", err)
+	}
+	if len(projects) != 1 {
+		t.Errorf("len(ListProjects()) = %d, want 1", len(projects))
+	}
+}
+
+func TestTodoClient_DeleteProjectUngroupsTodos(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := memory.NewTodoClient()
+
+	p := mustCreateProject(t, c, "Sprint")
+	td, err := c.CreateTodo(ctx, newTodo("grouped", &p.ID))
+	if err != nil {
+		t.Fatalf("CreateTodo() error = %v", err)
+	}
+
+	if err := c.DeleteProject(ctx, p.ID); err != nil {
+		t.Fatalf("DeleteProject() error = %v", err)
+	}
+
+	got, err := c.GetTodo(ctx, td.ID)
+	if err != nil {
+		t.Fatalf("GetTodo() error = %v", err)
+	}
+	if got.ProjectID != nil {
+		t.Errorf("ProjectID = %d, want nil after project deletion", *got.ProjectID)
+	}
+}
+
+func TestTodoClient_ReturnsCopies(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := memory.NewTodoClient()
+
+	p := mustCreateProject(t, c, "Sprint")
+	created, err := c.CreateTodo(ctx, newTodo("original", &p.ID))
+	if err != nil {
+		t.Fatalf("CreateTodo() error = %v", err)
+	}
+	created.Title = "mutated"
+	*created.ProjectID = 999
+
+	got, err := c.GetTodo(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetTodo() error = %v", err)
+	}
+	if got.Title != "original" || *got.ProjectID != p.ID {
+		t.Errorf("stored todo changed through returned pointer: %+v", got)
+	}
+}
+
+func TestTodoClient_OwnershipThroughProjectService(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := memory.NewTodoClient()
+	svc := app.NewProjectService(c, nil)
+
+	a := mustCreateProject(t, c, "A")
+	b := mustCreateProject(t, c, "B")
+
+	td, err := svc.AddTodo(ctx, a.ID, newTodo("in A", nil))
+	if err != nil {
+		t.Fatalf("AddTodo() error = %v", err)
+	}
+
+	projectTodos, err := c.GetProjectTodos(ctx, a.ID, todo.Filter{})
+	if err != nil {
+		t.Fatalf("GetProjectTodos() error = %v", err)
+	}
+	if len(projectTodos) != 1 || projectTodos[0].ID != td.ID {
+		t.Errorf("GetProjectTodos(A) = %v, want only todo %d", projectTodos, td.ID)
+	}
+
+	if err := svc.RemoveTodo(ctx, b.ID, td.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("RemoveTodo(other project) error = %v, want ErrNotFound", err)
+	}
+	if err := svc.RemoveTodo(ctx, a.ID, td.ID); err != nil {
+		t.Errorf("RemoveTodo(owning project) error = %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// EnvelopeParam is the Accept media type parameter that opts a request into
+// the response envelope, e.g. "Accept: application/json; envelope=true".
+// Without it responses keep their unwrapped shape.
+const EnvelopeParam = "envelope"
+
+// Envelope wraps a response body under "data" alongside request metadata.
+type Envelope struct {
+	Data any          `json:"data"`
+	Meta EnvelopeMeta `json:"meta"`
+}
+
+// EnvelopeMeta carries per-request metadata in an Envelope.
+type EnvelopeMeta struct {
+	RequestID  string  `json:"request_id,omitempty"`
+	DurationMS float64 `json:"duration_ms"`
+}
+
+// NewEnvelope nests data under an Envelope with the given metadata.
+func NewEnvelope(data any, meta EnvelopeMeta) Envelope {
+	return Envelope{Data: data, Meta: meta}
+}
+
+// WantsEnvelope reports whether any media range in the request's Accept
+// header carries EnvelopeParam set to a true boolean value.
+func WantsEnvelope(r *http.Request) bool {
+	for part := range strings.SplitSeq(r.Header.Get("Accept"), ",") {
+		_, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if v, ok := params[EnvelopeParam]; ok {
+			if enabled, err := strconv.ParseBool(v); err == nil && enabled {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package dto_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+)
+
+func TestEnvelope_TodoResponseSerialization(t *testing.T) {
+	t.Parallel()
+
+	td := validTodo()
+	resp := dto.ToTodoResponse(&td)
+
+	t.Run("unwrapped", func(t *testing.T) {
+		t.Parallel()
+
+		raw, err := json.Marshal(resp)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+
+		var got map[string]any
+		if err := json.Unmarshal(raw, &got); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if _, ok := got["data"]; ok {
+			t.Error("unwrapped response should not have a data key")
+		}
+		if got["title"] != "Buy groceries" {
+			t.Errorf("title = %v, want %q", got["title"], "Buy groceries")
+		}
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		t.Parallel()
+
+		env := dto.NewEnvelope(resp, dto.EnvelopeMeta{RequestID: "req-1", DurationMS: 1.5})
+		raw, err := json.Marshal(env)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+
+		var got struct {
+			Data dto.TodoResponse `json:"data"`
+			Meta dto.EnvelopeMeta `json:"meta"`
+		}
+		if err := json.Unmarshal(raw, &got); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if got.Data.Title != "Buy groceries" {
+			t.Errorf("data.title = %q, want %q", got.Data.Title, "Buy groceries")
+		}
+		if got.Data.ID != 1 {
+			t.Errorf("data.id = %d, want 1", got.Data.ID)
+		}
+		if got.Meta.RequestID != "req-1" {
+			t.Errorf("meta.request_id = %q, want %q", got.Meta.RequestID, "req-1")
+		}
+		if got.Meta.DurationMS != 1.5 {
+			t.Errorf("meta.duration_ms = %v, want 1.5", got.Meta.DurationMS)
+		}
+	})
+}
+
+func TestWantsEnvelope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		accept string
+		want   bool
+	}{
+		{name: "no accept header", accept: "", want: false},
+		{name: "plain json", accept: "application/json", want: false},
+		{name: "envelope true", accept: "application/json; envelope=true", want: true},
+		{name: "envelope false", accept: "application/json; envelope=false", want: false},
+		{name: "envelope invalid", accept: "application/json; envelope=maybe", want: false},
+		{name: "envelope on second range", accept: "text/html, application/json;envelope=1", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			if got := dto.WantsEnvelope(r); got != tt.want {
+				t.Errorf("WantsEnvelope(%q) = %v, want %v", tt.accept, got, tt.want)
+			}
+		})
+	}
+}
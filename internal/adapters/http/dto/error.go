@@ -1,23 +1,46 @@
 package dto
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"log/slog"
 	"net/http"
-	"sort"
+
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 )
 
+// StatusClientClosedRequest is the non-standard status (popularised by
+// nginx) reported when the caller canceled the request before it finished.
+// The client has usually gone away, so it mostly shows up in logs and
+// metrics rather than on the wire.
+const StatusClientClosedRequest = 499
+
 // ErrorResponse represents an RFC 9457 Problem Details response.
+// RequestID and TraceID are extension members for correlating a response
+// with logs and traces; each is omitted when the request context lacks it.
 type ErrorResponse struct {
-	Type     string        `json:"type"`
-	Title    string        `json:"title"`
-	Status   int           `json:"status"`
-	Detail   string        `json:"detail,omitempty"`
-	Instance string        `json:"instance,omitempty"`
-	Errors   []ErrorDetail `json:"errors,omitempty"`
+	Type      string        `json:"type"`
+	Title     string        `json:"title"`
+	Status    int           `json:"status"`
+	Detail    string        `json:"detail,omitempty"`
+	Instance  string        `json:"instance,omitempty"`
+	Errors    []ErrorDetail `json:"errors,omitempty"`
+	RequestID string        `json:"request_id,omitempty"`
+	TraceID   string        `json:"trace_id,omitempty"`
+}
+
+// requestIDKey is the context key for the request ID reported in error
+// responses. dto keeps its own key, like httpclient, so it does not depend
+// on the middleware package.
+type requestIDKey struct{}
+
+// WithRequestID returns a new context carrying the request ID reported in
+// the request_id member of error responses. middleware.WithRequestID calls
+// it for every request.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
 }
 
 // ErrorDetail represents a single field-level validation error within
@@ -29,36 +52,43 @@ type ErrorDetail struct {
 }
 
 // NewErrorResponse creates an RFC 9457 ErrorResponse from a domain error.
-// The request is used to populate the instance field with the request URI.
+// The request is used to populate the instance field with the request URI,
+// and the request_id and trace_id extensions from its context.
 func NewErrorResponse(r *http.Request, err error) ErrorResponse {
 	status := domainErrorToStatus(err)
 
 	resp := ErrorResponse{
 		Type:     "about:blank",
-		Title:    http.StatusText(status),
+		Title:    statusTitle(status),
 		Status:   status,
 		Detail:   err.Error(),
 		Instance: r.RequestURI,
 	}
 
+	ctx := r.Context()
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+		resp.RequestID = id
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		resp.TraceID = sc.TraceID().String()
+	}
+
 	var verr *domain.ValidationError
 	if errors.As(err, &verr) {
-		resp.Errors = validationFieldsToDetails(verr.Fields)
+		resp.Errors = validationFieldsToDetails(verr)
 	}
 
 	return resp
 }
 
 // WriteErrorResponse writes an RFC 9457 error response for the given domain
-// error. It sets the Content-Type to application/problem+json, writes the
-// appropriate HTTP status code, and marshals the error body as JSON.
+// error. It writes the appropriate HTTP status code and marshals the error
+// body as application/problem+json, or as YAML when the client prefers it
+// (see WantsYAML).
 func WriteErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
 	resp := NewErrorResponse(r, err)
 
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(resp.Status)
-
-	if encErr := json.NewEncoder(w).Encode(resp); encErr != nil {
+	if encErr := WriteBody(w, r, resp.Status, "application/problem+json", resp); encErr != nil {
 		slog.ErrorContext(r.Context(), "failed to encode error response",
 			slog.Any("error", encErr),
 		)
@@ -72,29 +102,50 @@ func domainErrorToStatus(err error) int {
 		return http.StatusBadRequest
 	case errors.Is(err, domain.ErrNotFound):
 		return http.StatusNotFound
+	case errors.Is(err, domain.ErrUnauthenticated):
+		return http.StatusUnauthorized
 	case errors.Is(err, domain.ErrForbidden):
 		return http.StatusForbidden
 	case errors.Is(err, domain.ErrConflict):
 		return http.StatusConflict
 	case errors.Is(err, domain.ErrUnavailable):
 		return http.StatusBadGateway
+	case errors.Is(err, domain.ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, context.Canceled):
+		return StatusClientClosedRequest
+	case errors.Is(err, domain.ErrURITooLong):
+		return http.StatusRequestURITooLong
+	case errors.Is(err, domain.ErrOverloaded):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, domain.ErrPayloadTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, domain.ErrRateLimited):
+		return http.StatusTooManyRequests
 	default:
 		return http.StatusInternalServerError
 	}
 }
 
-// validationFieldsToDetails converts domain validation fields to sorted
-// ErrorDetail entries.
-func validationFieldsToDetails(fields map[string]string) []ErrorDetail {
+// statusTitle returns the problem title for status, covering the
+// non-standard codes net/http has no text for.
+func statusTitle(status int) string {
+	if status == StatusClientClosedRequest {
+		return "Client Closed Request"
+	}
+	return http.StatusText(status)
+}
+
+// validationFieldsToDetails converts domain validation fields to ErrorDetail
+// entries sorted by location, using the field order from SortedFields.
+func validationFieldsToDetails(verr *domain.ValidationError) []ErrorDetail {
+	fields := verr.SortedFields()
 	details := make([]ErrorDetail, 0, len(fields))
-	for field, msg := range fields {
+	for _, f := range fields {
 		details = append(details, ErrorDetail{
-			Location: "body." + field,
-			Message:  msg,
+			Location: "body." + f.Field,
+			Message:  f.Message,
 		})
 	}
-	sort.Slice(details, func(i, j int) bool {
-		return details[i].Location < details[j].Location
-	})
 	return details
 }
@@ -1,6 +1,7 @@
 package dto_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,8 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 )
@@ -51,6 +54,54 @@ func TestNewErrorResponse_StatusMapping(t *testing.T) {
 			wantStatus: http.StatusBadGateway,
 			wantTitle:  "Bad Gateway",
 		},
+		{
+			name:       "ErrTimeout maps to 504",
+			err:        domain.ErrTimeout,
+			wantStatus: http.StatusGatewayTimeout,
+			wantTitle:  "Gateway Timeout",
+		},
+		{
+			name:       "context.DeadlineExceeded maps to 504",
+			err:        fmt.Errorf("GET /api/v1/todos: %w", context.DeadlineExceeded),
+			wantStatus: http.StatusGatewayTimeout,
+			wantTitle:  "Gateway Timeout",
+		},
+		{
+			name:       "context.Canceled maps to 499",
+			err:        fmt.Errorf("GET /api/v1/todos: %w", context.Canceled),
+			wantStatus: dto.StatusClientClosedRequest,
+			wantTitle:  "Client Closed Request",
+		},
+		{
+			name:       "ErrURITooLong maps to 414",
+			err:        domain.ErrURITooLong,
+			wantStatus: http.StatusRequestURITooLong,
+			wantTitle:  "Request URI Too Long",
+		},
+		{
+			name:       "ErrOverloaded maps to 503",
+			err:        domain.ErrOverloaded,
+			wantStatus: http.StatusServiceUnavailable,
+			wantTitle:  "Service Unavailable",
+		},
+		{
+			name:       "ErrPayloadTooLarge maps to 413",
+			err:        domain.ErrPayloadTooLarge,
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantTitle:  "Request Entity Too Large",
+		},
+		{
+			name:       "ErrRateLimited maps to 429",
+			err:        domain.ErrRateLimited,
+			wantStatus: http.StatusTooManyRequests,
+			wantTitle:  "Too Many Requests",
+		},
+		{
+			name:       "ErrUnauthenticated maps to 401",
+			err:        domain.ErrUnauthenticated,
+			wantStatus: http.StatusUnauthorized,
+			wantTitle:  "Unauthorized",
+		},
 		{
 			name:       "unknown error maps to 500",
 			err:        errors.New("oops"),
@@ -216,3 +267,55 @@ func TestWriteErrorResponse_ValidJSON(t *testing.T) {
 		t.Errorf("Errors[0].Message = %q, want %q", resp.Errors[0].Message, "is required")
 	}
 }
+
+func TestNewErrorResponse_Extensions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request ID and trace ID from context", func(t *testing.T) {
+		t.Parallel()
+
+		traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  spanID,
+		}))
+		ctx = dto.WithRequestID(ctx, "req-123")
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todos/99", http.NoBody)
+		rec := httptest.NewRecorder()
+		dto.WriteErrorResponse(rec, r, domain.ErrNotFound)
+
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+		}
+		var got map[string]any
+		if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+			t.Fatalf("decode error = %v", err)
+		}
+		if got["request_id"] != "req-123" {
+			t.Errorf("request_id = %v, want %q", got["request_id"], "req-123")
+		}
+		if got["trace_id"] != traceID.String() {
+			t.Errorf("trace_id = %v, want %q", got["trace_id"], traceID.String())
+		}
+	})
+
+	t.Run("omitted when absent", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/todos/99", http.NoBody)
+		rec := httptest.NewRecorder()
+		dto.WriteErrorResponse(rec, r, domain.ErrNotFound)
+
+		var got map[string]any
+		if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+			t.Fatalf("decode error = %v", err)
+		}
+		for _, key := range []string{"request_id", "trace_id"} {
+			if _, ok := got[key]; ok {
+				t.Errorf("%s present = %v, want omitted", key, got[key])
+			}
+		}
+	})
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// jsonIndent is the per-level indent for pretty-printed responses.
+const jsonIndent = "  "
+
+type indentKey struct{}
+
+// WithIndentedJSON returns a context under which EncodeJSON pretty-prints its
+// output. It is set per request by the PrettyJSON middleware.
+func WithIndentedJSON(ctx context.Context) context.Context {
+	return context.WithValue(ctx, indentKey{}, true)
+}
+
+// IndentedJSON reports whether ctx was marked by WithIndentedJSON.
+func IndentedJSON(ctx context.Context) bool {
+	indent, _ := ctx.Value(indentKey{}).(bool)
+	return indent
+}
+
+// EncodeJSON writes v to w as JSON followed by a newline, indented when ctx
+// was marked by WithIndentedJSON and compact otherwise. Every response body
+// the HTTP adapter writes goes through here.
+func EncodeJSON(ctx context.Context, w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	if IndentedJSON(ctx) {
+		enc.SetIndent("", jsonIndent)
+	}
+	return enc.Encode(v)
+}
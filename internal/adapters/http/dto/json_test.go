@@ -0,0 +1,37 @@
+package dto_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+)
+
+func TestEncodeJSON(t *testing.T) {
+	t.Parallel()
+
+	v := map[string]int{"count": 1}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "compact by default", ctx: context.Background(), want: "{\"count\":1}\n"},
+		{name: "indented when marked", ctx: dto.WithIndentedJSON(context.Background()), want: "{\n  \"count\": 1\n}\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if err := dto.EncodeJSON(tt.ctx, &buf, v); err != nil {
+				t.Fatalf("EncodeJSON() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("EncodeJSON() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+)
+
+// ApplyTodoUpdate returns a copy of existing with every non-nil field of req
+// applied. Nil fields keep their existing value, so the result is a complete
+// entity suitable for full validation and a whole-resource downstream write.
+// existing is not modified.
+func ApplyTodoUpdate(existing *todo.Todo, req UpdateTodoRequest) *todo.Todo {
+	merged := *existing
+	applyField(&merged.Title, req.Title)
+	applyField(&merged.Description, req.Description)
+	applyConverted(&merged.Status, req.Status)
+	applyConverted(&merged.Category, req.Category)
+	applyField(&merged.ProgressPercent, req.ProgressPercent)
+	return &merged
+}
+
+// ApplyProjectUpdate returns a copy of existing with every non-nil field of
+// req applied. It is the project counterpart of ApplyTodoUpdate.
+func ApplyProjectUpdate(existing *project.Project, req UpdateProjectRequest) *project.Project {
+	merged := *existing
+	applyField(&merged.Name, req.Name)
+	applyField(&merged.Description, req.Description)
+	return &merged
+}
+
+// applyField overwrites *dst with *src when src is non-nil.
+func applyField[T any](dst, src *T) {
+	if src != nil {
+		*dst = *src
+	}
+}
+
+// applyConverted overwrites *dst with *src converted to the destination's
+// named type (e.g. a string to todo.Status) when src is non-nil.
+func applyConverted[T ~string](dst *T, src *string) {
+	if src != nil {
+		*dst = T(*src)
+	}
+}
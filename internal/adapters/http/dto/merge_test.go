@@ -0,0 +1,152 @@
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+)
+
+func existingTodo() todo.Todo {
+	projectID := int64(7)
+	return todo.Todo{
+		ID:              1,
+		Title:           "Buy groceries",
+		Description:     "Milk, eggs, bread",
+		Status:          todo.StatusPending,
+		Category:        todo.CategoryPersonal,
+		ProgressPercent: 10,
+		ProjectID:       &projectID,
+		CreatedAt:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestApplyTodoUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		req    dto.UpdateTodoRequest
+		modify func(*todo.Todo)
+	}{
+		{
+			name:   "empty request leaves todo unchanged",
+			req:    dto.UpdateTodoRequest{},
+			modify: func(_ *todo.Todo) {},
+		},
+		{
+			name:   "title applied",
+			req:    dto.UpdateTodoRequest{Title: stringPtr("New title")},
+			modify: func(td *todo.Todo) { td.Title = "New title" },
+		},
+		{
+			name:   "description applied",
+			req:    dto.UpdateTodoRequest{Description: stringPtr("New desc")},
+			modify: func(td *todo.Todo) { td.Description = "New desc" },
+		},
+		{
+			name:   "status applied",
+			req:    dto.UpdateTodoRequest{Status: stringPtr("done")},
+			modify: func(td *todo.Todo) { td.Status = todo.StatusDone },
+		},
+		{
+			name:   "category applied",
+			req:    dto.UpdateTodoRequest{Category: stringPtr("work")},
+			modify: func(td *todo.Todo) { td.Category = todo.CategoryWork },
+		},
+		{
+			name:   "progress applied",
+			req:    dto.UpdateTodoRequest{ProgressPercent: intPtr(80)},
+			modify: func(td *todo.Todo) { td.ProgressPercent = 80 },
+		},
+		{
+			name:   "explicit zero progress applied",
+			req:    dto.UpdateTodoRequest{ProgressPercent: intPtr(0)},
+			modify: func(td *todo.Todo) { td.ProgressPercent = 0 },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			existing := existingTodo()
+			want := existingTodo()
+			tt.modify(&want)
+
+			got := dto.ApplyTodoUpdate(&existing, tt.req)
+
+			if got.Title != want.Title || got.Description != want.Description ||
+				got.Status != want.Status || got.Category != want.Category ||
+				got.ProgressPercent != want.ProgressPercent {
+				t.Errorf("ApplyTodoUpdate() = %+v, want %+v", *got, want)
+			}
+			if got.ID != want.ID || *got.ProjectID != *want.ProjectID || !got.CreatedAt.Equal(want.CreatedAt) {
+				t.Errorf("ApplyTodoUpdate() changed fields outside the request: %+v", *got)
+			}
+			if existing.Title != existingTodo().Title || existing.ProgressPercent != existingTodo().ProgressPercent {
+				t.Errorf("ApplyTodoUpdate() modified existing: %+v", existing)
+			}
+		})
+	}
+}
+
+func TestApplyProjectUpdate(t *testing.T) {
+	t.Parallel()
+
+	base := project.Project{ID: 1, Name: "Sprint 1", Description: "First sprint tasks"}
+
+	tests := []struct {
+		name     string
+		req      dto.UpdateProjectRequest
+		wantName string
+		wantDesc string
+	}{
+		{
+			name:     "empty request leaves project unchanged",
+			req:      dto.UpdateProjectRequest{},
+			wantName: "Sprint 1",
+			wantDesc: "First sprint tasks",
+		},
+		{
+			name:     "name applied",
+			req:      dto.UpdateProjectRequest{Name: stringPtr("Sprint 2")},
+			wantName: "Sprint 2",
+			wantDesc: "First sprint tasks",
+		},
+		{
+			name:     "description applied",
+			req:      dto.UpdateProjectRequest{Description: stringPtr("Second sprint")},
+			wantName: "Sprint 1",
+			wantDesc: "Second sprint",
+		},
+		{
+			name:     "both applied",
+			req:      dto.UpdateProjectRequest{Name: stringPtr("Sprint 2"), Description: stringPtr("Second sprint")},
+			wantName: "Sprint 2",
+			wantDesc: "Second sprint",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			existing := base
+			got := dto.ApplyProjectUpdate(&existing, tt.req)
+
+			if got.Name != tt.wantName || got.Description != tt.wantDesc {
+				t.Errorf("ApplyProjectUpdate() = {Name: %q, Description: %q}, want {Name: %q, Description: %q}",
+					got.Name, got.Description, tt.wantName, tt.wantDesc)
+			}
+			if got.ID != base.ID {
+				t.Errorf("ApplyProjectUpdate().ID = %d, want %d", got.ID, base.ID)
+			}
+			if existing.Name != base.Name || existing.Description != base.Description {
+				t.Errorf("ApplyProjectUpdate() modified existing: %+v", existing)
+			}
+		})
+	}
+}
@@ -14,8 +14,33 @@ const (
 
 	// maxBulkUpdateItems is the maximum number of items in a bulk update request.
 	maxBulkUpdateItems = 20
+	// maxBulkDeleteItems is the maximum number of IDs in a bulk delete request.
+	maxBulkDeleteItems = 20
 )
 
+// validLogLevels are the level names accepted by the log-level admin endpoint.
+var validLogLevels = map[string]bool{"debug": true, "info": true, "warn": true, "error": true}
+
+// LogLevelRequest represents the JSON body for changing the runtime log level.
+type LogLevelRequest struct {
+	Level string `json:"level"`
+}
+
+// Validate checks that the level is one of debug, info, warn, or error
+// (case-insensitive). Returns a *domain.ValidationError if it is not.
+func (r *LogLevelRequest) Validate() error {
+	level := strings.ToLower(strings.TrimSpace(r.Level))
+	if level == "" {
+		return &domain.ValidationError{Fields: map[string]string{"level": msgRequired}}
+	}
+	if !validLogLevels[level] {
+		return &domain.ValidationError{
+			Fields: map[string]string{"level": "must be one of: debug, info, warn, error"},
+		}
+	}
+	return nil
+}
+
 // CreateProjectRequest represents the JSON body for creating a new project.
 type CreateProjectRequest struct {
 	Name        string `json:"name"`
@@ -77,12 +102,22 @@ type CreateTodoRequest struct {
 // Validate checks that required fields are present and optional fields have
 // valid values. Returns a *domain.ValidationError if any checks fail.
 func (r *CreateTodoRequest) Validate() error {
+	return r.validate(false)
+}
+
+// ValidateDraft is Validate for a draft todo, which may omit its
+// description.
+func (r *CreateTodoRequest) ValidateDraft() error {
+	return r.validate(true)
+}
+
+func (r *CreateTodoRequest) validate(draft bool) error {
 	fields := make(map[string]string)
 
 	if strings.TrimSpace(r.Title) == "" {
 		fields["title"] = msgRequired
 	}
-	if strings.TrimSpace(r.Description) == "" {
+	if !draft && strings.TrimSpace(r.Description) == "" {
 		fields["description"] = msgRequired
 	}
 	if r.Status != "" && !todo.Status(r.Status).IsValid() {
@@ -138,6 +173,54 @@ func (r *UpdateTodoRequest) Validate() error {
 	return nil
 }
 
+// ReplaceTodoRequest represents the JSON body for replacing a TODO item with
+// PUT. Unlike UpdateTodoRequest every field is required: the downstream
+// entity is overwritten as a whole, so an omitted field is an error rather
+// than "leave unchanged".
+type ReplaceTodoRequest struct {
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	Status          string `json:"status"`
+	Category        string `json:"category"`
+	ProgressPercent *int   `json:"progress_percent"`
+}
+
+// Validate checks that every field is present and valid.
+// Returns a *domain.ValidationError if any checks fail.
+func (r *ReplaceTodoRequest) Validate() error {
+	fields := make(map[string]string)
+
+	if strings.TrimSpace(r.Title) == "" {
+		fields["title"] = msgRequired
+	}
+	if strings.TrimSpace(r.Description) == "" {
+		fields["description"] = msgRequired
+	}
+	switch {
+	case r.Status == "":
+		fields["status"] = msgRequired
+	case !todo.Status(r.Status).IsValid():
+		fields["status"] = fmt.Sprintf("invalid: %q", r.Status)
+	}
+	switch {
+	case r.Category == "":
+		fields["category"] = msgRequired
+	case !todo.Category(r.Category).IsValid():
+		fields["category"] = fmt.Sprintf("invalid: %q", r.Category)
+	}
+	switch {
+	case r.ProgressPercent == nil:
+		fields["progress_percent"] = msgRequired
+	case *r.ProgressPercent < 0 || *r.ProgressPercent > 100:
+		fields["progress_percent"] = fmt.Sprintf("must be 0-100, got %d", *r.ProgressPercent)
+	}
+
+	if len(fields) > 0 {
+		return &domain.ValidationError{Fields: fields}
+	}
+	return nil
+}
+
 // BulkUpdateTodoItem represents a single item within a bulk update request.
 // It pairs a todo ID with optional fields to update (same fields as UpdateTodoRequest).
 type BulkUpdateTodoItem struct {
@@ -208,3 +291,39 @@ func (r *BulkUpdateTodosRequest) Validate() error {
 	}
 	return nil
 }
+
+// BulkDeleteTodosRequest represents the JSON body for deleting several todos
+// from a project at once.
+type BulkDeleteTodosRequest struct {
+	TodoIDs []int64 `json:"todo_ids"`
+}
+
+// Validate checks that the request names at least one todo, does not exceed
+// the maximum batch size, and contains only positive, distinct IDs.
+func (r *BulkDeleteTodosRequest) Validate() error {
+	fields := make(map[string]string)
+
+	if len(r.TodoIDs) == 0 {
+		fields["todo_ids"] = "must not be empty"
+	}
+	if len(r.TodoIDs) > maxBulkDeleteItems {
+		fields["todo_ids"] = fmt.Sprintf("exceeds maximum of %d items", maxBulkDeleteItems)
+	}
+
+	seen := make(map[int64]bool, len(r.TodoIDs))
+	for i, id := range r.TodoIDs {
+		key := fmt.Sprintf("todo_ids[%d]", i)
+		switch {
+		case id <= 0:
+			fields[key] = "must be a positive integer"
+		case seen[id]:
+			fields[key] = fmt.Sprintf("duplicate todo ID %d", id)
+		}
+		seen[id] = true
+	}
+
+	if len(fields) > 0 {
+		return &domain.ValidationError{Fields: fields}
+	}
+	return nil
+}
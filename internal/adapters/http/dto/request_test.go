@@ -187,6 +187,19 @@ func TestCreateTodoRequest_Validate(t *testing.T) {
 	}
 }
 
+func TestCreateTodoRequest_ValidateDraft(t *testing.T) {
+	t.Parallel()
+
+	req := dto.CreateTodoRequest{Title: "Buy groceries"}
+	if err := req.ValidateDraft(); err != nil {
+		t.Errorf("ValidateDraft() = %v, want nil without a description", err)
+	}
+	requireValidationField(t, req.Validate(), "description")
+
+	req.Category = "bad"
+	requireValidationField(t, req.ValidateDraft(), "category")
+}
+
 func TestCreateTodoRequest_Validate_MultipleErrors(t *testing.T) {
 	t.Parallel()
 
@@ -454,6 +467,64 @@ func TestBulkUpdateTodosRequest_Validate(t *testing.T) {
 	}
 }
 
+func TestBulkDeleteTodosRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	tooMany := make([]int64, 21)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 1)
+	}
+
+	tests := []struct {
+		name      string
+		req       dto.BulkDeleteTodosRequest
+		wantErr   bool
+		wantField string
+	}{
+		{
+			name:    "valid request passes",
+			req:     dto.BulkDeleteTodosRequest{TodoIDs: []int64{1, 2, 3}},
+			wantErr: false,
+		},
+		{
+			name:      "empty todo IDs fails",
+			req:       dto.BulkDeleteTodosRequest{},
+			wantErr:   true,
+			wantField: "todo_ids",
+		},
+		{
+			name:      "exceeds max items fails",
+			req:       dto.BulkDeleteTodosRequest{TodoIDs: tooMany},
+			wantErr:   true,
+			wantField: "todo_ids",
+		},
+		{
+			name:      "non-positive todo ID fails",
+			req:       dto.BulkDeleteTodosRequest{TodoIDs: []int64{1, 0}},
+			wantErr:   true,
+			wantField: "todo_ids[1]",
+		},
+		{
+			name:      "duplicate todo IDs fails",
+			req:       dto.BulkDeleteTodosRequest{TodoIDs: []int64{4, 4}},
+			wantErr:   true,
+			wantField: "todo_ids[1]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.req.Validate()
+			if tt.wantErr {
+				requireValidationField(t, err, tt.wantField)
+			} else if err != nil {
+				t.Errorf("Validate() = %v, want nil", err)
+			}
+		})
+	}
+}
+
 func TestUpdateTodoRequest_Validate(t *testing.T) {
 	t.Parallel()
 
@@ -559,3 +630,48 @@ func TestUpdateTodoRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestReplaceTodoRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	full := func() dto.ReplaceTodoRequest {
+		return dto.ReplaceTodoRequest{
+			Title:           "Buy groceries",
+			Description:     "Milk, eggs, bread",
+			Status:          "pending",
+			Category:        "personal",
+			ProgressPercent: intPtr(0),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		mutate    func(*dto.ReplaceTodoRequest)
+		wantErr   bool
+		wantField string
+	}{
+		{name: "complete request passes", mutate: func(*dto.ReplaceTodoRequest) {}},
+		{name: "missing title fails", mutate: func(r *dto.ReplaceTodoRequest) { r.Title = "" }, wantErr: true, wantField: "title"},
+		{name: "missing description fails", mutate: func(r *dto.ReplaceTodoRequest) { r.Description = "  " }, wantErr: true, wantField: "description"},
+		{name: "missing status fails", mutate: func(r *dto.ReplaceTodoRequest) { r.Status = "" }, wantErr: true, wantField: "status"},
+		{name: "invalid status fails", mutate: func(r *dto.ReplaceTodoRequest) { r.Status = "bad" }, wantErr: true, wantField: "status"},
+		{name: "missing category fails", mutate: func(r *dto.ReplaceTodoRequest) { r.Category = "" }, wantErr: true, wantField: "category"},
+		{name: "invalid category fails", mutate: func(r *dto.ReplaceTodoRequest) { r.Category = "bad" }, wantErr: true, wantField: "category"},
+		{name: "missing progress fails", mutate: func(r *dto.ReplaceTodoRequest) { r.ProgressPercent = nil }, wantErr: true, wantField: "progress_percent"},
+		{name: "progress over 100 fails", mutate: func(r *dto.ReplaceTodoRequest) { r.ProgressPercent = intPtr(101) }, wantErr: true, wantField: "progress_percent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := full()
+			tt.mutate(&req)
+			err := req.Validate()
+			if tt.wantErr {
+				requireValidationField(t, err, tt.wantField)
+			} else if err != nil {
+				t.Errorf("Validate() = %v, want nil", err)
+			}
+		})
+	}
+}
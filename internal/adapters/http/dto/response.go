@@ -3,10 +3,12 @@
 package dto
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/paging"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
 )
 
@@ -15,15 +17,22 @@ type ProjectResponse struct {
 	ID          int64          `json:"id"`
 	Name        string         `json:"name"`
 	Description string         `json:"description"`
+	Archived    bool           `json:"archived"`
+	OwnerID     string         `json:"owner_id,omitempty"`
 	Todos       []TodoResponse `json:"todos,omitempty"`
-	CreatedAt   string         `json:"created_at"`
-	UpdatedAt   string         `json:"updated_at"`
+	// TodosUnavailable is true when the project was served without its
+	// todos because they failed to load. The cause is logged, not exposed.
+	TodosUnavailable bool   `json:"todos_unavailable,omitempty"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
 }
 
 // ProjectListResponse represents a list of projects in HTTP responses.
 type ProjectListResponse struct {
 	Projects []ProjectResponse `json:"projects"`
 	Count    int               `json:"count"`
+	// Page describes the page Projects holds, when the list is paginated.
+	Page *paging.Meta `json:"page,omitempty"`
 }
 
 // ToProjectResponse converts a domain Project entity to an HTTP response DTO.
@@ -33,8 +42,12 @@ func ToProjectResponse(p *project.Project) ProjectResponse {
 		ID:          p.ID,
 		Name:        p.Name,
 		Description: p.Description,
+		Archived:    p.Archived,
+		OwnerID:     p.OwnerID,
 		CreatedAt:   p.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:   p.UpdatedAt.Format(time.RFC3339),
+
+		TodosUnavailable: p.TodosLoadError != nil,
 	}
 
 	if len(p.Todos) > 0 {
@@ -68,10 +81,19 @@ type TodoResponse struct {
 	Status          string `json:"status"`
 	Category        string `json:"category"`
 	ProgressPercent int    `json:"progress_percent"`
+	ProjectID       *int64 `json:"project_id,omitempty"`
 	CreatedAt       string `json:"created_at"`
 	UpdatedAt       string `json:"updated_at"`
 }
 
+// TodoListResponse represents a list of TODO items in HTTP responses.
+type TodoListResponse struct {
+	Todos []TodoResponse `json:"todos"`
+	Count int            `json:"count"`
+	// Page describes the page Todos holds, when the list is paginated.
+	Page *paging.Meta `json:"page,omitempty"`
+}
+
 // ToTodoResponse converts a domain Todo entity to an HTTP response DTO.
 func ToTodoResponse(t *todo.Todo) TodoResponse {
 	return TodoResponse{
@@ -81,11 +103,25 @@ func ToTodoResponse(t *todo.Todo) TodoResponse {
 		Status:          t.Status.String(),
 		Category:        t.Category.String(),
 		ProgressPercent: t.ProgressPercent,
+		ProjectID:       t.ProjectID,
 		CreatedAt:       t.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:       t.UpdatedAt.Format(time.RFC3339),
 	}
 }
 
+// ToTodoListResponse converts a slice of domain Todo entities to an HTTP
+// list response DTO.
+func ToTodoListResponse(todos []todo.Todo) TodoListResponse {
+	items := make([]TodoResponse, len(todos))
+	for i := range todos {
+		items[i] = ToTodoResponse(&todos[i])
+	}
+	return TodoListResponse{
+		Todos: items,
+		Count: len(items),
+	}
+}
+
 // BulkUpdateTodosResponse represents the result of a bulk update operation.
 // It includes both successful updates and per-item errors.
 type BulkUpdateTodosResponse struct {
@@ -126,3 +162,93 @@ func ToBulkUpdateResponse(result *ports.BulkUpdateResult) BulkUpdateTodosRespons
 		Failed:    len(result.Errors),
 	}
 }
+
+// BulkResultResponse reports the per-item outcomes of a non-atomic bulk
+// operation (?atomic=false). It is sent with 207 Multi-Status; each item
+// carries its own status code, in request order.
+type BulkResultResponse struct {
+	Results   []BulkItemResult `json:"results"`
+	Total     int              `json:"total"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+}
+
+// BulkItemResult is the outcome of one item in a BulkResultResponse. Todo is
+// set for a successful update; Error is set for any failure.
+type BulkItemResult struct {
+	TodoID int64         `json:"todo_id"`
+	Status int           `json:"status"`
+	Todo   *TodoResponse `json:"todo,omitempty"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// ToBulkUpdateResultResponse converts a ports.BulkUpdateResult to a
+// BulkResultResponse, ordering items by todoIDs as they were requested.
+// Successful items are 200 with the updated todo; failures carry the status
+// their error maps to.
+func ToBulkUpdateResultResponse(todoIDs []int64, result *ports.BulkUpdateResult) BulkResultResponse {
+	updated := make(map[int64]*todo.Todo, len(result.Updated))
+	for i := range result.Updated {
+		updated[result.Updated[i].ID] = &result.Updated[i]
+	}
+	return newBulkResultResponse(todoIDs, result.Errors, func(id int64) BulkItemResult {
+		item := BulkItemResult{TodoID: id, Status: http.StatusOK}
+		if td, ok := updated[id]; ok {
+			resp := ToTodoResponse(td)
+			item.Todo = &resp
+		}
+		return item
+	})
+}
+
+// ToBulkRemoveResultResponse converts a ports.BulkRemoveResult to a
+// BulkResultResponse, ordering items by todoIDs as they were requested.
+// Removed items are 204; failures carry the status their error maps to.
+func ToBulkRemoveResultResponse(todoIDs []int64, result *ports.BulkRemoveResult) BulkResultResponse {
+	return newBulkResultResponse(todoIDs, result.Errors, func(id int64) BulkItemResult {
+		return BulkItemResult{TodoID: id, Status: http.StatusNoContent}
+	})
+}
+
+// newBulkResultResponse builds a BulkResultResponse for todoIDs, using errs
+// for failed items and success for the rest.
+func newBulkResultResponse(todoIDs []int64, errs []ports.BulkUpdateError, success func(int64) BulkItemResult) BulkResultResponse {
+	failed := make(map[int64]error, len(errs))
+	for _, e := range errs {
+		failed[e.TodoID] = e.Err
+	}
+
+	resp := BulkResultResponse{Results: make([]BulkItemResult, len(todoIDs)), Total: len(todoIDs)}
+	for i, id := range todoIDs {
+		if err, ok := failed[id]; ok {
+			resp.Results[i] = BulkItemResult{TodoID: id, Status: domainErrorToStatus(err), Error: err.Error()}
+			resp.Failed++
+			continue
+		}
+		resp.Results[i] = success(id)
+		resp.Succeeded++
+	}
+	return resp
+}
+
+// LogLevelResponse reports the active log level after a change.
+type LogLevelResponse struct {
+	Level string `json:"level"`
+}
+
+// HealthCheckResult reports one health checker's most recent run in the
+// readiness response.
+type HealthCheckResult struct {
+	Name       string    `json:"name"`
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	DurationMS float64   `json:"duration_ms"`
+	CheckedAt  time.Time `json:"checked_at"`
+}
+
+// BreakerStateResponse reports a downstream circuit breaker's state after an
+// operator override.
+type BreakerStateResponse struct {
+	Service string `json:"service"`
+	State   string `json:"state"`
+}
@@ -2,6 +2,7 @@ package dto_test
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -196,6 +197,19 @@ func TestToProjectResponse(t *testing.T) {
 		if got.Todos != nil {
 			t.Errorf("Todos = %v, want nil (omitted)", got.Todos)
 		}
+		if got.TodosUnavailable {
+			t.Error("TodosUnavailable = true, want false")
+		}
+	})
+
+	t.Run("flags todos unavailable on load error", func(t *testing.T) {
+		t.Parallel()
+		p := validProject()
+		p.TodosLoadError = errors.New("downstream down")
+		got := dto.ToProjectResponse(&p)
+		if !got.TodosUnavailable {
+			t.Error("TodosUnavailable = false, want true")
+		}
 	})
 }
 
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// MediaTypeYAML is the Content-Type of responses negotiated as YAML.
+const MediaTypeYAML = "application/yaml"
+
+// yamlMediaTypes are the Accept media types that select YAML.
+var yamlMediaTypes = []string{MediaTypeYAML, "application/x-yaml", "text/yaml"}
+
+// WantsYAML reports whether the request's Accept header prefers YAML over
+// JSON: some YAML media type must carry a higher quality value than every
+// range that also matches JSON, wildcards included. JSON wins ties, so it
+// stays the default.
+func WantsYAML(r *http.Request) bool {
+	var yamlQ, jsonQ float64
+	for part := range strings.SplitSeq(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if raw, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(raw, 64); err != nil {
+				continue
+			}
+		}
+		switch {
+		case slices.Contains(yamlMediaTypes, mediaType):
+			yamlQ = max(yamlQ, q)
+		case mediaType == "*/*", mediaType == "application/*",
+			mediaType == "application/json", mediaType == "application/problem+json":
+			jsonQ = max(jsonQ, q)
+		}
+	}
+	return yamlQ > 0 && yamlQ > jsonQ
+}
+
+// EncodeYAML writes v to w as YAML. Field names and omissions follow the
+// json struct tags, so a YAML body carries the same fields as its JSON
+// counterpart.
+func EncodeYAML(w io.Writer, v any) error {
+	return yaml.NewEncoder(w, yaml.UseJSONMarshaler()).Encode(v)
+}
+
+// WriteBody writes status and v as the response, encoded as YAML when
+// WantsYAML and otherwise as JSON (see EncodeJSON) with the given
+// Content-Type. The returned error is from encoding, after the status has
+// been written.
+func WriteBody(w http.ResponseWriter, r *http.Request, status int, jsonContentType string, v any) error {
+	if WantsYAML(r) {
+		w.Header().Set("Content-Type", MediaTypeYAML)
+		w.WriteHeader(status)
+		return EncodeYAML(w, v)
+	}
+	w.Header().Set("Content-Type", jsonContentType)
+	w.WriteHeader(status)
+	return EncodeJSON(r.Context(), w, v)
+}
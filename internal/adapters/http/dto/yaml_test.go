@@ -0,0 +1,118 @@
+package dto_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+)
+
+func TestWantsYAML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		accept string
+		want   bool
+	}{
+		{"no accept", "", false},
+		{"yaml", "application/yaml", true},
+		{"x-yaml", "application/x-yaml", true},
+		{"text yaml", "text/yaml", true},
+		{"json", "application/json", false},
+		{"wildcard", "*/*", false},
+		{"tie prefers json", "application/json, application/yaml", false},
+		{"wildcard preferred", "application/yaml;q=0.5, */*", false},
+		{"yaml preferred", "application/json;q=0.5, application/yaml", true},
+		{"yaml rejected", "application/yaml;q=0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/todos", http.NoBody)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			if got := dto.WantsYAML(r); got != tt.want {
+				t.Errorf("WantsYAML(%q) = %v, want %v", tt.accept, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteBody_YAMLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	projectID := int64(7)
+	want := dto.TodoResponse{
+		ID:              42,
+		Title:           "Write docs",
+		Description:     "Cover YAML responses",
+		Status:          "in_progress",
+		Category:        "work",
+		ProgressPercent: 50,
+		ProjectID:       &projectID,
+		CreatedAt:       "2024-01-01T00:00:00Z",
+		UpdatedAt:       "2024-01-02T00:00:00Z",
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/todos/42", http.NoBody)
+	r.Header.Set("Accept", "application/yaml")
+
+	if err := dto.WriteBody(w, r, http.StatusOK, "application/json", want); err != nil {
+		t.Fatalf("WriteBody() error = %v", err)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != dto.MediaTypeYAML {
+		t.Errorf("Content-Type = %q, want %q", ct, dto.MediaTypeYAML)
+	}
+
+	var got dto.TodoResponse
+	if err := yaml.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v\nbody:\n%s", err, w.Body.String())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip = %+v, want %+v", got, want)
+	}
+}
+
+func TestWriteBody_DefaultsToJSON(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/todos/42", http.NoBody)
+
+	if err := dto.WriteBody(w, r, http.StatusOK, "application/json", dto.TodoResponse{ID: 42}); err != nil {
+		t.Fatalf("WriteBody() error = %v", err)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want %q", ct, "application/json")
+	}
+}
+
+func TestWriteErrorResponse_YAML(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/todos/42", http.NoBody)
+	r.Header.Set("Accept", "application/yaml")
+
+	dto.WriteErrorResponse(w, r, domain.ErrNotFound)
+
+	if ct := w.Header().Get("Content-Type"); ct != dto.MediaTypeYAML {
+		t.Errorf("Content-Type = %q, want %q", ct, dto.MediaTypeYAML)
+	}
+	var got dto.ErrorResponse
+	if err := yaml.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v\nbody:\n%s", err, w.Body.String())
+	}
+	if got.Status != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", got.Status, http.StatusNotFound)
+	}
+}
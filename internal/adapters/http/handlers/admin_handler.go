@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
+)
+
+// AdminHandler handles operational endpoints under /admin.
+type AdminHandler struct {
+	logLevel *slog.LevelVar
+	logger   *slog.Logger
+	breakers map[string]ports.CircuitBreaker
+}
+
+// NewAdminHandler creates an AdminHandler. The logLevel is the variable
+// backing the shared logger's minimum level. The breakers are the downstream
+// circuit breakers operators may override, keyed by Name. If logger is nil,
+// a no-op logger is used.
+func NewAdminHandler(logLevel *slog.LevelVar, logger *slog.Logger, breakers ...ports.CircuitBreaker) *AdminHandler {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	byName := make(map[string]ports.CircuitBreaker, len(breakers))
+	for _, b := range breakers {
+		byName[b.Name()] = b
+	}
+	return &AdminHandler{logLevel: logLevel, logger: logger, breakers: byName}
+}
+
+// SetLogLevel handles POST /admin/log-level. It swaps the shared logger's
+// minimum level at runtime and returns the new level.
+func (h *AdminHandler) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+	var req dto.LogLevelRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(req.Level))); err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	previous := h.logLevel.Level()
+	h.logLevel.Set(level)
+	h.logger.InfoContext(r.Context(), "log level changed",
+		slog.String("from", previous.String()),
+		slog.String("to", level.String()),
+	)
+
+	writeJSON(w, r, http.StatusOK, dto.LogLevelResponse{Level: strings.ToLower(level.String())})
+}
+
+// SetBreakerState handles POST /admin/breakers/{service}/{action}, where
+// action is "open" or "close". It forces the named downstream's circuit
+// breaker open, or resets it to closed, and returns the resulting state.
+// An unknown service is a 404; an unknown action is a 400.
+func (h *AdminHandler) SetBreakerState(w http.ResponseWriter, r *http.Request) {
+	service := chi.URLParam(r, "service")
+	breaker, ok := h.breakers[service]
+	if !ok {
+		dto.WriteErrorResponse(w, r, fmt.Errorf("circuit breaker %q: %w", service, domain.ErrNotFound))
+		return
+	}
+
+	action := chi.URLParam(r, "action")
+	switch action {
+	case "open":
+		breaker.ForceOpen()
+	case "close":
+		breaker.ForceClose()
+	default:
+		dto.WriteErrorResponse(w, r, &domain.ValidationError{
+			Fields: map[string]string{"action": "must be one of: open, close"},
+		})
+		return
+	}
+
+	state := breaker.BreakerState()
+	h.logger.WarnContext(r.Context(), "circuit breaker overridden",
+		slog.String("service", service),
+		slog.String("action", action),
+		slog.String("state", state),
+	)
+
+	writeJSON(w, r, http.StatusOK, dto.BreakerStateResponse{Service: service, State: state})
+}
@@ -0,0 +1,151 @@
+package handlers_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/handlers"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/logging"
+	"github.com/jsamuelsen11/go-service-template-v2/mocks"
+)
+
+// --- SetLogLevel ---
+
+func TestSetLogLevel_EnablesDebugOnSharedLogger(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger, levelVar := logging.NewWithLevel("info", "json", &buf)
+	h := handlers.NewAdminHandler(levelVar, nil)
+
+	logger.Debug("before switch")
+	if strings.Contains(buf.String(), "before switch") {
+		t.Fatal("debug record emitted at info level")
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/admin/log-level", jsonBody(t, dto.LogLevelRequest{Level: "debug"}))
+	req.Header.Set("Content-Type", "application/json")
+	h.SetLogLevel(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[dto.LogLevelResponse](t, rec)
+	if resp.Level != "debug" {
+		t.Errorf("Level = %q, want %q", resp.Level, "debug")
+	}
+
+	logger.Debug("after switch")
+	if !strings.Contains(buf.String(), "after switch") {
+		t.Errorf("debug record missing after switch; output = %s", buf.String())
+	}
+}
+
+func TestSetLogLevel_InvalidLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		level string
+	}{
+		{name: "empty", level: ""},
+		{name: "unknown", level: "verbose"},
+		{name: "numeric offset", level: "info+2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, levelVar := logging.NewWithLevel("info", "json", &bytes.Buffer{})
+			h := handlers.NewAdminHandler(levelVar, nil)
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/log-level", jsonBody(t, dto.LogLevelRequest{Level: tt.level}))
+			req.Header.Set("Content-Type", "application/json")
+			h.SetLogLevel(rec, req)
+
+			requireStatus(t, rec, http.StatusBadRequest)
+			if got := levelVar.Level().String(); got != "INFO" {
+				t.Errorf("level = %s, want INFO (unchanged)", got)
+			}
+		})
+	}
+}
+
+// --- SetBreakerState ---
+
+func breakerRequest(service, action string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/admin/breakers/"+service+"/"+action, http.NoBody)
+	return withChiParams(req, map[string]string{"service": service, "action": action})
+}
+
+func TestSetBreakerState_ForceOpen(t *testing.T) {
+	t.Parallel()
+
+	breaker := mocks.NewMockCircuitBreaker(t)
+	breaker.EXPECT().Name().Return("todo-api")
+	breaker.EXPECT().ForceOpen().Return()
+	breaker.EXPECT().BreakerState().Return("forced-open")
+	h := handlers.NewAdminHandler(new(slog.LevelVar), nil, breaker)
+
+	rec := httptest.NewRecorder()
+	h.SetBreakerState(rec, breakerRequest("todo-api", "open"))
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[dto.BreakerStateResponse](t, rec)
+	if resp.Service != "todo-api" || resp.State != "forced-open" {
+		t.Errorf("response = %+v, want todo-api forced-open", resp)
+	}
+}
+
+func TestSetBreakerState_ForceClose(t *testing.T) {
+	t.Parallel()
+
+	breaker := mocks.NewMockCircuitBreaker(t)
+	breaker.EXPECT().Name().Return("todo-api")
+	breaker.EXPECT().ForceClose().Return()
+	breaker.EXPECT().BreakerState().Return("closed")
+	h := handlers.NewAdminHandler(new(slog.LevelVar), nil, breaker)
+
+	rec := httptest.NewRecorder()
+	h.SetBreakerState(rec, breakerRequest("todo-api", "close"))
+
+	requireStatus(t, rec, http.StatusOK)
+	if resp := decodeJSON[dto.BreakerStateResponse](t, rec); resp.State != "closed" {
+		t.Errorf("State = %q, want %q", resp.State, "closed")
+	}
+}
+
+func TestSetBreakerState_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		service    string
+		action     string
+		wantStatus int
+	}{
+		{name: "unknown service", service: "billing-api", action: "open", wantStatus: http.StatusNotFound},
+		{name: "unknown action", service: "todo-api", action: "reset", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			breaker := mocks.NewMockCircuitBreaker(t)
+			breaker.EXPECT().Name().Return("todo-api")
+			h := handlers.NewAdminHandler(new(slog.LevelVar), nil, breaker)
+
+			rec := httptest.NewRecorder()
+			h.SetBreakerState(rec, breakerRequest(tt.service, tt.action))
+
+			requireStatus(t, rec, tt.wantStatus)
+		})
+	}
+}
@@ -3,11 +3,13 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
 )
 
 const (
 	statusOK       = "ok"
+	statusFailing  = "failing"
 	statusReady    = "ready"
 	statusNotReady = "not_ready"
 )
@@ -23,23 +25,34 @@ func NewHealthHandler(registry ports.HealthRegistry) *HealthHandler {
 }
 
 // Liveness handles GET /health/live. Always returns 200 OK.
-func (h *HealthHandler) Liveness(w http.ResponseWriter, _ *http.Request) {
-	writeJSON(w, http.StatusOK, map[string]string{"status": statusOK})
+func (h *HealthHandler) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, r, http.StatusOK, map[string]string{"status": statusOK})
 }
 
 // Readiness handles GET /health/ready. Returns 200 if all checks pass,
-// 503 if any check fails.
+// 503 if any check fails. Alongside the per-check summary, the body lists
+// each checker's latest result from the registry snapshot with its
+// duration and when it ran, which may be a cached result.
 func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
-	results := h.registry.CheckAll(r.Context())
+	snapshot := h.registry.Snapshot(r.Context())
 
-	checks := make(map[string]string, len(results))
+	checks := make(map[string]string, len(snapshot))
+	results := make([]dto.HealthCheckResult, len(snapshot))
 	healthy := true
-	for name, err := range results {
-		if err != nil {
-			checks[name] = err.Error()
+	for i, res := range snapshot {
+		results[i] = dto.HealthCheckResult{
+			Name:       res.Name,
+			Status:     statusOK,
+			DurationMS: float64(res.Duration.Microseconds()) / 1000,
+			CheckedAt:  res.CheckedAt,
+		}
+		if res.Err != nil {
+			results[i].Status = statusFailing
+			results[i].Error = res.Err.Error()
+			checks[res.Name] = res.Err.Error()
 			healthy = false
 		} else {
-			checks[name] = statusOK
+			checks[res.Name] = statusOK
 		}
 	}
 
@@ -50,8 +63,9 @@ func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
 		code = http.StatusServiceUnavailable
 	}
 
-	writeJSON(w, code, map[string]any{
-		"status": status,
-		"checks": checks,
+	writeJSON(w, r, code, map[string]any{
+		"status":  status,
+		"checks":  checks,
+		"results": results,
 	})
 }
@@ -5,10 +5,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/handlers"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
 	"github.com/jsamuelsen11/go-service-template-v2/mocks"
 )
 
@@ -38,8 +41,8 @@ func TestReadiness_AllHealthy(t *testing.T) {
 	t.Parallel()
 
 	registry := mocks.NewMockHealthRegistry(t)
-	registry.EXPECT().CheckAll(mock.Anything).Return(map[string]error{
-		"todo-api": nil,
+	registry.EXPECT().Snapshot(mock.Anything).Return([]ports.HealthResult{
+		{Name: "todo-api"},
 	})
 
 	h := handlers.NewHealthHandler(registry)
@@ -67,9 +70,9 @@ func TestReadiness_Unhealthy(t *testing.T) {
 	t.Parallel()
 
 	registry := mocks.NewMockHealthRegistry(t)
-	registry.EXPECT().CheckAll(mock.Anything).Return(map[string]error{
-		"todo-api": errors.New("connection refused"),
-		"database": nil,
+	registry.EXPECT().Snapshot(mock.Anything).Return([]ports.HealthResult{
+		{Name: "todo-api", Err: errors.New("connection refused")},
+		{Name: "database"},
 	})
 
 	h := handlers.NewHealthHandler(registry)
@@ -100,7 +103,7 @@ func TestReadiness_NoCheckers(t *testing.T) {
 	t.Parallel()
 
 	registry := mocks.NewMockHealthRegistry(t)
-	registry.EXPECT().CheckAll(mock.Anything).Return(map[string]error{})
+	registry.EXPECT().Snapshot(mock.Anything).Return(nil)
 
 	h := handlers.NewHealthHandler(registry)
 
@@ -110,3 +113,41 @@ func TestReadiness_NoCheckers(t *testing.T) {
 
 	requireStatus(t, rec, http.StatusOK)
 }
+
+func TestReadiness_ReportsSnapshotResults(t *testing.T) {
+	t.Parallel()
+
+	checkedAt := time.Date(2026, 2, 12, 15, 4, 5, 0, time.UTC)
+	registry := mocks.NewMockHealthRegistry(t)
+	registry.EXPECT().Snapshot(mock.Anything).Return([]ports.HealthResult{
+		{Name: "config", Duration: 50 * time.Microsecond, CheckedAt: checkedAt},
+		{Name: "todo-api", Err: errors.New("connection refused"), Duration: 1500 * time.Microsecond, CheckedAt: checkedAt},
+	})
+
+	h := handlers.NewHealthHandler(registry)
+
+	rec := httptest.NewRecorder()
+	h.Readiness(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+
+	requireStatus(t, rec, http.StatusServiceUnavailable)
+
+	resp := decodeJSON[struct {
+		Results []dto.HealthCheckResult `json:"results"`
+	}](t, rec)
+	if len(resp.Results) != 2 {
+		t.Fatalf("len(results) = %d, want 2", len(resp.Results))
+	}
+	want := dto.HealthCheckResult{
+		Name:       "todo-api",
+		Status:     "failing",
+		Error:      "connection refused",
+		DurationMS: 1.5,
+		CheckedAt:  checkedAt,
+	}
+	if got := resp.Results[1]; got != want {
+		t.Errorf("results[1] = %+v, want %+v", got, want)
+	}
+	if got := resp.Results[0]; got.Status != "ok" || got.Error != "" {
+		t.Errorf("results[0] = %+v, want ok with no error", got)
+	}
+}
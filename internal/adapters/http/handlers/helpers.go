@@ -1,16 +1,25 @@
 package handlers
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/logging"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/paging"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
 )
 
@@ -26,6 +35,72 @@ func parseID(r *http.Request, param string) (int64, error) {
 	return id, nil
 }
 
+// parsePage parses the limit, offset, and cursor query parameters, reporting
+// invalid ones as a *domain.ValidationError.
+func parsePage(r *http.Request) (paging.Page, error) {
+	page, err := paging.Parse(r.URL.Query())
+	var verr *paging.ValidationError
+	if errors.As(err, &verr) {
+		return paging.Page{}, &domain.ValidationError{Fields: verr.Fields}
+	}
+	return page, err
+}
+
+// withDryRun returns the request context, marked as a dry run when the
+// dry_run query parameter is true. An unparsable value is a validation error.
+func withDryRun(r *http.Request) (context.Context, error) {
+	raw := r.URL.Query().Get("dry_run")
+	if raw == "" {
+		return r.Context(), nil
+	}
+	dryRun, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, &domain.ValidationError{
+			Fields: map[string]string{"dry_run": "must be a boolean"},
+		}
+	}
+	if !dryRun {
+		return r.Context(), nil
+	}
+	return appctx.WithDryRun(r.Context()), nil
+}
+
+// parseAtomic reads the atomic query parameter of a bulk operation. It
+// defaults to true; an unparsable value is a validation error.
+func parseAtomic(r *http.Request) (bool, error) {
+	raw := r.URL.Query().Get("atomic")
+	if raw == "" {
+		return true, nil
+	}
+	atomic, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, &domain.ValidationError{
+			Fields: map[string]string{"atomic": "must be a boolean"},
+		}
+	}
+	return atomic, nil
+}
+
+// withDraft returns ctx, marked with appctx.WithDraft when the draft query
+// parameter is true, and whether it was. An unparsable value is a
+// validation error.
+func withDraft(ctx context.Context, r *http.Request) (context.Context, bool, error) {
+	raw := r.URL.Query().Get("draft")
+	if raw == "" {
+		return ctx, false, nil
+	}
+	draft, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, false, &domain.ValidationError{
+			Fields: map[string]string{"draft": "must be a boolean"},
+		}
+	}
+	if !draft {
+		return ctx, false, nil
+	}
+	return appctx.WithDraft(ctx), true, nil
+}
+
 // mapCreateTodoRequest converts a CreateTodoRequest DTO to a domain Todo entity.
 func mapCreateTodoRequest(req *dto.CreateTodoRequest) *todo.Todo {
 	t := &todo.Todo{
@@ -44,34 +119,104 @@ func mapCreateTodoRequest(req *dto.CreateTodoRequest) *todo.Todo {
 	return t
 }
 
-// mapUpdateTodoRequest converts an UpdateTodoRequest DTO to a domain Todo entity.
-func mapUpdateTodoRequest(req *dto.UpdateTodoRequest) *todo.Todo {
-	t := &todo.Todo{}
-	if req.Title != nil {
-		t.Title = *req.Title
+// mapReplaceTodoRequest converts a validated ReplaceTodoRequest DTO to a
+// domain Todo entity.
+func mapReplaceTodoRequest(req *dto.ReplaceTodoRequest) *todo.Todo {
+	return &todo.Todo{
+		Title:           req.Title,
+		Description:     req.Description,
+		Status:          todo.Status(req.Status),
+		Category:        todo.Category(req.Category),
+		ProgressPercent: *req.ProgressPercent,
+	}
+}
+
+// APIBasePath is the prefix the versioned API routes are mounted under.
+const APIBasePath = "/api/v1"
+
+// setLocation points the Location header of a 201 response at the canonical
+// path of the created resource, APIBasePath/{collection}/{id}.
+func setLocation(w http.ResponseWriter, collection string, id int64) {
+	w.Header().Set("Location", APIBasePath+"/"+collection+"/"+strconv.FormatInt(id, 10))
+}
+
+// DegradedHeader is set to "true" on responses served from a fallback
+// because the downstream was unavailable (see appctx.MarkDegraded).
+const DegradedHeader = "X-Degraded"
+
+// setDegradedHeader flags the response as degraded if the service marked the
+// request's RequestContext.
+func setDegradedHeader(w http.ResponseWriter, r *http.Request) {
+	if appctx.IsDegraded(r.Context()) {
+		w.Header().Set(DegradedHeader, "true")
 	}
-	if req.Description != nil {
-		t.Description = *req.Description
+}
+
+// writeJSON writes a JSON response with the given status code, encoded with
+// dto.EncodeJSON so it is indented where the PrettyJSON middleware is on.
+// Clients that prefer YAML in their Accept header get the same body as YAML
+// (see dto.WriteBody).
+// The status is already on the wire by the time encoding can fail, so an
+// encode or write error is only logged with the request's logger; no second
+// status or error body is attempted.
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v any) {
+	if err := dto.WriteBody(w, r, status, "application/json", v); err != nil {
+		logging.FromContext(r.Context()).ErrorContext(r.Context(), "failed to encode response",
+			slog.Int("status", status),
+			slog.Any("error", err),
+		)
 	}
-	if req.Status != nil {
-		t.Status = todo.Status(*req.Status)
+}
+
+// writeResponse writes a resource response as JSON. When the client opts in
+// via the Accept header (see dto.WantsEnvelope), v is nested under a
+// dto.Envelope carrying the request ID and elapsed time.
+func writeResponse(w http.ResponseWriter, r *http.Request, status int, v any) {
+	if dto.WantsEnvelope(r) {
+		v = dto.NewEnvelope(v, envelopeMeta(r))
 	}
-	if req.Category != nil {
-		t.Category = todo.Category(*req.Category)
+	writeJSON(w, r, status, v)
+}
+
+// envelopeMeta builds the envelope metadata for r. The duration is measured
+// from RequestContext creation and is zero when the appctx middleware is not
+// active.
+func envelopeMeta(r *http.Request) dto.EnvelopeMeta {
+	meta := dto.EnvelopeMeta{RequestID: middleware.RequestIDFromContext(r.Context())}
+	if rc := appctx.FromContext(r.Context()); rc != nil {
+		meta.DurationMS = float64(time.Since(rc.Started()).Microseconds()) / 1000
 	}
-	if req.ProgressPercent != nil {
-		t.ProgressPercent = *req.ProgressPercent
+	return meta
+}
+
+// etag returns a strong ETag for the JSON representation of v.
+func etag(v any) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", err
 	}
-	return t
+	sum := sha256.Sum256(raw)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`, nil
 }
 
-// writeJSON writes a JSON response with the given status code.
-func writeJSON(w http.ResponseWriter, status int, v any) {
+// writeHead answers a HEAD request for the resource representation v: it
+// sets Content-Type and an ETag computed from v's JSON encoding, then writes
+// status with no body.
+func writeHead(w http.ResponseWriter, r *http.Request, status int, v any) {
+	tag, err := etag(v)
+	if err != nil {
+		logging.FromContext(r.Context()).ErrorContext(r.Context(), "failed to compute etag", slog.Any("error", err))
+	} else {
+		w.Header().Set("ETag", tag)
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
-	if err := json.NewEncoder(w).Encode(v); err != nil {
-		slog.Error("failed to encode response", slog.Any("error", err))
-	}
+}
+
+// writeHeadError writes the status code dto.WriteErrorResponse would use for
+// err, without a body, for HEAD requests.
+func writeHeadError(w http.ResponseWriter, r *http.Request, err error) {
+	w.WriteHeader(dto.NewErrorResponse(r, err).Status)
 }
 
 // maxJSONBodyBytes is the maximum allowed size for a JSON request body (1 MB).
@@ -110,23 +255,43 @@ func decodeAndValidate[T validatable](w http.ResponseWriter, r *http.Request, ds
 }
 
 // decodeTodoCreate decodes and validates a CreateTodoRequest, returning the
-// mapped domain Todo. Returns nil and writes an error response on failure.
-func decodeTodoCreate(w http.ResponseWriter, r *http.Request) *todo.Todo {
+// mapped domain Todo. A draft is validated with ValidateDraft. Returns nil
+// and writes an error response on failure.
+func decodeTodoCreate(w http.ResponseWriter, r *http.Request, draft bool) *todo.Todo {
 	var req dto.CreateTodoRequest
-	if !decodeAndValidate(w, r, &req) {
+	if !decodeJSONBody(w, r, &req) {
+		return nil
+	}
+	validate := req.Validate
+	if draft {
+		validate = req.ValidateDraft
+	}
+	if err := validate(); err != nil {
+		dto.WriteErrorResponse(w, r, err)
 		return nil
 	}
 	return mapCreateTodoRequest(&req)
 }
 
-// decodeTodoUpdate decodes and validates an UpdateTodoRequest, returning the
-// mapped domain Todo. Returns nil and writes an error response on failure.
-func decodeTodoUpdate(w http.ResponseWriter, r *http.Request) *todo.Todo {
+// decodeTodoUpdate decodes and validates an UpdateTodoRequest. The caller
+// merges it onto the existing todo with dto.ApplyTodoUpdate. Returns nil and
+// writes an error response on failure.
+func decodeTodoUpdate(w http.ResponseWriter, r *http.Request) *dto.UpdateTodoRequest {
 	var req dto.UpdateTodoRequest
 	if !decodeAndValidate(w, r, &req) {
 		return nil
 	}
-	return mapUpdateTodoRequest(&req)
+	return &req
+}
+
+// decodeTodoReplace decodes and validates a ReplaceTodoRequest, returning the
+// mapped domain Todo. Returns nil and writes an error response on failure.
+func decodeTodoReplace(w http.ResponseWriter, r *http.Request) *todo.Todo {
+	var req dto.ReplaceTodoRequest
+	if !decodeAndValidate(w, r, &req) {
+		return nil
+	}
+	return mapReplaceTodoRequest(&req)
 }
 
 // mapBulkUpdateRequest converts BulkUpdateTodoItem DTOs to ports.TodoUpdate
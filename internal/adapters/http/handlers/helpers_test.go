@@ -0,0 +1,66 @@
+package handlers_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/handlers"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/logging"
+	"github.com/jsamuelsen11/go-service-template-v2/mocks"
+)
+
+// failingWriter is a ResponseWriter whose body writes always fail. It counts
+// WriteHeader calls so tests can assert the status is sent exactly once.
+type failingWriter struct {
+	header       http.Header
+	status       int
+	headerWrites int
+}
+
+func (f *failingWriter) Header() http.Header {
+	if f.header == nil {
+		f.header = make(http.Header)
+	}
+	return f.header
+}
+
+func (f *failingWriter) WriteHeader(status int) {
+	f.status = status
+	f.headerWrites++
+}
+
+func (f *failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("connection reset by peer")
+}
+
+func TestWriteJSON_LogsEncodeFailure(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	h := handlers.NewHealthHandler(mocks.NewMockHealthRegistry(t))
+	w := &failingWriter{}
+	req := httptest.NewRequest(http.MethodGet, "/health/live", http.NoBody)
+	req = req.WithContext(logging.WithLogger(req.Context(), logger))
+
+	h.Liveness(w, req)
+
+	if w.headerWrites != 1 {
+		t.Errorf("WriteHeader called %d times, want 1", w.headerWrites)
+	}
+	if w.status != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.status, http.StatusOK)
+	}
+	logs := buf.String()
+	for _, want := range []string{"failed to encode response", "status=200", "connection reset by peer"} {
+		if !strings.Contains(logs, want) {
+			t.Errorf("log output missing %q:\n%s", want, logs)
+		}
+	}
+}
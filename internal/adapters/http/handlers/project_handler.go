@@ -2,10 +2,14 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/paging"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
 )
 
@@ -20,15 +24,31 @@ func NewProjectHandler(svc ports.ProjectService) *ProjectHandler {
 	return &ProjectHandler{svc: svc}
 }
 
-// ListProjects handles GET /api/v1/projects.
+// ListProjects handles GET /api/v1/projects. Archived projects are listed
+// only when include_archived=true.
 func (h *ProjectHandler) ListProjects(w http.ResponseWriter, r *http.Request) {
-	projects, err := h.svc.ListProjects(r.Context())
+	filter, err := project.ParseFilter(r.URL.Query())
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+	page, err := parsePage(r)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	projects, err := h.svc.ListProjects(r.Context(), filter)
 	if err != nil {
 		dto.WriteErrorResponse(w, r, err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, dto.ToProjectListResponse(projects))
+	projects, meta := paging.Slice(projects, page)
+	resp := dto.ToProjectListResponse(projects)
+	resp.Page = &meta
+	setDegradedHeader(w, r)
+	writeResponse(w, r, http.StatusOK, resp)
 }
 
 // CreateProject handles POST /api/v1/projects.
@@ -49,7 +69,8 @@ func (h *ProjectHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, dto.ToProjectResponse(created))
+	setLocation(w, "projects", created.ID)
+	writeResponse(w, r, http.StatusCreated, dto.ToProjectResponse(created))
 }
 
 // GetProject handles GET /api/v1/projects/{id}.
@@ -66,7 +87,26 @@ func (h *ProjectHandler) GetProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	writeJSON(w, http.StatusOK, dto.ToProjectResponse(p))
+	setDegradedHeader(w, r)
+	writeResponse(w, r, http.StatusOK, dto.ToProjectResponse(p))
+}
+
+// HeadProject handles HEAD /api/v1/projects/{id}. It runs the same fetch as
+// GetProject but writes only the status and ETag header.
+func (h *ProjectHandler) HeadProject(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r, "id")
+	if err != nil {
+		writeHeadError(w, r, err)
+		return
+	}
+
+	p, err := h.svc.GetProject(r.Context(), id)
+	if err != nil {
+		writeHeadError(w, r, err)
+		return
+	}
+
+	writeHead(w, r, http.StatusOK, dto.ToProjectResponse(p))
 }
 
 // UpdateProject handles PATCH /api/v1/projects/{id}.
@@ -82,21 +122,15 @@ func (h *ProjectHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	p := &project.Project{}
-	if req.Name != nil {
-		p.Name = *req.Name
-	}
-	if req.Description != nil {
-		p.Description = *req.Description
-	}
-
-	updated, err := h.svc.UpdateProject(r.Context(), id, p)
+	updated, err := h.svc.PatchProject(r.Context(), id, func(p *project.Project) *project.Project {
+		return dto.ApplyProjectUpdate(p, req)
+	})
 	if err != nil {
 		dto.WriteErrorResponse(w, r, err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, dto.ToProjectResponse(updated))
+	writeResponse(w, r, http.StatusOK, dto.ToProjectResponse(updated))
 }
 
 // DeleteProject handles DELETE /api/v1/projects/{id}.
@@ -115,7 +149,38 @@ func (h *ProjectHandler) DeleteProject(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// ArchiveProject handles POST /api/v1/projects/{id}/archive.
+func (h *ProjectHandler) ArchiveProject(w http.ResponseWriter, r *http.Request) {
+	h.setArchived(w, r, h.svc.ArchiveProject)
+}
+
+// UnarchiveProject handles POST /api/v1/projects/{id}/unarchive.
+func (h *ProjectHandler) UnarchiveProject(w http.ResponseWriter, r *http.Request) {
+	h.setArchived(w, r, h.svc.UnarchiveProject)
+}
+
+// setArchived runs an archive or unarchive operation on the project named
+// by the id path parameter and responds with the updated project.
+func (h *ProjectHandler) setArchived(w http.ResponseWriter, r *http.Request, op func(context.Context, int64) (*project.Project, error)) {
+	id, err := parseID(r, "id")
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	updated, err := op(r.Context(), id)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, dto.ToProjectResponse(updated))
+}
+
 // AddProjectTodo handles POST /api/v1/projects/{projectId}/todos.
+// A dry_run=true query parameter validates the todo without creating it and
+// responds 200 with the would-be todo. A draft=true query parameter creates
+// a draft, which may omit its description.
 func (h *ProjectHandler) AddProjectTodo(w http.ResponseWriter, r *http.Request) {
 	projectID, err := parseID(r, "projectId")
 	if err != nil {
@@ -123,21 +188,42 @@ func (h *ProjectHandler) AddProjectTodo(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	t := decodeTodoCreate(w, r)
+	ctx, err := withDryRun(r)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	ctx, draft, err := withDraft(ctx, r)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	t := decodeTodoCreate(w, r, draft)
 	if t == nil {
 		return
 	}
 
-	created, err := h.svc.AddTodo(r.Context(), projectID, t)
+	created, err := h.svc.AddTodo(ctx, projectID, t)
 	if err != nil {
 		dto.WriteErrorResponse(w, r, err)
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, dto.ToTodoResponse(created))
+	// A dry run created nothing, so there is no Location to point at.
+	if appctx.IsDryRun(ctx) {
+		writeResponse(w, r, http.StatusOK, dto.ToTodoResponse(created))
+		return
+	}
+
+	// Nested todos are addressed canonically through /todos/{id}.
+	setLocation(w, "todos", created.ID)
+	writeResponse(w, r, http.StatusCreated, dto.ToTodoResponse(created))
 }
 
 // UpdateProjectTodo handles PATCH /api/v1/projects/{projectId}/todos/{todoId}.
+// A dry_run=true query parameter validates the update without applying it.
 func (h *ProjectHandler) UpdateProjectTodo(w http.ResponseWriter, r *http.Request) {
 	projectID, err := parseID(r, "projectId")
 	if err != nil {
@@ -151,21 +237,30 @@ func (h *ProjectHandler) UpdateProjectTodo(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	t := decodeTodoUpdate(w, r)
-	if t == nil {
+	ctx, err := withDryRun(r)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	req := decodeTodoUpdate(w, r)
+	if req == nil {
 		return
 	}
 
-	updated, err := h.svc.UpdateTodo(r.Context(), projectID, todoID, t)
+	updated, err := h.svc.PatchTodo(ctx, projectID, todoID, func(t *todo.Todo) *todo.Todo {
+		return dto.ApplyTodoUpdate(t, *req)
+	})
 	if err != nil {
 		dto.WriteErrorResponse(w, r, err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, dto.ToTodoResponse(updated))
+	writeResponse(w, r, http.StatusOK, dto.ToTodoResponse(updated))
 }
 
 // RemoveProjectTodo handles DELETE /api/v1/projects/{projectId}/todos/{todoId}.
+// A dry_run=true query parameter skips the deletion.
 func (h *ProjectHandler) RemoveProjectTodo(w http.ResponseWriter, r *http.Request) {
 	projectID, err := parseID(r, "projectId")
 	if err != nil {
@@ -179,7 +274,13 @@ func (h *ProjectHandler) RemoveProjectTodo(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if err := h.svc.RemoveTodo(r.Context(), projectID, todoID); err != nil {
+	ctx, err := withDryRun(r)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	if err := h.svc.RemoveTodo(ctx, projectID, todoID); err != nil {
 		dto.WriteErrorResponse(w, r, err)
 		return
 	}
@@ -188,12 +289,20 @@ func (h *ProjectHandler) RemoveProjectTodo(w http.ResponseWriter, r *http.Reques
 }
 
 // BulkUpdateProjectTodos handles PATCH /api/v1/projects/{projectId}/todos/bulk.
+// By default either every listed todo is updated or none are. With
+// ?atomic=false each update applies independently and the response is a 207
+// Multi-Status listing each item's own status in request order.
 func (h *ProjectHandler) BulkUpdateProjectTodos(w http.ResponseWriter, r *http.Request) {
 	projectID, err := parseID(r, "projectId")
 	if err != nil {
 		dto.WriteErrorResponse(w, r, err)
 		return
 	}
+	atomic, err := parseAtomic(r)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
 
 	var req dto.BulkUpdateTodosRequest
 	if !decodeAndValidate(w, r, &req) {
@@ -202,11 +311,63 @@ func (h *ProjectHandler) BulkUpdateProjectTodos(w http.ResponseWriter, r *http.R
 
 	updates := mapBulkUpdateRequest(req.Updates)
 
+	if !atomic {
+		result, err := h.svc.BulkUpdateTodosEach(r.Context(), projectID, updates)
+		if err != nil {
+			dto.WriteErrorResponse(w, r, err)
+			return
+		}
+		ids := make([]int64, len(updates))
+		for i, u := range updates {
+			ids[i] = u.TodoID
+		}
+		writeResponse(w, r, http.StatusMultiStatus, dto.ToBulkUpdateResultResponse(ids, result))
+		return
+	}
+
 	result, err := h.svc.BulkUpdateTodos(r.Context(), projectID, updates)
 	if err != nil {
 		dto.WriteErrorResponse(w, r, err)
 		return
 	}
+	writeResponse(w, r, http.StatusOK, dto.ToBulkUpdateResponse(result))
+}
+
+// BulkDeleteProjectTodos handles POST /api/v1/projects/{projectId}/todos/bulk-delete.
+// By default either every listed todo is deleted or none are. With
+// ?atomic=false each delete applies independently and the response is a 207
+// Multi-Status listing each item's own status.
+func (h *ProjectHandler) BulkDeleteProjectTodos(w http.ResponseWriter, r *http.Request) {
+	projectID, err := parseID(r, "projectId")
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+	atomic, err := parseAtomic(r)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
 
-	writeJSON(w, http.StatusOK, dto.ToBulkUpdateResponse(result))
+	var req dto.BulkDeleteTodosRequest
+	if !decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	if !atomic {
+		result, err := h.svc.BulkRemoveTodosEach(r.Context(), projectID, req.TodoIDs)
+		if err != nil {
+			dto.WriteErrorResponse(w, r, err)
+			return
+		}
+		writeResponse(w, r, http.StatusMultiStatus, dto.ToBulkRemoveResultResponse(req.TodoIDs, result))
+		return
+	}
+
+	if err := h.svc.BulkRemoveTodos(r.Context(), projectID, req.TodoIDs); err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/handlers"
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
@@ -31,7 +33,7 @@ func TestListProjects_Success(t *testing.T) {
 	h, svc := newProjectHandler(t)
 
 	projects := []project.Project{validProject()}
-	svc.EXPECT().ListProjects(mock.Anything).Return(projects, nil)
+	svc.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return(projects, nil)
 
 	rec := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects", nil)
@@ -44,11 +46,91 @@ func TestListProjects_Success(t *testing.T) {
 	}
 }
 
+func TestListProjects_Paginated(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	projects := make([]project.Project, 5)
+	for i := range projects {
+		projects[i] = validProject()
+		projects[i].ID = int64(i + 1)
+	}
+	svc.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return(projects, nil)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?limit=2&offset=2", nil)
+	h.ListProjects(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[dto.ProjectListResponse](t, rec)
+	if resp.Count != 2 || resp.Projects[0].ID != 3 || resp.Projects[1].ID != 4 {
+		t.Errorf("Projects = %+v, want projects 3 and 4", resp.Projects)
+	}
+	if resp.Page == nil || resp.Page.Total != 5 || resp.Page.NextCursor == "" {
+		t.Errorf("Page = %+v, want total 5 with a next cursor", resp.Page)
+	}
+}
+
+func TestListProjects_IncludeArchived(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	archived := validProject()
+	archived.Archived = true
+	svc.EXPECT().ListProjects(mock.Anything, project.Filter{IncludeArchived: true}).
+		Return([]project.Project{archived}, nil)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?include_archived=true", nil)
+	h.ListProjects(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[dto.ProjectListResponse](t, rec)
+	if resp.Count != 1 || !resp.Projects[0].Archived {
+		t.Errorf("Projects = %+v, want one archived project", resp.Projects)
+	}
+}
+
+func TestListProjects_Sort(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	svc.EXPECT().ListProjects(mock.Anything, project.Filter{Sort: "-name"}).Return([]project.Project{}, nil)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?sort=-name", nil)
+	h.ListProjects(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+}
+
+func TestListProjects_InvalidSort(t *testing.T) {
+	t.Parallel()
+	h, _ := newProjectHandler(t)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?sort=priority", nil)
+	h.ListProjects(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
+func TestListProjects_InvalidIncludeArchived(t *testing.T) {
+	t.Parallel()
+	h, _ := newProjectHandler(t)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?include_archived=maybe", nil)
+	h.ListProjects(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
 func TestListProjects_ServiceError(t *testing.T) {
 	t.Parallel()
 	h, svc := newProjectHandler(t)
 
-	svc.EXPECT().ListProjects(mock.Anything).Return(nil, domain.ErrUnavailable)
+	svc.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return(nil, domain.ErrUnavailable)
 
 	rec := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects", nil)
@@ -57,6 +139,25 @@ func TestListProjects_ServiceError(t *testing.T) {
 	requireStatus(t, rec, http.StatusBadGateway)
 }
 
+func TestListProjects_DegradedHeader(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	svc.EXPECT().ListProjects(mock.Anything, project.Filter{}).
+		Run(func(ctx context.Context, _ project.Filter) { appctx.MarkDegraded(ctx) }).
+		Return([]project.Project{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects", nil)
+	req = req.WithContext(appctx.WithRequestContext(req.Context(), appctx.New(req.Context())))
+	rec := httptest.NewRecorder()
+	h.ListProjects(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	if got := rec.Header().Get(handlers.DegradedHeader); got != "true" {
+		t.Errorf("%s = %q, want %q", handlers.DegradedHeader, got, "true")
+	}
+}
+
 // --- CreateProject ---
 
 func TestCreateProject_Success(t *testing.T) {
@@ -78,6 +179,9 @@ func TestCreateProject_Success(t *testing.T) {
 	if resp.Name != "Sprint 1" {
 		t.Errorf("Name = %q, want %q", resp.Name, "Sprint 1")
 	}
+	if got := rec.Header().Get("Location"); got != "/api/v1/projects/1" {
+		t.Errorf("Location = %q, want %q", got, "/api/v1/projects/1")
+	}
 }
 
 func TestCreateProject_InvalidJSON(t *testing.T) {
@@ -141,6 +245,50 @@ func TestGetProject_Success(t *testing.T) {
 	}
 }
 
+func TestGetProject_EmptyTodosOmitted(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	p := validProject()
+	p.Todos = []todo.Todo{}
+	svc.EXPECT().GetProject(mock.Anything, int64(1)).Return(&p, nil)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodGet, "/api/v1/projects/1", nil), map[string]string{"id": "1"})
+	h.GetProject(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	body := decodeJSON[map[string]any](t, rec)
+	if _, ok := body["todos"]; ok {
+		t.Errorf("body contains todos = %v, want omitted", body["todos"])
+	}
+}
+
+func TestGetProject_TodosUnavailable(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	p := validProject()
+	p.TodosLoadError = domain.ErrUnavailable
+	svc.EXPECT().GetProject(mock.Anything, int64(1)).
+		Run(func(ctx context.Context, _ int64) { appctx.MarkDegraded(ctx) }).
+		Return(&p, nil)
+
+	req := withChiParams(httptest.NewRequest(http.MethodGet, "/api/v1/projects/1", nil), map[string]string{"id": "1"})
+	req = req.WithContext(appctx.WithRequestContext(req.Context(), appctx.New(req.Context())))
+	rec := httptest.NewRecorder()
+	h.GetProject(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	if got := rec.Header().Get(handlers.DegradedHeader); got != "true" {
+		t.Errorf("%s = %q, want %q", handlers.DegradedHeader, got, "true")
+	}
+	resp := decodeJSON[dto.ProjectResponse](t, rec)
+	if !resp.TodosUnavailable {
+		t.Error("TodosUnavailable = false, want true")
+	}
+}
+
 func TestGetProject_InvalidID(t *testing.T) {
 	t.Parallel()
 	h, _ := newProjectHandler(t)
@@ -171,10 +319,17 @@ func TestUpdateProject_Success(t *testing.T) {
 	t.Parallel()
 	h, svc := newProjectHandler(t)
 
+	existing := validProject()
 	updated := validProject()
 	updated.Name = testUpdatedValue
-	svc.EXPECT().UpdateProject(mock.Anything, int64(1), mock.AnythingOfType("*project.Project")).
-		Return(&updated, nil)
+	svc.EXPECT().PatchProject(mock.Anything, int64(1), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ int64, patch func(*project.Project) *project.Project) (*project.Project, error) {
+			p := patch(&existing)
+			if p.Name != testUpdatedValue || p.Description != existing.Description {
+				t.Errorf("patched project = %+v, want name %q and description kept", p, testUpdatedValue)
+			}
+			return &updated, nil
+		})
 
 	name := testUpdatedValue
 	body := jsonBody(t, dto.UpdateProjectRequest{Name: &name})
@@ -271,6 +426,67 @@ func TestDeleteProject_NotFound(t *testing.T) {
 	requireStatus(t, rec, http.StatusNotFound)
 }
 
+// --- ArchiveProject / UnarchiveProject ---
+
+func TestArchiveProject_Success(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	archived := validProject()
+	archived.Archived = true
+	svc.EXPECT().ArchiveProject(mock.Anything, int64(1)).Return(&archived, nil)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodPost, "/api/v1/projects/1/archive", nil), map[string]string{"id": "1"})
+	h.ArchiveProject(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	if resp := decodeJSON[dto.ProjectResponse](t, rec); !resp.Archived {
+		t.Error("Archived = false, want true")
+	}
+}
+
+func TestArchiveProject_InvalidID(t *testing.T) {
+	t.Parallel()
+	h, _ := newProjectHandler(t)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodPost, "/api/v1/projects/abc/archive", nil), map[string]string{"id": "abc"})
+	h.ArchiveProject(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
+func TestUnarchiveProject_Success(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	active := validProject()
+	svc.EXPECT().UnarchiveProject(mock.Anything, int64(1)).Return(&active, nil)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodPost, "/api/v1/projects/1/unarchive", nil), map[string]string{"id": "1"})
+	h.UnarchiveProject(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	if resp := decodeJSON[dto.ProjectResponse](t, rec); resp.Archived {
+		t.Error("Archived = true, want false")
+	}
+}
+
+func TestUnarchiveProject_NotFound(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	svc.EXPECT().UnarchiveProject(mock.Anything, int64(999)).Return(nil, domain.ErrNotFound)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodPost, "/api/v1/projects/999/unarchive", nil), map[string]string{"id": "999"})
+	h.UnarchiveProject(rec, req)
+
+	requireStatus(t, rec, http.StatusNotFound)
+}
+
 // --- AddProjectTodo ---
 
 func TestAddProjectTodo_Success(t *testing.T) {
@@ -278,6 +494,7 @@ func TestAddProjectTodo_Success(t *testing.T) {
 	h, svc := newProjectHandler(t)
 
 	created := validTodo()
+	created.ID = 42
 	svc.EXPECT().AddTodo(mock.Anything, int64(1), mock.AnythingOfType("*todo.Todo")).
 		Return(&created, nil)
 
@@ -293,6 +510,9 @@ func TestAddProjectTodo_Success(t *testing.T) {
 	if resp.Title != "Buy groceries" {
 		t.Errorf("Title = %q, want %q", resp.Title, "Buy groceries")
 	}
+	if got := rec.Header().Get("Location"); got != "/api/v1/todos/42" {
+		t.Errorf("Location = %q, want %q", got, "/api/v1/todos/42")
+	}
 }
 
 func TestAddProjectTodo_InvalidProjectID(t *testing.T) {
@@ -323,16 +543,79 @@ func TestAddProjectTodo_ValidationError(t *testing.T) {
 	requireStatus(t, rec, http.StatusBadRequest)
 }
 
+func TestAddProjectTodo_DryRun(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	created := validTodo()
+	svc.EXPECT().AddTodo(mock.MatchedBy(func(ctx context.Context) bool {
+		return appctx.IsDryRun(ctx)
+	}), int64(1), mock.AnythingOfType("*todo.Todo")).Return(&created, nil)
+
+	body := jsonBody(t, dto.CreateTodoRequest{Title: "Buy groceries", Description: "Milk, eggs, bread"})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/projects/1/todos?dry_run=true", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "1"})
+	h.AddProjectTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	if got := rec.Header().Get("Location"); got != "" {
+		t.Errorf("Location = %q, want none for a dry run", got)
+	}
+}
+
+func TestAddProjectTodo_InvalidDryRun(t *testing.T) {
+	t.Parallel()
+	h, _ := newProjectHandler(t)
+
+	body := jsonBody(t, dto.CreateTodoRequest{Title: "Buy groceries", Description: "Milk, eggs, bread"})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/projects/1/todos?dry_run=maybe", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "1"})
+	h.AddProjectTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
+func TestAddProjectTodo_Draft(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	created := validTodo()
+	svc.EXPECT().AddTodo(mock.MatchedBy(func(ctx context.Context) bool {
+		return appctx.IsDraft(ctx) && !appctx.IsDryRun(ctx)
+	}), int64(1), mock.AnythingOfType("*todo.Todo")).Return(&created, nil)
+
+	body := jsonBody(t, dto.CreateTodoRequest{Title: "Buy groceries"})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/projects/1/todos?draft=true", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "1"})
+	h.AddProjectTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusCreated)
+}
+
 // --- UpdateProjectTodo ---
 
 func TestUpdateProjectTodo_Success(t *testing.T) {
 	t.Parallel()
 	h, svc := newProjectHandler(t)
 
-	updated := validTodo()
+	existing := validTodo()
+	existing.ID = 2
+	updated := existing
 	updated.Title = testUpdatedValue
-	svc.EXPECT().UpdateTodo(mock.Anything, int64(1), int64(2), mock.AnythingOfType("*todo.Todo")).
-		Return(&updated, nil)
+	svc.EXPECT().PatchTodo(mock.Anything, int64(1), int64(2), mock.Anything).
+		RunAndReturn(func(_ context.Context, _, _ int64, patch func(*todo.Todo) *todo.Todo) (*todo.Todo, error) {
+			td := patch(&existing)
+			if td.Title != testUpdatedValue || td.Description != existing.Description {
+				t.Errorf("patched todo = %+v, want title %q and description kept", td, testUpdatedValue)
+			}
+			return &updated, nil
+		})
 
 	title := testUpdatedValue
 	body := jsonBody(t, dto.UpdateTodoRequest{Title: &title})
@@ -349,6 +632,24 @@ func TestUpdateProjectTodo_Success(t *testing.T) {
 	}
 }
 
+func TestUpdateProjectTodo_TodoNotInProject(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	svc.EXPECT().PatchTodo(mock.Anything, int64(1), int64(2), mock.Anything).
+		Return(nil, domain.ErrNotFound)
+
+	title := testUpdatedValue
+	body := jsonBody(t, dto.UpdateTodoRequest{Title: &title})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/projects/1/todos/2", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "1", "todoId": "2"})
+	h.UpdateProjectTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusNotFound)
+}
+
 func TestUpdateProjectTodo_InvalidProjectID(t *testing.T) {
 	t.Parallel()
 	h, _ := newProjectHandler(t)
@@ -414,6 +715,21 @@ func TestRemoveProjectTodo_NotFound(t *testing.T) {
 	requireStatus(t, rec, http.StatusNotFound)
 }
 
+func TestRemoveProjectTodo_DryRun(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	svc.EXPECT().RemoveTodo(mock.MatchedBy(func(ctx context.Context) bool {
+		return appctx.IsDryRun(ctx)
+	}), int64(1), int64(2)).Return(nil)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodDelete, "/api/v1/projects/1/todos/2?dry_run=true", nil), map[string]string{"projectId": "1", "todoId": "2"})
+	h.RemoveProjectTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusNoContent)
+}
+
 // --- BulkUpdateProjectTodos ---
 
 func TestBulkUpdateProjectTodos_Success(t *testing.T) {
@@ -513,6 +829,162 @@ func TestBulkUpdateProjectTodos_ServiceError(t *testing.T) {
 	requireStatus(t, rec, http.StatusNotFound)
 }
 
+func TestBulkUpdateProjectTodos_NonAtomicReportsEachItem(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	updated := validTodo()
+	updated.ID = 1
+	result := &ports.BulkUpdateResult{
+		Updated: []todo.Todo{updated},
+		Errors:  []ports.BulkUpdateError{{TodoID: 2, Err: domain.ErrUnavailable}},
+	}
+	svc.EXPECT().BulkUpdateTodosEach(mock.Anything, int64(1), mock.AnythingOfType("[]ports.TodoUpdate")).
+		Return(result, nil)
+
+	title := "Updated"
+	body := jsonBody(t, dto.BulkUpdateTodosRequest{
+		Updates: []dto.BulkUpdateTodoItem{
+			{TodoID: 2, Title: &title},
+			{TodoID: 1, Title: &title},
+		},
+	})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/projects/1/todos/bulk?atomic=false", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "1"})
+	h.BulkUpdateProjectTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusMultiStatus)
+	resp := decodeJSON[dto.BulkResultResponse](t, rec)
+	if resp.Total != 2 || resp.Succeeded != 1 || resp.Failed != 1 {
+		t.Errorf("totals = %d/%d/%d, want 2/1/1", resp.Total, resp.Succeeded, resp.Failed)
+	}
+	if len(resp.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(resp.Results))
+	}
+	if got := resp.Results[0]; got.TodoID != 2 || got.Status != http.StatusBadGateway || got.Error == "" || got.Todo != nil {
+		t.Errorf("Results[0] = %+v, want todo 2 failed with 502", got)
+	}
+	if got := resp.Results[1]; got.TodoID != 1 || got.Status != http.StatusOK || got.Todo == nil || got.Error != "" {
+		t.Errorf("Results[1] = %+v, want todo 1 updated with 200", got)
+	}
+}
+
+func TestBulkUpdateProjectTodos_InvalidAtomic(t *testing.T) {
+	t.Parallel()
+	h, _ := newProjectHandler(t)
+
+	title := "Updated"
+	body := jsonBody(t, dto.BulkUpdateTodosRequest{
+		Updates: []dto.BulkUpdateTodoItem{{TodoID: 1, Title: &title}},
+	})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/projects/1/todos/bulk?atomic=maybe", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "1"})
+	h.BulkUpdateProjectTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
+// --- BulkDeleteProjectTodos ---
+
+func TestBulkDeleteProjectTodos_NonAtomicReportsEachItem(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	svc.EXPECT().BulkRemoveTodosEach(mock.Anything, int64(1), []int64{2, 3}).Return(&ports.BulkRemoveResult{
+		Removed: []int64{2},
+		Errors:  []ports.BulkUpdateError{{TodoID: 3, Err: domain.ErrNotFound}},
+	}, nil)
+
+	body := jsonBody(t, dto.BulkDeleteTodosRequest{TodoIDs: []int64{2, 3}})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/projects/1/todos/bulk-delete?atomic=false", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "1"})
+	h.BulkDeleteProjectTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusMultiStatus)
+	resp := decodeJSON[dto.BulkResultResponse](t, rec)
+	want := []dto.BulkItemResult{
+		{TodoID: 2, Status: http.StatusNoContent},
+		{TodoID: 3, Status: http.StatusNotFound, Error: domain.ErrNotFound.Error()},
+	}
+	if len(resp.Results) != len(want) {
+		t.Fatalf("len(Results) = %d, want %d", len(resp.Results), len(want))
+	}
+	for i := range want {
+		if resp.Results[i] != want[i] {
+			t.Errorf("Results[%d] = %+v, want %+v", i, resp.Results[i], want[i])
+		}
+	}
+	if resp.Succeeded != 1 || resp.Failed != 1 {
+		t.Errorf("Succeeded/Failed = %d/%d, want 1/1", resp.Succeeded, resp.Failed)
+	}
+}
+
+func TestBulkDeleteProjectTodos_Success(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	svc.EXPECT().BulkRemoveTodos(mock.Anything, int64(1), []int64{2, 3}).Return(nil)
+
+	body := jsonBody(t, dto.BulkDeleteTodosRequest{TodoIDs: []int64{2, 3}})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/projects/1/todos/bulk-delete", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "1"})
+	h.BulkDeleteProjectTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusNoContent)
+}
+
+func TestBulkDeleteProjectTodos_InvalidProjectID(t *testing.T) {
+	t.Parallel()
+	h, _ := newProjectHandler(t)
+
+	body := jsonBody(t, dto.BulkDeleteTodosRequest{TodoIDs: []int64{2}})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/projects/abc/todos/bulk-delete", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "abc"})
+	h.BulkDeleteProjectTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
+func TestBulkDeleteProjectTodos_ValidationError(t *testing.T) {
+	t.Parallel()
+	h, _ := newProjectHandler(t)
+
+	body := jsonBody(t, dto.BulkDeleteTodosRequest{TodoIDs: []int64{2, 2}})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/projects/1/todos/bulk-delete", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "1"})
+	h.BulkDeleteProjectTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
+func TestBulkDeleteProjectTodos_ServiceError(t *testing.T) {
+	t.Parallel()
+	h, svc := newProjectHandler(t)
+
+	svc.EXPECT().BulkRemoveTodos(mock.Anything, int64(1), []int64{2}).Return(domain.ErrNotFound)
+
+	body := jsonBody(t, dto.BulkDeleteTodosRequest{TodoIDs: []int64{2}})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/projects/1/todos/bulk-delete", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = withChiParams(req, map[string]string{"projectId": "1"})
+	h.BulkDeleteProjectTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusNotFound)
+}
+
 // --- Error propagation ---
 
 func TestProjectHandler_ErrorPropagation(t *testing.T) {
@@ -528,6 +1000,9 @@ func TestProjectHandler_ErrorPropagation(t *testing.T) {
 		{"conflict", domain.ErrConflict, http.StatusConflict},
 		{"forbidden", domain.ErrForbidden, http.StatusForbidden},
 		{"unavailable", domain.ErrUnavailable, http.StatusBadGateway},
+		{"timeout", domain.ErrTimeout, http.StatusGatewayTimeout},
+		{"deadline exceeded", context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{"canceled", context.Canceled, dto.StatusClientClosedRequest},
 		{"unknown", errors.New("boom"), http.StatusInternalServerError},
 	}
 
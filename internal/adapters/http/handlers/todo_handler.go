@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/paging"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
+)
+
+// TodoHandler handles HTTP requests for standalone todo CRUD.
+type TodoHandler struct {
+	svc ports.TodoService
+}
+
+// NewTodoHandler creates a new TodoHandler with the given service port.
+func NewTodoHandler(svc ports.TodoService) *TodoHandler {
+	return &TodoHandler{svc: svc}
+}
+
+// ListTodos handles GET /api/v1/todos. Supported query parameters are
+// status, category, project_id, and q (free-text search).
+func (h *TodoHandler) ListTodos(w http.ResponseWriter, r *http.Request) {
+	filter, err := todo.ParseFilter(r.URL.Query())
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+	page, err := parsePage(r)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	todos, err := h.svc.ListTodos(r.Context(), filter)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	todos, meta := paging.Slice(todos, page)
+	resp := dto.ToTodoListResponse(todos)
+	resp.Page = &meta
+	writeResponse(w, r, http.StatusOK, resp)
+}
+
+// CreateTodo handles POST /api/v1/todos.
+// A draft=true query parameter creates a draft, which may omit its
+// description.
+func (h *TodoHandler) CreateTodo(w http.ResponseWriter, r *http.Request) {
+	ctx, draft, err := withDraft(r.Context(), r)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	t := decodeTodoCreate(w, r, draft)
+	if t == nil {
+		return
+	}
+
+	created, err := h.svc.CreateTodo(ctx, t)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	setLocation(w, "todos", created.ID)
+	writeResponse(w, r, http.StatusCreated, dto.ToTodoResponse(created))
+}
+
+// GetTodo handles GET /api/v1/todos/{id}.
+func (h *TodoHandler) GetTodo(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r, "id")
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	t, err := h.svc.GetTodo(r.Context(), id)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, dto.ToTodoResponse(t))
+}
+
+// HeadTodo handles HEAD /api/v1/todos/{id}. It runs the same fetch as
+// GetTodo but writes only the status and ETag header.
+func (h *TodoHandler) HeadTodo(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r, "id")
+	if err != nil {
+		writeHeadError(w, r, err)
+		return
+	}
+
+	t, err := h.svc.GetTodo(r.Context(), id)
+	if err != nil {
+		writeHeadError(w, r, err)
+		return
+	}
+
+	writeHead(w, r, http.StatusOK, dto.ToTodoResponse(t))
+}
+
+// UpdateTodo handles PATCH /api/v1/todos/{id}.
+func (h *TodoHandler) UpdateTodo(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r, "id")
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	req := decodeTodoUpdate(w, r)
+	if req == nil {
+		return
+	}
+
+	updated, err := h.svc.PatchTodo(r.Context(), id, func(t *todo.Todo) *todo.Todo {
+		return dto.ApplyTodoUpdate(t, *req)
+	})
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, dto.ToTodoResponse(updated))
+}
+
+// ReplaceTodo handles PUT /api/v1/todos/{id}. The body must carry every
+// field; the todo is replaced as a whole rather than merged as in PATCH.
+func (h *TodoHandler) ReplaceTodo(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r, "id")
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	t := decodeTodoReplace(w, r)
+	if t == nil {
+		return
+	}
+
+	replaced, err := h.svc.UpdateTodo(r.Context(), id, t)
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, dto.ToTodoResponse(replaced))
+}
+
+// DeleteTodo handles DELETE /api/v1/todos/{id}.
+func (h *TodoHandler) DeleteTodo(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r, "id")
+	if err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	if err := h.svc.DeleteTodo(r.Context(), id); err != nil {
+		dto.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,491 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/handlers"
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/paging"
+	"github.com/jsamuelsen11/go-service-template-v2/mocks"
+)
+
+func newTodoHandler(t *testing.T) (*handlers.TodoHandler, *mocks.MockTodoService) {
+	t.Helper()
+	svc := mocks.NewMockTodoService(t)
+	return handlers.NewTodoHandler(svc), svc
+}
+
+// --- ListTodos ---
+
+func TestListTodos_Success(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	projectID := int64(3)
+	want := todo.Filter{
+		Status:    todo.StatusDone,
+		Category:  todo.CategoryWork,
+		ProjectID: &projectID,
+		Search:    "report",
+	}
+	svc.EXPECT().ListTodos(mock.Anything, want).Return([]todo.Todo{validTodo()}, nil)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/todos?status=done&category=work&project_id=3&q=report", nil)
+	h.ListTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[dto.TodoListResponse](t, rec)
+	if resp.Count != 1 {
+		t.Errorf("Count = %d, want 1", resp.Count)
+	}
+}
+
+func TestListTodos_InvalidQuery(t *testing.T) {
+	t.Parallel()
+	h, _ := newTodoHandler(t)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/todos?status=bogus&project_id=abc", nil)
+	h.ListTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+	resp := decodeJSON[dto.ErrorResponse](t, rec)
+	if len(resp.Errors) != 2 {
+		t.Errorf("len(Errors) = %d, want 2", len(resp.Errors))
+	}
+}
+
+func TestListTodos_InvalidPagination(t *testing.T) {
+	t.Parallel()
+	h, _ := newTodoHandler(t)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/todos?limit=500&offset=-1", nil)
+	h.ListTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+	resp := decodeJSON[dto.ErrorResponse](t, rec)
+	if len(resp.Errors) != 2 {
+		t.Errorf("len(Errors) = %d, want 2", len(resp.Errors))
+	}
+}
+
+func TestListTodos_DefaultPage(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	svc.EXPECT().ListTodos(mock.Anything, todo.Filter{}).Return([]todo.Todo{validTodo()}, nil)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/todos", nil)
+	h.ListTodos(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[dto.TodoListResponse](t, rec)
+	if resp.Page == nil || resp.Page.Limit != paging.DefaultLimit || resp.Page.Total != 1 || resp.Page.NextCursor != "" {
+		t.Errorf("Page = %+v, want the default limit, total 1, and no next cursor", resp.Page)
+	}
+}
+
+func TestListTodos_NonPositiveProjectIDFilter(t *testing.T) {
+	t.Parallel()
+
+	for _, raw := range []string{"0", "-5"} {
+		t.Run(raw, func(t *testing.T) {
+			t.Parallel()
+			h, _ := newTodoHandler(t)
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/todos?project_id="+raw, nil)
+			h.ListTodos(rec, req)
+
+			requireStatus(t, rec, http.StatusBadRequest)
+			resp := decodeJSON[dto.ErrorResponse](t, rec)
+			if len(resp.Errors) != 1 {
+				t.Fatalf("len(Errors) = %d, want 1", len(resp.Errors))
+			}
+			if got := resp.Errors[0].Location; got != "body.project_id" {
+				t.Errorf("Errors[0].Location = %q, want %q", got, "body.project_id")
+			}
+		})
+	}
+}
+
+// --- CreateTodo ---
+
+func TestCreateTodo_Success(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	created := validTodo()
+	svc.EXPECT().CreateTodo(mock.Anything, mock.AnythingOfType("*todo.Todo")).Return(&created, nil)
+
+	body := jsonBody(t, dto.CreateTodoRequest{Title: "Buy groceries", Description: "Milk, eggs, bread"})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/todos", body)
+	h.CreateTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusCreated)
+	resp := decodeJSON[dto.TodoResponse](t, rec)
+	if resp.Title != "Buy groceries" {
+		t.Errorf("Title = %q, want %q", resp.Title, "Buy groceries")
+	}
+	if got := rec.Header().Get("Location"); got != "/api/v1/todos/1" {
+		t.Errorf("Location = %q, want %q", got, "/api/v1/todos/1")
+	}
+}
+
+func TestCreateTodo_ValidationError(t *testing.T) {
+	t.Parallel()
+	h, _ := newTodoHandler(t)
+
+	body := jsonBody(t, dto.CreateTodoRequest{Title: ""})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/todos", body)
+	h.CreateTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
+func TestCreateTodo_Draft(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	created := validTodo()
+	created.Description = ""
+	svc.EXPECT().CreateTodo(mock.MatchedBy(func(ctx context.Context) bool {
+		return appctx.IsDraft(ctx)
+	}), mock.AnythingOfType("*todo.Todo")).Return(&created, nil)
+
+	body := jsonBody(t, dto.CreateTodoRequest{Title: "Buy groceries"})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/todos?draft=true", body)
+	h.CreateTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusCreated)
+}
+
+func TestCreateTodo_NonDraftRequiresDescription(t *testing.T) {
+	t.Parallel()
+	h, _ := newTodoHandler(t)
+
+	body := jsonBody(t, dto.CreateTodoRequest{Title: "Buy groceries"})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/todos?draft=false", body)
+	h.CreateTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
+func TestCreateTodo_InvalidDraft(t *testing.T) {
+	t.Parallel()
+	h, _ := newTodoHandler(t)
+
+	body := jsonBody(t, dto.CreateTodoRequest{Title: "Buy groceries"})
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/todos?draft=maybe", body)
+	h.CreateTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
+// --- GetTodo ---
+
+func TestGetTodo_Success(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	td := validTodo()
+	svc.EXPECT().GetTodo(mock.Anything, int64(1)).Return(&td, nil)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodGet, "/api/v1/todos/1", nil), map[string]string{"id": "1"})
+	h.GetTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[dto.TodoResponse](t, rec)
+	if resp.ID != 1 {
+		t.Errorf("ID = %d, want 1", resp.ID)
+	}
+}
+
+func TestGetTodo_Envelope(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	td := validTodo()
+	svc.EXPECT().GetTodo(mock.Anything, int64(1)).Return(&td, nil)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodGet, "/api/v1/todos/1", nil), map[string]string{"id": "1"})
+	req.Header.Set("Accept", "application/json; envelope=true")
+	h.GetTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[struct {
+		Data dto.TodoResponse `json:"data"`
+		Meta dto.EnvelopeMeta `json:"meta"`
+	}](t, rec)
+	if resp.Data.ID != 1 {
+		t.Errorf("data.id = %d, want 1", resp.Data.ID)
+	}
+	if resp.Meta.DurationMS < 0 {
+		t.Errorf("meta.duration_ms = %v, want >= 0", resp.Meta.DurationMS)
+	}
+}
+
+func TestHeadTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(svc *mocks.MockTodoService)
+		wantStatus int
+		wantETag   bool
+	}{
+		{
+			name: "existing todo",
+			setup: func(svc *mocks.MockTodoService) {
+				td := validTodo()
+				svc.EXPECT().GetTodo(mock.Anything, int64(1)).Return(&td, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantETag:   true,
+		},
+		{
+			name: "missing todo",
+			setup: func(svc *mocks.MockTodoService) {
+				svc.EXPECT().GetTodo(mock.Anything, int64(1)).Return(nil, domain.ErrNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h, svc := newTodoHandler(t)
+			tt.setup(svc)
+
+			rec := httptest.NewRecorder()
+			req := withChiParams(httptest.NewRequest(http.MethodHead, "/api/v1/todos/1", nil), map[string]string{"id": "1"})
+			h.HeadTodo(rec, req)
+
+			requireStatus(t, rec, tt.wantStatus)
+			if rec.Body.Len() != 0 {
+				t.Errorf("body = %q, want empty", rec.Body.String())
+			}
+			if got := rec.Header().Get("ETag") != ""; got != tt.wantETag {
+				t.Errorf("ETag set = %v, want %v", got, tt.wantETag)
+			}
+		})
+	}
+}
+
+func TestGetTodo_NotFound(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	svc.EXPECT().GetTodo(mock.Anything, int64(999)).Return(nil, domain.ErrNotFound)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodGet, "/api/v1/todos/999", nil), map[string]string{"id": "999"})
+	h.GetTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusNotFound)
+}
+
+func TestGetTodo_InvalidID(t *testing.T) {
+	t.Parallel()
+	h, _ := newTodoHandler(t)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodGet, "/api/v1/todos/abc", nil), map[string]string{"id": "abc"})
+	h.GetTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusBadRequest)
+}
+
+// --- UpdateTodo ---
+
+func TestUpdateTodo_Success(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	existing := validTodo()
+	updated := validTodo()
+	updated.Title = testUpdatedValue
+	// The patch produces the full entity: the patched title merged onto
+	// the stored description, status, and category.
+	svc.EXPECT().PatchTodo(mock.Anything, int64(1), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ int64, patch func(*todo.Todo) *todo.Todo) (*todo.Todo, error) {
+			td := patch(&existing)
+			if td.Title != testUpdatedValue || td.Description != existing.Description ||
+				td.Status != existing.Status || td.Category != existing.Category {
+				t.Errorf("patched todo = %+v, want title %q merged onto the stored fields", td, testUpdatedValue)
+			}
+			return &updated, nil
+		})
+
+	title := testUpdatedValue
+	body := jsonBody(t, dto.UpdateTodoRequest{Title: &title})
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodPatch, "/api/v1/todos/1", body), map[string]string{"id": "1"})
+	h.UpdateTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[dto.TodoResponse](t, rec)
+	if resp.Title != testUpdatedValue {
+		t.Errorf("Title = %q, want %q", resp.Title, testUpdatedValue)
+	}
+}
+
+func TestUpdateTodo_NotFound(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	svc.EXPECT().PatchTodo(mock.Anything, int64(1), mock.Anything).Return(nil, domain.ErrNotFound)
+
+	title := testUpdatedValue
+	body := jsonBody(t, dto.UpdateTodoRequest{Title: &title})
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodPatch, "/api/v1/todos/1", body), map[string]string{"id": "1"})
+	h.UpdateTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusNotFound)
+}
+
+func TestUpdateTodo_EmptyBodyLeavesTodoUnchanged(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	existing := validTodo()
+	svc.EXPECT().PatchTodo(mock.Anything, int64(1), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ int64, patch func(*todo.Todo) *todo.Todo) (*todo.Todo, error) {
+			if td := patch(&existing); !td.SameContent(&existing) {
+				t.Errorf("empty PATCH changed the todo: %+v", td)
+			}
+			return &existing, nil
+		})
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodPatch, "/api/v1/todos/1", strings.NewReader("{}")),
+		map[string]string{"id": "1"})
+	h.UpdateTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[dto.TodoResponse](t, rec)
+	if resp.Title != existing.Title {
+		t.Errorf("Title = %q, want %q", resp.Title, existing.Title)
+	}
+}
+
+// --- ReplaceTodo ---
+
+func TestReplaceTodo_Success(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	replaced := validTodo()
+	replaced.Status = todo.StatusDone
+	svc.EXPECT().UpdateTodo(mock.Anything, int64(1), mock.MatchedBy(func(td *todo.Todo) bool {
+		return td.Status == todo.StatusDone && td.Category == todo.CategoryWork && td.ProgressPercent == 100
+	})).Return(&replaced, nil)
+
+	progress := 100
+	body := jsonBody(t, dto.ReplaceTodoRequest{
+		Title:           "Buy groceries",
+		Description:     "Milk, eggs, bread",
+		Status:          "done",
+		Category:        "work",
+		ProgressPercent: &progress,
+	})
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodPut, "/api/v1/todos/1", body), map[string]string{"id": "1"})
+	h.ReplaceTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusOK)
+	resp := decodeJSON[dto.TodoResponse](t, rec)
+	if resp.Status != "done" {
+		t.Errorf("Status = %q, want %q", resp.Status, "done")
+	}
+}
+
+// TestReplaceTodo_MissingFieldVsPatch sends the same body, which omits the
+// required title, to PUT and PATCH: PUT rejects it before reaching the
+// service, PATCH treats the omission as "leave unchanged".
+func TestReplaceTodo_MissingFieldVsPatch(t *testing.T) {
+	t.Parallel()
+
+	const partial = `{"description":"Milk","status":"done","category":"work","progress_percent":100}`
+
+	t.Run("PUT rejects", func(t *testing.T) {
+		t.Parallel()
+		h, _ := newTodoHandler(t)
+
+		rec := httptest.NewRecorder()
+		req := withChiParams(httptest.NewRequest(http.MethodPut, "/api/v1/todos/1", strings.NewReader(partial)),
+			map[string]string{"id": "1"})
+		h.ReplaceTodo(rec, req)
+
+		requireStatus(t, rec, http.StatusBadRequest)
+		resp := decodeJSON[dto.ErrorResponse](t, rec)
+		if len(resp.Errors) != 1 || resp.Errors[0].Location != "body.title" {
+			t.Errorf("Errors = %+v, want single body.title error", resp.Errors)
+		}
+	})
+
+	t.Run("PATCH accepts", func(t *testing.T) {
+		t.Parallel()
+		h, svc := newTodoHandler(t)
+
+		updated := validTodo()
+		svc.EXPECT().PatchTodo(mock.Anything, int64(1), mock.Anything).Return(&updated, nil)
+
+		rec := httptest.NewRecorder()
+		req := withChiParams(httptest.NewRequest(http.MethodPatch, "/api/v1/todos/1", strings.NewReader(partial)),
+			map[string]string{"id": "1"})
+		h.UpdateTodo(rec, req)
+
+		requireStatus(t, rec, http.StatusOK)
+	})
+}
+
+// --- DeleteTodo ---
+
+func TestDeleteTodo_Success(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	svc.EXPECT().DeleteTodo(mock.Anything, int64(1)).Return(nil)
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodDelete, "/api/v1/todos/1", nil), map[string]string{"id": "1"})
+	h.DeleteTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusNoContent)
+}
+
+func TestDeleteTodo_ServiceError(t *testing.T) {
+	t.Parallel()
+	h, svc := newTodoHandler(t)
+
+	svc.EXPECT().DeleteTodo(mock.Anything, int64(1)).Return(errors.New("boom"))
+
+	rec := httptest.NewRecorder()
+	req := withChiParams(httptest.NewRequest(http.MethodDelete, "/api/v1/todos/1", nil), map[string]string{"id": "1"})
+	h.DeleteTodo(rec, req)
+
+	requireStatus(t, rec, http.StatusInternalServerError)
+}
@@ -10,6 +10,9 @@ import (
 // HTTP request and stores it in the request context. Downstream handlers
 // and application services can retrieve it via appctx.FromContext(ctx).
 //
+// When StartTime is earlier in the chain, the RequestContext's Started time
+// is the stored RequestStart rather than the time AppContext runs.
+//
 // This middleware should be registered after CorrelationID (so that the
 // RequestContext's embedded context carries request/correlation IDs) and
 // before OpenTelemetry (so that the RequestContext is available when
@@ -17,7 +20,11 @@ import (
 func AppContext() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			rc := appctx.New(r.Context())
+			var opts []appctx.Option
+			if start := RequestStart(r.Context()); !start.IsZero() {
+				opts = append(opts, appctx.WithStarted(start))
+			}
+			rc := appctx.New(r.Context(), opts...)
 			ctx := appctx.WithRequestContext(r.Context(), rc)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// ParseTrustedProxies parses CIDR strings (e.g. from config) into prefixes
+// for Logging. Returns an error naming the first invalid entry.
+func ParseTrustedProxies(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, c := range cidrs {
+		p, err := netip.ParsePrefix(strings.TrimSpace(c))
+		if err != nil {
+			return nil, fmt.Errorf("parsing trusted proxy %q: %w", c, err)
+		}
+		prefixes = append(prefixes, p.Masked())
+	}
+	return prefixes, nil
+}
+
+// ClientIP resolves the originating client IP for r. The direct peer
+// (RemoteAddr) is returned unless it is a trusted proxy, in which case
+// X-Forwarded-For is walked right to left and the first address that is not
+// itself a trusted proxy is returned. If every hop is trusted, the leftmost
+// entry is used.
+func ClientIP(r *http.Request, trustedProxies []netip.Prefix) string {
+	peer := remoteAddrIP(r.RemoteAddr)
+	if !isTrusted(peer, trustedProxies) {
+		return peer
+	}
+
+	hops := forwardedFor(r.Header)
+	for i := len(hops) - 1; i >= 0; i-- {
+		if !isTrusted(hops[i], trustedProxies) {
+			return hops[i]
+		}
+	}
+	if len(hops) > 0 {
+		return hops[0]
+	}
+	return peer
+}
+
+// remoteAddrIP strips the port from a RemoteAddr value.
+func remoteAddrIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+// forwardedFor returns the trimmed, non-empty entries of all X-Forwarded-For
+// headers in order.
+func forwardedFor(h http.Header) []string {
+	var hops []string
+	for _, v := range h.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(v, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	return hops
+}
+
+// isTrusted reports whether ip parses and falls within any trusted prefix.
+func isTrusted(ip string, trustedProxies []netip.Prefix) bool {
+	if len(trustedProxies) == 0 {
+		return false
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range trustedProxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
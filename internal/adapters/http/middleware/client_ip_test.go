@@ -0,0 +1,59 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
+)
+
+func TestClientIP(t *testing.T) {
+	t.Parallel()
+
+	trusted := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.168.1.0/24"),
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		xff        string
+		trusted    []netip.Prefix
+		want       string
+	}{
+		{name: "no proxy", remoteAddr: "198.51.100.1:1234", want: "198.51.100.1"},
+		{name: "untrusted peer ignores XFF", remoteAddr: "198.51.100.1:1234", xff: "1.2.3.4", trusted: trusted, want: "198.51.100.1"},
+		{name: "no trusted proxies ignores XFF", remoteAddr: "10.0.0.1:1234", xff: "1.2.3.4", want: "10.0.0.1"},
+		{name: "trusted peer uses XFF", remoteAddr: "10.0.0.1:1234", xff: "1.2.3.4", trusted: trusted, want: "1.2.3.4"},
+		{name: "skips trusted hops", remoteAddr: "10.0.0.1:1234", xff: "1.2.3.4, 5.6.7.8, 192.168.1.10", trusted: trusted, want: "5.6.7.8"},
+		{name: "all hops trusted uses leftmost", remoteAddr: "10.0.0.1:1234", xff: "10.0.0.2, 10.0.0.3", trusted: trusted, want: "10.0.0.2"},
+		{name: "trusted peer without XFF", remoteAddr: "10.0.0.1:1234", trusted: trusted, want: "10.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.xff != "" {
+				req.Header.Set("X-Forwarded-For", tt.xff)
+			}
+
+			if got := middleware.ClientIP(req, tt.trusted); got != tt.want {
+				t.Errorf("ClientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies_Invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := middleware.ParseTrustedProxies([]string{"10.0.0.0/8", "not-a-cidr"}); err == nil {
+		t.Error("ParseTrustedProxies() error = nil, want error for invalid CIDR")
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+)
+
+// concurrencyRetryAfter is the Retry-After value, in seconds, sent with
+// requests rejected by ConcurrencyLimit.
+const concurrencyRetryAfter = "1"
+
+// ConcurrencyLimit returns middleware that lets at most limit requests run
+// through the rest of the chain at once. A request arriving while limit are
+// in flight is rejected immediately with an RFC 9457 503 Service
+// Unavailable response and a Retry-After header rather than queued. The
+// slot is released when the handler returns, including by panic. A
+// non-positive limit disables the limit.
+func ConcurrencyLimit(limit int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limit <= 0 {
+			return next
+		}
+		slots := make(chan struct{}, limit)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case slots <- struct{}{}:
+			default:
+				w.Header().Set("Retry-After", concurrencyRetryAfter)
+				dto.WriteErrorResponse(w, r, fmt.Errorf("%d requests in flight: %w", limit, domain.ErrOverloaded))
+				return
+			}
+			defer func() { <-slots }()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
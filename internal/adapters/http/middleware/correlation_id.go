@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/httpclient"
 )
 
@@ -14,10 +15,12 @@ type correlationIDKey struct{}
 
 // WithCorrelationID returns a new context with the given correlation ID stored
 // in it. It also stores the ID via httpclient.WithCorrelationID so that
-// outbound HTTP calls automatically include the X-Correlation-ID header.
+// outbound HTTP calls automatically include the X-Correlation-ID header, and
+// via appctx.WithCorrelationID so that audit records report it.
 func WithCorrelationID(ctx context.Context, id string) context.Context {
 	ctx = context.WithValue(ctx, correlationIDKey{}, id)
 	ctx = httpclient.WithCorrelationID(ctx, id)
+	ctx = appctx.WithCorrelationID(ctx, id)
 	return ctx
 }
 
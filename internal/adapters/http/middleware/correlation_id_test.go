@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
 )
 
 func TestCorrelationID_ExtractsFromHeader(t *testing.T) {
@@ -88,3 +89,20 @@ func TestWithCorrelationID_StoresInContext(t *testing.T) {
 		t.Errorf("CorrelationIDFromContext = %q, want %q", got, "test-corr")
 	}
 }
+
+func TestCorrelationID_StoresForAppLayer(t *testing.T) {
+	t.Parallel()
+
+	var got string
+	handler := middleware.CorrelationID()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = appctx.CorrelationID(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("X-Correlation-ID", "corr-abc")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got != "corr-abc" {
+		t.Errorf("appctx.CorrelationID = %q, want %q", got, "corr-abc")
+	}
+}
@@ -0,0 +1,132 @@
+package middleware_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
+)
+
+func gzipBytes(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		t.Fatalf("gzip write: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("gzip close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecompressRequest_GzipBulkUpdate(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"updates":[{"todo_id":1,"status":"done"},{"todo_id":2,"title":"Renamed"}]}`
+
+	var got dto.BulkUpdateTodosRequest
+	var gotEncoding string
+	handler := middleware.DecompressRequest()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotEncoding = r.Header.Get("Content-Encoding")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decoding body: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/projects/1/todos", bytes.NewReader(gzipBytes(t, []byte(payload))))
+	req.Header.Set("Content-Encoding", "gzip")
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if gotEncoding != "" {
+		t.Errorf("Content-Encoding seen by handler = %q, want empty", gotEncoding)
+	}
+	if err := got.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+	if len(got.Updates) != 2 || got.Updates[0].TodoID != 1 || got.Updates[1].TodoID != 2 {
+		t.Errorf("decoded updates = %+v, want todo_ids 1 and 2", got.Updates)
+	}
+}
+
+func TestDecompressRequest_Rejects(t *testing.T) {
+	t.Parallel()
+
+	// Ten megabytes of zeros compresses to a few kilobytes.
+	bomb := gzipBytes(t, make([]byte, 10<<20))
+	valid := gzipBytes(t, []byte(`{"updates":[]}`))
+
+	tests := []struct {
+		name       string
+		body       []byte
+		wantStatus int
+	}{
+		{name: "zip bomb", body: bomb, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "not gzip", body: []byte(`{"updates":[]}`), wantStatus: http.StatusBadRequest},
+		{name: "truncated stream", body: valid[:len(valid)-6], wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			handler := middleware.DecompressRequest()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/projects/1/todos", bytes.NewReader(tt.body))
+			req.Header.Set("Content-Encoding", "gzip")
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if called {
+				t.Error("next handler was called for a rejected body")
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+				t.Errorf("Content-Type = %q, want %q", ct, "application/problem+json")
+			}
+		})
+	}
+}
+
+func TestDecompressRequest_PassesThroughUnencoded(t *testing.T) {
+	t.Parallel()
+
+	const payload = `{"updates":[]}`
+	var got string
+	handler := middleware.DecompressRequest()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		got = string(b)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/projects/1/todos", strings.NewReader(payload))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got != payload {
+		t.Errorf("body = %q, want %q", got, payload)
+	}
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/flags"
+)
+
+// FeatureFlags returns middleware that resolves each request's feature
+// flags with p, honoring the X-Feature-Flags header only if p allows it,
+// and stores them in the request context for flags.Enabled.
+func FeatureFlags(p *flags.Provider) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			set := p.Resolve(r.Header.Get(flags.Header))
+			next.ServeHTTP(w, r.WithContext(flags.WithFlags(r.Context(), set)))
+		})
+	}
+}
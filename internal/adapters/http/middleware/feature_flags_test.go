@@ -0,0 +1,48 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/flags"
+)
+
+func TestFeatureFlags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		allowHeader bool
+		header      string
+		want        bool
+	}{
+		{name: "default applies", want: false},
+		{name: "header overrides in non-prod", allowHeader: true, header: "envelope", want: true},
+		{name: "header ignored in prod", allowHeader: false, header: "envelope", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := flags.NewProvider(map[string]bool{"envelope": false}, flags.WithHeaderOverride(tt.allowHeader))
+
+			var got bool
+			handler := middleware.FeatureFlags(p)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = flags.Enabled(r.Context(), "envelope")
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/todos", http.NoBody)
+			if tt.header != "" {
+				req.Header.Set(flags.Header, tt.header)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if got != tt.want {
+				t.Errorf("Enabled(envelope) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
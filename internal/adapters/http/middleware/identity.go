@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/app/identity"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/httpclient"
+)
+
+// Identity sources for IdentityConfig.Source.
+const (
+	IdentitySourceHeader = "header"
+	IdentitySourceJWT    = "jwt"
+)
+
+// maxUserIDLength bounds a user ID taken from a header or token claim.
+const maxUserIDLength = 256
+
+// errMissingIdentity is returned when a request carries no identity.
+var errMissingIdentity = errors.New("missing identity")
+
+// IdentityConfig configures Identity.
+type IdentityConfig struct {
+	// Source is IdentitySourceHeader, IdentitySourceJWT, or empty to
+	// disable the middleware.
+	Source string
+	// Header names the trusted header carrying the user ID for the header
+	// source.
+	Header string
+	// JWT verifies bearer tokens for the jwt source.
+	JWT JWTConfig
+	// Required rejects requests without an identity.
+	Required bool
+}
+
+// Identity returns middleware that resolves the caller's user ID and stores
+// it via identity.WithIdentity. With the header source the ID is read from
+// cfg.Header, which is trusted as is, so it must be set by an upstream
+// gateway that authenticates callers and strips any client-supplied value.
+// With the jwt source it is the configured claim of a verified
+// "Authorization: Bearer" token, whose claims are stored alongside it.
+//
+// A malformed header value or an invalid token is rejected with an RFC 9457
+// 401 response. A request with no identity at all is rejected the same way
+// only when cfg.Required is set; otherwise it proceeds anonymously. When the
+// token's signing key cannot be resolved because the key set is unavailable
+// (httpclient.ErrKeySetUnavailable), the request fails with a 502 instead.
+func Identity(cfg IdentityConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if cfg.Source == "" {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			caller, err := resolveIdentity(r, cfg)
+			switch {
+			case errors.Is(err, errMissingIdentity) && !cfg.Required:
+				next.ServeHTTP(w, r)
+				return
+			case errors.Is(err, httpclient.ErrKeySetUnavailable):
+				// The token may well be valid; the issuer's keys just
+				// could not be fetched, so this is not a 401.
+				dto.WriteErrorResponse(w, r, fmt.Errorf("%w: %w", domain.ErrUnavailable, err))
+				return
+			case err != nil:
+				if cfg.Source == IdentitySourceJWT {
+					w.Header().Set("WWW-Authenticate", `Bearer`)
+				}
+				dto.WriteErrorResponse(w, r, fmt.Errorf("%w: %w", domain.ErrUnauthenticated, err))
+				return
+			}
+			ctx := identity.WithIdentity(r.Context(), caller)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// resolveIdentity extracts the caller from r according to cfg.Source. It
+// returns errMissingIdentity when the request carries none.
+func resolveIdentity(r *http.Request, cfg IdentityConfig) (identity.Identity, error) {
+	if cfg.Source == IdentitySourceJWT {
+		raw := r.Header.Get("Authorization")
+		if raw == "" {
+			return identity.Identity{}, errMissingIdentity
+		}
+		token, ok := strings.CutPrefix(raw, "Bearer ")
+		if !ok || token == "" {
+			return identity.Identity{}, errors.New("authorization header is not a bearer token")
+		}
+		userID, claims, err := verifyJWT(r.Context(), token, cfg.JWT, time.Now())
+		if err != nil {
+			return identity.Identity{}, err
+		}
+		return identity.Identity{UserID: userID, Claims: claims}, nil
+	}
+
+	userID := r.Header.Get(cfg.Header)
+	if userID == "" {
+		return identity.Identity{}, errMissingIdentity
+	}
+	if err := validateUserID(userID); err != nil {
+		return identity.Identity{}, fmt.Errorf("%s header: %w", cfg.Header, err)
+	}
+	return identity.Identity{UserID: userID}, nil
+}
+
+// validateUserID rejects user IDs that are too long or contain whitespace
+// or control characters.
+func validateUserID(userID string) error {
+	if len(userID) > maxUserIDLength {
+		return fmt.Errorf("user ID longer than %d bytes", maxUserIDLength)
+	}
+	if strings.IndexFunc(userID, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	}) >= 0 {
+		return errors.New("user ID contains whitespace or control characters")
+	}
+	return nil
+}
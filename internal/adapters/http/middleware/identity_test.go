@@ -0,0 +1,347 @@
+package middleware_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/app/identity"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/httpclient"
+)
+
+var testJWTSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// signJWT builds a token with the given alg header and claims, signed with
+// HS256 over secret regardless of alg.
+func signJWT(t *testing.T, alg string, claims map[string]any, secret []byte) string {
+	t.Helper()
+	segment := func(v any) string {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("marshal JWT segment: %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(raw)
+	}
+	signingInput := segment(map[string]string{"alg": alg, "typ": "JWT"}) + "." + segment(claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// signRS256 builds an RS256 token with the given key ID and claims.
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+	segment := func(v any) string {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("marshal JWT segment: %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(raw)
+	}
+	signingInput := segment(map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid}) + "." + segment(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("signing JWT: %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+// staticKeySet is a KeySet over a fixed set of public keys.
+type staticKeySet map[string]*rsa.PublicKey
+
+func (s staticKeySet) Key(_ context.Context, kid string) (*rsa.PublicKey, error) {
+	key, ok := s[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// unavailableKeySet is a KeySet whose issuer cannot be reached.
+type unavailableKeySet struct{}
+
+func (unavailableKeySet) Key(_ context.Context, kid string) (*rsa.PublicKey, error) {
+	return nil, fmt.Errorf("%w: resolving key %q: connection refused", httpclient.ErrKeySetUnavailable, kid)
+}
+
+// serveIdentity runs a request through Identity and reports the status and
+// the identity the handler saw.
+func serveIdentity(cfg middleware.IdentityConfig, req *http.Request) (*httptest.ResponseRecorder, identity.Identity, bool) {
+	var (
+		got identity.Identity
+		ok  bool
+	)
+	handler := middleware.Identity(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok = identity.FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec, got, ok
+}
+
+func TestIdentity_Header(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		value      string
+		required   bool
+		wantStatus int
+		wantUserID string
+	}{
+		{name: "present", value: "user-42", wantStatus: http.StatusOK, wantUserID: "user-42"},
+		{name: "missing, optional", wantStatus: http.StatusOK},
+		{name: "missing, required", required: true, wantStatus: http.StatusUnauthorized},
+		{name: "malformed", value: "user 42", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := middleware.IdentityConfig{
+				Source:   middleware.IdentitySourceHeader,
+				Header:   "X-User-ID",
+				Required: tt.required,
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/todos", http.NoBody)
+			if tt.value != "" {
+				req.Header.Set("X-User-ID", tt.value)
+			}
+			rec, got, ok := serveIdentity(cfg, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusUnauthorized {
+				if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+					t.Errorf("Content-Type = %q, want %q", ct, "application/problem+json")
+				}
+				return
+			}
+			if ok != (tt.wantUserID != "") || got.UserID != tt.wantUserID {
+				t.Errorf("identity = %+v (present %v), want UserID %q", got, ok, tt.wantUserID)
+			}
+		})
+	}
+}
+
+func TestIdentity_JWT(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	valid := map[string]any{"sub": "user-42", "aud": []string{"todo-api"}, "exp": now.Add(time.Hour).Unix()}
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{"valid", "Bearer " + signJWT(t, "HS256", valid, testJWTSecret), http.StatusOK},
+		{"missing", "", http.StatusUnauthorized},
+		{"not bearer", "Basic dXNlcjpwYXNz", http.StatusUnauthorized},
+		{"not a JWT", "Bearer abc.def", http.StatusUnauthorized},
+		{"wrong secret", "Bearer " + signJWT(t, "HS256", valid, []byte("another-secret-another-secret-xx")), http.StatusUnauthorized},
+		{"alg none", "Bearer " + signJWT(t, "none", valid, testJWTSecret), http.StatusUnauthorized},
+		{"expired", "Bearer " + signJWT(t, "HS256", map[string]any{
+			"sub": "user-42", "aud": "todo-api", "exp": now.Add(-time.Minute).Unix(),
+		}, testJWTSecret), http.StatusUnauthorized},
+		{"no exp", "Bearer " + signJWT(t, "HS256", map[string]any{"sub": "user-42", "aud": "todo-api"}, testJWTSecret), http.StatusUnauthorized},
+		{"wrong audience", "Bearer " + signJWT(t, "HS256", map[string]any{
+			"sub": "user-42", "aud": "other-api", "exp": now.Add(time.Hour).Unix(),
+		}, testJWTSecret), http.StatusUnauthorized},
+		{"no subject", "Bearer " + signJWT(t, "HS256", map[string]any{
+			"aud": "todo-api", "exp": now.Add(time.Hour).Unix(),
+		}, testJWTSecret), http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := middleware.IdentityConfig{
+				Source:   middleware.IdentitySourceJWT,
+				JWT:      middleware.JWTConfig{Secret: testJWTSecret, Audience: "todo-api", Claim: "sub"},
+				Required: true,
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/todos", http.NoBody)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec, got, _ := serveIdentity(cfg, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK {
+				if got.UserID != "user-42" {
+					t.Errorf("UserID = %q, want %q", got.UserID, "user-42")
+				}
+			} else if h := rec.Header().Get("WWW-Authenticate"); h != "Bearer" {
+				t.Errorf("WWW-Authenticate = %q, want %q", h, "Bearer")
+			}
+		})
+	}
+}
+
+func TestIdentity_JWTKeepsLargeNumericClaims(t *testing.T) {
+	t.Parallel()
+
+	claims := map[string]any{
+		"sub":       "user-42",
+		"exp":       time.Now().Add(time.Hour).Unix(),
+		"tenant_id": int64(9007199254740993),
+	}
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/todos", http.NoBody)
+	req.Header.Set("Authorization", "Bearer "+signJWT(t, "HS256", claims, testJWTSecret))
+
+	rec, got, _ := serveIdentity(middleware.IdentityConfig{
+		Source: middleware.IdentitySourceJWT,
+		JWT:    middleware.JWTConfig{Secret: testJWTSecret, Claim: "sub"},
+	}, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if tenant := got.Claims["tenant_id"]; tenant != json.Number("9007199254740993") {
+		t.Errorf("Claims[tenant_id] = %#v, want json.Number(\"9007199254740993\")", tenant)
+	}
+}
+
+func TestIdentity_Disabled(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/todos", http.NoBody)
+	req.Header.Set("X-User-ID", "user-42")
+	rec, _, ok := serveIdentity(middleware.IdentityConfig{Header: "X-User-ID", Required: true}, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if ok {
+		t.Error("identity stored with no source configured")
+	}
+}
+
+func TestIdentity_JWKS(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %v", err)
+	}
+	now := time.Now()
+	claims := map[string]any{
+		"sub":   "user-42",
+		"iss":   "https://issuer.example.com",
+		"aud":   "todo-api",
+		"exp":   now.Add(time.Hour).Unix(),
+		"scope": "todos:write",
+	}
+	valid := signRS256(t, key, "k1", claims)
+
+	// Swap in a payload granting a different subject, keeping the signature.
+	parts := strings.Split(valid, ".")
+	forged, err := json.Marshal(map[string]any{
+		"sub": "admin", "iss": claims["iss"], "aud": claims["aud"], "exp": claims["exp"],
+	})
+	if err != nil {
+		t.Fatalf("marshal forged claims: %v", err)
+	}
+	tampered := parts[0] + "." + base64.RawURLEncoding.EncodeToString(forged) + "." + parts[2]
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{"valid", valid, http.StatusOK},
+		{"tampered payload", tampered, http.StatusUnauthorized},
+		{"unknown kid", signRS256(t, key, "k2", claims), http.StatusUnauthorized},
+		{"HS256 rejected", signJWT(t, "HS256", claims, testJWTSecret), http.StatusUnauthorized},
+		{"wrong issuer", signRS256(t, key, "k1", map[string]any{
+			"sub": "user-42", "iss": "https://evil.example.com", "aud": "todo-api", "exp": now.Add(time.Hour).Unix(),
+		}), http.StatusUnauthorized},
+		{"expired", signRS256(t, key, "k1", map[string]any{
+			"sub": "user-42", "iss": "https://issuer.example.com", "aud": "todo-api", "exp": now.Add(-time.Minute).Unix(),
+		}), http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := middleware.IdentityConfig{
+				Source: middleware.IdentitySourceJWT,
+				JWT: middleware.JWTConfig{
+					Keys:     staticKeySet{"k1": &key.PublicKey},
+					Secret:   testJWTSecret,
+					Issuer:   "https://issuer.example.com",
+					Audience: "todo-api",
+					Claim:    "sub",
+				},
+				Required: true,
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/todos", http.NoBody)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rec, got, _ := serveIdentity(cfg, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got.UserID != "user-42" {
+				t.Errorf("UserID = %q, want %q", got.UserID, "user-42")
+			}
+			if got.Claims["scope"] != "todos:write" {
+				t.Errorf("Claims[scope] = %v, want %q", got.Claims["scope"], "todos:write")
+			}
+		})
+	}
+}
+
+func TestIdentity_JWKSUnavailable(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %v", err)
+	}
+	token := signRS256(t, key, "k1", map[string]any{
+		"sub": "user-42", "exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	cfg := middleware.IdentityConfig{
+		Source:   middleware.IdentitySourceJWT,
+		JWT:      middleware.JWTConfig{Keys: unavailableKeySet{}, Claim: "sub"},
+		Required: true,
+	}
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/todos", http.NoBody)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec, _, ok := serveIdentity(cfg, req)
+
+	if rec.Code != http.StatusBadGateway {
+		t.Fatalf("status = %d, want %d; body: %s", rec.Code, http.StatusBadGateway, rec.Body.String())
+	}
+	if got := rec.Header().Get("WWW-Authenticate"); got != "" {
+		t.Errorf("WWW-Authenticate = %q, want none for an unavailable key set", got)
+	}
+	if ok {
+		t.Error("handler reached with an unverifiable token")
+	}
+}
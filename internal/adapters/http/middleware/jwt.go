@@ -0,0 +1,189 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// KeySet resolves RS256 verification keys by key ID.
+// *httpclient.KeySet implements it with a cached JWKS endpoint. An error
+// wrapping httpclient.ErrKeySetUnavailable means the keys could not be
+// fetched; any other error rejects the token.
+type KeySet interface {
+	Key(ctx context.Context, kid string) (*rsa.PublicKey, error)
+}
+
+// JWTConfig configures bearer token verification for Identity. Tokens are
+// verified with exactly one of Keys (RS256) or Secret (HS256); a token
+// signed with the other algorithm is rejected, so a public key can never be
+// used as an HMAC secret.
+type JWTConfig struct {
+	// Keys resolves RS256 keys, typically from the issuer's JWKS endpoint.
+	Keys KeySet
+	// Secret is the HS256 signing key, used when Keys is nil.
+	Secret []byte
+	// Issuer and Audience, when set, must match the iss and aud claims.
+	Issuer   string
+	Audience string
+	// Claim names the string claim holding the user ID.
+	Claim string
+}
+
+// jwtHeader is the part of a JOSE header verifyJWT checks.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// verifyJWT checks token's signature and its exp, nbf, iss, and aud claims at
+// now, and returns the user ID from cfg.Claim along with all claims.
+func verifyJWT(ctx context.Context, token string, cfg JWTConfig, now time.Time) (string, map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", nil, errors.New("token is not a JWT")
+	}
+
+	var header jwtHeader
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return "", nil, fmt.Errorf("token header: %w", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, errors.New("token signature is not base64url")
+	}
+	if err := verifyJWTSignature(ctx, header, parts[0]+"."+parts[1], sig, cfg); err != nil {
+		return "", nil, err
+	}
+
+	var claims map[string]any
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return "", nil, fmt.Errorf("token claims: %w", err)
+	}
+	if err := checkJWTClaims(claims, cfg, now); err != nil {
+		return "", nil, err
+	}
+
+	userID, _ := claims[cfg.Claim].(string)
+	if userID == "" {
+		return "", nil, fmt.Errorf("token has no %q claim", cfg.Claim)
+	}
+	if err := validateUserID(userID); err != nil {
+		return "", nil, fmt.Errorf("token %q claim: %w", cfg.Claim, err)
+	}
+	return userID, claims, nil
+}
+
+// verifyJWTSignature checks sig over signingInput with the one algorithm cfg
+// allows: RS256 with a key from cfg.Keys, or HS256 with cfg.Secret.
+func verifyJWTSignature(ctx context.Context, header jwtHeader, signingInput string, sig []byte, cfg JWTConfig) error {
+	if cfg.Keys != nil {
+		if header.Alg != "RS256" {
+			return fmt.Errorf("token algorithm %q not accepted", header.Alg)
+		}
+		if header.Kid == "" {
+			return errors.New("token header has no kid")
+		}
+		key, err := cfg.Keys.Key(ctx, header.Kid)
+		if err != nil {
+			return fmt.Errorf("resolving token key: %w", err)
+		}
+		digest := sha256.Sum256([]byte(signingInput))
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) != nil {
+			return errors.New("token signature is invalid")
+		}
+		return nil
+	}
+
+	if header.Alg != "HS256" {
+		return fmt.Errorf("token algorithm %q not accepted", header.Alg)
+	}
+	mac := hmac.New(sha256.New, cfg.Secret)
+	mac.Write([]byte(signingInput))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return errors.New("token signature is invalid")
+	}
+	return nil
+}
+
+// checkJWTClaims validates the registered time, issuer, and audience claims.
+// exp is required so a leaked token cannot be replayed forever.
+func checkJWTClaims(claims map[string]any, cfg JWTConfig, now time.Time) error {
+	exp, ok := jwtNumericDate(claims["exp"])
+	if !ok {
+		return errors.New("token has no exp claim")
+	}
+	if now.Unix() >= exp {
+		return errors.New("token is expired")
+	}
+	if nbf, ok := jwtNumericDate(claims["nbf"]); ok && now.Unix() < nbf {
+		return errors.New("token is not valid yet")
+	}
+	if cfg.Issuer != "" && claims["iss"] != cfg.Issuer {
+		return errors.New("token issuer is not accepted")
+	}
+	if cfg.Audience != "" && !jwtAudienceContains(claims["aud"], cfg.Audience) {
+		return errors.New("token audience is not accepted")
+	}
+	return nil
+}
+
+// jwtNumericDate returns a NumericDate claim (RFC 7519 section 2) in whole
+// seconds, truncating any fraction.
+func jwtNumericDate(v any) (int64, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	if i, err := n.Int64(); err == nil {
+		return i, true
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+// jwtAudienceContains reports whether the aud claim, a string or an array of
+// strings, includes want.
+func jwtAudienceContains(aud any, want string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == want
+	case []any:
+		return slices.Contains(v, any(want))
+	default:
+		return false
+	}
+}
+
+// decodeJWTSegment decodes a base64url JSON segment into dst. Numbers in
+// claims decode as json.Number so large numeric claims keep their
+// precision.
+func decodeJWTSegment(segment string, dst any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return errors.New("not base64url")
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(dst); err != nil {
+		return errors.New("not a JSON object")
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("trailing data after JSON object")
+	}
+	return nil
+}
@@ -3,19 +3,29 @@ package middleware
 import (
 	"log/slog"
 	"net/http"
+	"net/netip"
 	"time"
 
+	"github.com/go-chi/chi/v5"
+
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/logging"
 )
 
 // Logging returns middleware that logs request start and completion events.
 // It creates a child logger enriched with the request ID and correlation ID
 // from context, stores it via logging.WithLogger for downstream use, and
-// logs completion with method, path, status code, and duration.
-func Logging(logger *slog.Logger) func(http.Handler) http.Handler {
+// logs completion as an access log with method, path, matched route pattern,
+// status code, bytes written, client IP, user agent, and duration.
+//
+// The duration is measured from the StartTime middleware's RequestStart when
+// present, otherwise from when Logging itself is entered.
+//
+// The client IP is taken from X-Forwarded-For only when the direct peer is
+// within one of trustedProxies; see ClientIP.
+func Logging(logger *slog.Logger, trustedProxies ...netip.Prefix) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			start := time.Now()
+			start := requestStartOrNow(r)
 			ctx := r.Context()
 
 			reqID := RequestIDFromContext(ctx)
@@ -42,9 +52,22 @@ func Logging(logger *slog.Logger) func(http.Handler) http.Handler {
 			child.InfoContext(ctx, "request completed",
 				slog.String("method", r.Method),
 				slog.String("path", r.URL.Path),
+				slog.String("route", routePattern(r)),
 				slog.Int("status", rw.statusCode),
+				slog.Int64("bytes_written", rw.written),
+				slog.String("remote_ip", ClientIP(r, trustedProxies)),
+				slog.String("user_agent", r.UserAgent()),
 				slog.Duration("duration", time.Since(start)),
 			)
 		})
 	}
 }
+
+// routePattern returns the chi route pattern matched for r (e.g.
+// "/api/v1/projects/{id}"), or "" when the request was not routed by chi.
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		return rctx.RoutePattern()
+	}
+	return ""
+}
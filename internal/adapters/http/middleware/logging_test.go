@@ -2,12 +2,16 @@ package middleware_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"strings"
 	"testing"
 
+	"github.com/go-chi/chi/v5"
+
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/logging"
 )
@@ -201,3 +205,50 @@ func TestLogging_HeadersNotLoggedAtInfoLevel(t *testing.T) {
 		t.Error("log output missing 'request started'")
 	}
 }
+
+func TestLogging_AccessLogFields(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	r := chi.NewRouter()
+	r.Use(middleware.Logging(logger, trusted...))
+	r.Get("/items/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/items/7", http.NoBody)
+	req.RemoteAddr = "10.1.2.3:5555"
+	req.Header.Set("X-Forwarded-For", "203.0.113.9, 10.0.0.5")
+	req.Header.Set("User-Agent", "test-agent/1.0")
+	r.ServeHTTP(rec, req)
+
+	var completed map[string]any
+	for line := range strings.SplitSeq(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("invalid JSON log line %q: %v", line, err)
+		}
+		if entry["msg"] == "request completed" {
+			completed = entry
+		}
+	}
+	if completed == nil {
+		t.Fatalf("no 'request completed' entry in %s", buf.String())
+	}
+
+	want := map[string]any{
+		"bytes_written": float64(5),
+		"remote_ip":     "203.0.113.9",
+		"user_agent":    "test-agent/1.0",
+		"route":         "/items/{id}",
+	}
+	for k, v := range want {
+		if completed[k] != v {
+			t.Errorf("%s = %v, want %v", k, completed[k], v)
+		}
+	}
+}
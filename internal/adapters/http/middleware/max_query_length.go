@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+)
+
+// MaxQueryLength returns middleware that rejects requests whose raw query
+// string exceeds n bytes with an RFC 9457 414 URI Too Long response. This
+// guards against abusive query strings (e.g. oversized ids= lists) before
+// they reach handlers or the downstream API. A non-positive n disables the
+// check.
+func MaxQueryLength(n int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if n <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(r.URL.RawQuery) > n {
+				dto.WriteErrorResponse(w, r, fmt.Errorf("query string exceeds %d bytes: %w", n, domain.ErrURITooLong))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
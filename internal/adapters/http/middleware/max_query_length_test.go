@@ -0,0 +1,55 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
+)
+
+func TestMaxQueryLength(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		limit      int
+		query      string
+		wantStatus int
+		wantCalled bool
+	}{
+		{name: "short query passes", limit: 32, query: "status=pending", wantStatus: http.StatusOK, wantCalled: true},
+		{name: "query at limit passes", limit: 8, query: "ids=1,23", wantStatus: http.StatusOK, wantCalled: true},
+		{name: "long query rejected", limit: 16, query: "ids=" + strings.Repeat("1,", 20), wantStatus: http.StatusRequestURITooLong},
+		{name: "zero limit disables check", limit: 0, query: strings.Repeat("a", 10000), wantStatus: http.StatusOK, wantCalled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var called bool
+			handler := middleware.MaxQueryLength(tt.limit)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/todos?"+tt.query, http.NoBody)
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if called != tt.wantCalled {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCalled)
+			}
+			if tt.wantStatus == http.StatusRequestURITooLong {
+				if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+					t.Errorf("Content-Type = %q, want %q", ct, "application/problem+json")
+				}
+			}
+		})
+	}
+}
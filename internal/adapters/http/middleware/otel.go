@@ -20,11 +20,17 @@ import (
 // request and records server request metrics. It extracts W3C Trace Context
 // from incoming headers so that distributed traces are connected.
 //
+// Metrics carry the chi route template (e.g. "/api/v1/projects/{id}") as
+// http.route rather than the concrete path, keeping label cardinality bounded.
+//
+// Request duration is measured from RequestStart when the StartTime
+// middleware is in the chain.
+//
 // If metrics is nil, metric recording is skipped (safe nil check).
 func OpenTelemetry(metrics *telemetry.Metrics) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			start := time.Now()
+			start := requestStartOrNow(r)
 
 			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
 
@@ -43,19 +49,24 @@ func OpenTelemetry(metrics *telemetry.Metrics) func(http.Handler) http.Handler {
 			next.ServeHTTP(rw, r.WithContext(ctx))
 
 			status := rw.statusCode
-			span.SetAttributes(attribute.Int("http.status_code", status))
+			route := routePattern(r)
+			span.SetAttributes(
+				attribute.Int("http.status_code", status),
+				attribute.String("http.route", route),
+			)
 			if status >= http.StatusInternalServerError {
 				span.SetStatus(codes.Error, http.StatusText(status))
 			}
 
-			recordServerMetrics(ctx, metrics, r.Method, start, status)
+			// Record within the span's context so duration exemplars carry its trace ID.
+			recordServerMetrics(ctx, metrics, r.Method, route, start, status)
 		})
 	}
 }
 
 // recordServerMetrics records server request duration and count metrics.
 // Safe to call with nil metrics.
-func recordServerMetrics(ctx context.Context, metrics *telemetry.Metrics, method string, start time.Time, status int) {
+func recordServerMetrics(ctx context.Context, metrics *telemetry.Metrics, method, route string, start time.Time, status int) {
 	if metrics == nil {
 		return
 	}
@@ -69,6 +80,7 @@ func recordServerMetrics(ctx context.Context, metrics *telemetry.Metrics, method
 
 	attrs := metric.WithAttributes(
 		telemetry.AttrHTTPMethod.String(method),
+		telemetry.AttrHTTPRoute.String(route),
 		telemetry.AttrHTTPStatus.Int(status),
 		telemetry.AttrResult.String(result),
 	)
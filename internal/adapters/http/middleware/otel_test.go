@@ -5,13 +5,17 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/telemetry"
 )
 
 // OTEL tests are NOT parallel because they modify the global TracerProvider.
@@ -106,20 +110,146 @@ func TestOpenTelemetry_SetsErrorStatusOn5xx(t *testing.T) {
 	}
 }
 
-func TestOpenTelemetry_NilMetricsNoPanic(t *testing.T) {
-	t.Parallel()
+func TestOpenTelemetry_ContinuesInboundTrace(t *testing.T) {
+	exporter := setupTracer(t)
+
+	const (
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanID = "00f067aa0ba902b7"
+	)
 
 	handler := middleware.OpenTelemetry(nil)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
 
 	rec := httptest.NewRecorder()
-	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req := httptest.NewRequest(http.MethodGet, "/traced", http.NoBody)
+	req.Header.Set("traceparent", "00-"+traceID+"-"+parentSpanID+"-01")
+	handler.ServeHTTP(rec, req)
+
+	spans := exporter.GetSpans()
+	if len(spans) == 0 {
+		t.Fatal("no spans recorded")
+	}
+
+	span := spans[0]
+	if got := span.SpanContext.TraceID().String(); got != traceID {
+		t.Errorf("trace ID = %q, want %q", got, traceID)
+	}
+	if got := span.Parent.SpanID().String(); got != parentSpanID {
+		t.Errorf("parent span ID = %q, want %q", got, parentSpanID)
+	}
+	if !span.Parent.IsRemote() {
+		t.Error("parent span context should be remote")
+	}
+}
 
-	// Should not panic with nil metrics.
+func TestOpenTelemetry_StartsRootSpanWithoutTraceparent(t *testing.T) {
+	exporter := setupTracer(t)
+
+	handler := middleware.OpenTelemetry(nil)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/untraced", http.NoBody)
 	handler.ServeHTTP(rec, req)
 
-	if rec.Code != http.StatusOK {
-		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	spans := exporter.GetSpans()
+	if len(spans) == 0 {
+		t.Fatal("no spans recorded")
+	}
+
+	if spans[0].Parent.IsValid() {
+		t.Errorf("parent = %v, want no parent", spans[0].Parent)
+	}
+}
+
+func TestOpenTelemetry_NilMetricsNoPanic(t *testing.T) {
+	t.Parallel()
+
+	for _, status := range []int{http.StatusOK, http.StatusBadRequest, http.StatusInternalServerError} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			t.Parallel()
+
+			handler := middleware.OpenTelemetry(nil)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(status)
+			}))
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+
+			// Should not panic with nil metrics on success or error paths.
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != status {
+				t.Errorf("status = %d, want %d", rec.Code, status)
+			}
+		})
+	}
+}
+
+// setupMetrics returns Metrics backed by a ManualReader for inspection.
+func setupMetrics(t *testing.T) (*telemetry.Metrics, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() {
+		_ = mp.Shutdown(t.Context())
+	})
+
+	metrics, err := telemetry.NewMetrics(mp, "test")
+	if err != nil {
+		t.Fatalf("NewMetrics() error = %v", err)
+	}
+	return metrics, reader
+}
+
+// findMetric returns the named metric from collected data, or fails the test.
+func findMetric(t *testing.T, reader *sdkmetric.ManualReader, name string) metricdata.Metrics {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(t.Context(), &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m
+			}
+		}
+	}
+	t.Fatalf("metric %q not recorded", name)
+	return metricdata.Metrics{}
+}
+
+func TestOpenTelemetry_RecordsRouteTemplate(t *testing.T) {
+	setupTracer(t)
+	metrics, reader := setupMetrics(t)
+
+	r := chi.NewRouter()
+	r.Use(middleware.OpenTelemetry(metrics))
+	r.Get("/api/v1/projects/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects/42", http.NoBody)
+	r.ServeHTTP(rec, req)
+
+	m := findMetric(t, reader, "http.server.request.duration")
+	hist, ok := m.Data.(metricdata.Histogram[float64])
+	if !ok || len(hist.DataPoints) != 1 {
+		t.Fatalf("unexpected histogram data: %#v", m.Data)
+	}
+
+	route, ok := hist.DataPoints[0].Attributes.Value(telemetry.AttrHTTPRoute)
+	if !ok {
+		t.Fatal("data point missing http.route attribute")
+	}
+	if got := route.AsString(); got != "/api/v1/projects/{id}" {
+		t.Errorf("http.route = %q, want %q", got, "/api/v1/projects/{id}")
 	}
 }
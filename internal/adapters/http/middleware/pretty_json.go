@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+)
+
+// PrettyJSON returns middleware that, when enabled, marks each request with
+// dto.WithIndentedJSON so its JSON response body, errors included, is
+// indented for reading. It is meant for non-production profiles; disabled,
+// it returns next unchanged.
+func PrettyJSON(enabled bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !enabled {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(dto.WithIndentedJSON(r.Context())))
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package middleware
+
+import (
+	"container/list"
+	"fmt"
+	"math"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+)
+
+// RateLimitConfig configures RateLimit.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained rate allowed per client IP. A
+	// non-positive value disables rate limiting.
+	RequestsPerSecond float64
+	// Burst is how many requests a client may make at once before the
+	// sustained rate applies. Values below 1 are treated as 1.
+	Burst int
+	// TrustedProxies are honored when resolving the client IP, as in
+	// ClientIP.
+	TrustedProxies []netip.Prefix
+	// Bypass lists client networks that are never limited.
+	Bypass []netip.Prefix
+	// MaxClients bounds how many per-client limiters are kept. When full,
+	// the least recently seen client is evicted. Values below 1 are treated
+	// as 1.
+	MaxClients int
+	// IdleTTL evicts limiters for clients not seen for this long. Zero
+	// keeps them until MaxClients forces eviction.
+	IdleTTL time.Duration
+}
+
+// RateLimit returns middleware that applies a token-bucket limit per client
+// IP, resolved with ClientIP. A request over the limit is rejected with an
+// RFC 9457 429 Too Many Requests response and a Retry-After header giving
+// the whole seconds until the client's next token. Clients in cfg.Bypass
+// are never limited. Limiters are kept in a bounded LRU so memory does not
+// grow with the number of distinct clients.
+func RateLimit(cfg RateLimitConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if cfg.RequestsPerSecond <= 0 {
+			return next
+		}
+		limiters := newClientLimiters(cfg)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := ClientIP(r, cfg.TrustedProxies)
+			if isTrusted(ip, cfg.Bypass) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if wait := limiters.reserve(ip, time.Now()); wait > 0 {
+				w.Header().Set("Retry-After", retryAfterSeconds(wait))
+				dto.WriteErrorResponse(w, r, fmt.Errorf("client %s over rate limit: %w", ip, domain.ErrRateLimited))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// retryAfterSeconds renders wait as a Retry-After value, rounded up to at
+// least one second.
+func retryAfterSeconds(wait time.Duration) string {
+	return strconv.Itoa(max(1, int(math.Ceil(wait.Seconds()))))
+}
+
+// clientLimiters is an LRU of per-client limiters with idle expiry. The
+// list is ordered from most to least recently seen.
+type clientLimiters struct {
+	mu      sync.Mutex
+	limit   rate.Limit
+	burst   int
+	max     int
+	idleTTL time.Duration
+	order   *list.List
+	byIP    map[string]*list.Element
+}
+
+// clientLimiter is the value stored in clientLimiters.order.
+type clientLimiter struct {
+	ip       string
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newClientLimiters(cfg RateLimitConfig) *clientLimiters {
+	return &clientLimiters{
+		limit:   rate.Limit(cfg.RequestsPerSecond),
+		burst:   max(1, cfg.Burst),
+		max:     max(1, cfg.MaxClients),
+		idleTTL: cfg.IdleTTL,
+		order:   list.New(),
+		byIP:    make(map[string]*list.Element),
+	}
+}
+
+// reserve takes a token for ip at now. It returns zero if the request may
+// proceed, or how long the client must wait for a token otherwise; in that
+// case no token is consumed.
+func (c *clientLimiters) reserve(ip string, now time.Time) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expire(now)
+	entry := c.get(ip, now)
+
+	res := entry.limiter.ReserveN(now, 1)
+	if wait := res.DelayFrom(now); wait > 0 {
+		res.CancelAt(now)
+		return wait
+	}
+	return 0
+}
+
+// get returns the limiter for ip, creating it and evicting the least
+// recently seen client if the LRU is full. Callers hold c.mu.
+func (c *clientLimiters) get(ip string, now time.Time) *clientLimiter {
+	if el, ok := c.byIP[ip]; ok {
+		c.order.MoveToFront(el)
+		entry := el.Value.(*clientLimiter)
+		entry.lastSeen = now
+		return entry
+	}
+
+	if c.order.Len() >= c.max {
+		c.remove(c.order.Back())
+	}
+	entry := &clientLimiter{ip: ip, limiter: rate.NewLimiter(c.limit, c.burst), lastSeen: now}
+	c.byIP[ip] = c.order.PushFront(entry)
+	return entry
+}
+
+// expire drops limiters idle for longer than idleTTL, oldest first.
+// Callers hold c.mu.
+func (c *clientLimiters) expire(now time.Time) {
+	if c.idleTTL <= 0 {
+		return
+	}
+	for el := c.order.Back(); el != nil; el = c.order.Back() {
+		if now.Sub(el.Value.(*clientLimiter).lastSeen) <= c.idleTTL {
+			return
+		}
+		c.remove(el)
+	}
+}
+
+// remove deletes el from the LRU. Callers hold c.mu.
+func (c *clientLimiters) remove(el *list.Element) {
+	entry := c.order.Remove(el).(*clientLimiter)
+	delete(c.byIP, entry.ip)
+}
+
+// len reports how many client limiters are held.
+func (c *clientLimiters) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func newRateLimited(t *testing.T, cfg RateLimitConfig) http.Handler {
+	t.Helper()
+	return RateLimit(cfg)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func rateLimitRequest(handler http.Handler, remoteAddr, forwardedFor string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/todos", http.NoBody)
+	req.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimit_AllowsWithinBurst(t *testing.T) {
+	t.Parallel()
+
+	handler := newRateLimited(t, RateLimitConfig{RequestsPerSecond: 1, Burst: 3, MaxClients: 10})
+	for i := range 3 {
+		if rec := rateLimitRequest(handler, "192.0.2.1:1234", ""); rec.Code != http.StatusOK {
+			t.Fatalf("request %d status = %d, want %d", i, rec.Code, http.StatusOK)
+		}
+	}
+}
+
+func TestRateLimit_ThrottlesOverLimit(t *testing.T) {
+	t.Parallel()
+
+	handler := newRateLimited(t, RateLimitConfig{RequestsPerSecond: 0.5, Burst: 1, MaxClients: 10})
+	if rec := rateLimitRequest(handler, "192.0.2.1:1234", ""); rec.Code != http.StatusOK {
+		t.Fatalf("first request status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	rec := rateLimitRequest(handler, "192.0.2.1:1234", "")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("Retry-After = %q, want %q", got, "2")
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("Content-Type = %q, want %q", ct, "application/problem+json")
+	}
+
+	// Other clients have their own bucket.
+	if rec := rateLimitRequest(handler, "192.0.2.2:1234", ""); rec.Code != http.StatusOK {
+		t.Errorf("other client status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestRateLimit_BypassedClient(t *testing.T) {
+	t.Parallel()
+
+	handler := newRateLimited(t, RateLimitConfig{
+		RequestsPerSecond: 0.5,
+		Burst:             1,
+		MaxClients:        10,
+		Bypass:            []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+	})
+	for i := range 5 {
+		if rec := rateLimitRequest(handler, "10.1.2.3:1234", ""); rec.Code != http.StatusOK {
+			t.Fatalf("request %d status = %d, want %d", i, rec.Code, http.StatusOK)
+		}
+	}
+}
+
+func TestRateLimit_KeysOnForwardedClientBehindTrustedProxy(t *testing.T) {
+	t.Parallel()
+
+	handler := newRateLimited(t, RateLimitConfig{
+		RequestsPerSecond: 0.5,
+		Burst:             1,
+		MaxClients:        10,
+		TrustedProxies:    []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+	})
+
+	// Two clients behind the same proxy are limited separately.
+	if rec := rateLimitRequest(handler, "10.0.0.1:1234", "203.0.113.1"); rec.Code != http.StatusOK {
+		t.Fatalf("client 1 status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec := rateLimitRequest(handler, "10.0.0.1:1234", "203.0.113.2"); rec.Code != http.StatusOK {
+		t.Fatalf("client 2 status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec := rateLimitRequest(handler, "10.0.0.1:1234", "203.0.113.1"); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("client 1 repeat status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+}
+
+func TestRateLimit_Disabled(t *testing.T) {
+	t.Parallel()
+
+	handler := newRateLimited(t, RateLimitConfig{})
+	for i := range 5 {
+		if rec := rateLimitRequest(handler, "192.0.2.1:1234", ""); rec.Code != http.StatusOK {
+			t.Fatalf("request %d status = %d, want %d", i, rec.Code, http.StatusOK)
+		}
+	}
+}
+
+func TestClientLimiters_EvictsLeastRecentlySeen(t *testing.T) {
+	t.Parallel()
+
+	limiters := newClientLimiters(RateLimitConfig{RequestsPerSecond: 0.001, Burst: 1, MaxClients: 2})
+	now := time.Now()
+
+	if wait := limiters.reserve("a", now); wait != 0 {
+		t.Fatalf("a: wait = %v, want 0", wait)
+	}
+	if wait := limiters.reserve("b", now); wait != 0 {
+		t.Fatalf("b: wait = %v, want 0", wait)
+	}
+	if wait := limiters.reserve("a", now); wait == 0 {
+		t.Fatal("a: second request allowed, want throttled")
+	}
+
+	// a was seen more recently than b, so adding c evicts b.
+	if wait := limiters.reserve("c", now); wait != 0 {
+		t.Fatalf("c: wait = %v, want 0", wait)
+	}
+	if n := limiters.len(); n != 2 {
+		t.Errorf("len = %d, want 2", n)
+	}
+	if wait := limiters.reserve("a", now); wait == 0 {
+		t.Error("a: allowed after eviction of another client, want still throttled")
+	}
+	if wait := limiters.reserve("b", now); wait != 0 {
+		t.Errorf("b: wait = %v, want 0 for a fresh limiter after eviction", wait)
+	}
+}
+
+func TestClientLimiters_ExpiresIdleClients(t *testing.T) {
+	t.Parallel()
+
+	limiters := newClientLimiters(RateLimitConfig{
+		RequestsPerSecond: 0.001,
+		Burst:             1,
+		MaxClients:        10,
+		IdleTTL:           time.Minute,
+	})
+	now := time.Now()
+
+	limiters.reserve("a", now)
+	limiters.reserve("b", now.Add(30*time.Second))
+
+	limiters.reserve("c", now.Add(90*time.Second))
+	if n := limiters.len(); n != 2 {
+		t.Errorf("len = %d, want 2 after a expired", n)
+	}
+	if wait := limiters.reserve("a", now.Add(90*time.Second)); wait != 0 {
+		t.Errorf("a: wait = %v, want 0 for a fresh limiter after expiry", wait)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/httpclient"
 )
 
@@ -18,10 +19,12 @@ type requestIDKey struct{}
 
 // WithRequestID returns a new context with the given request ID stored in it.
 // It also stores the ID via httpclient.WithRequestID so that outbound HTTP
-// calls automatically include the X-Request-ID header.
+// calls automatically include the X-Request-ID header, and via
+// dto.WithRequestID so that error responses report it.
 func WithRequestID(ctx context.Context, id string) context.Context {
 	ctx = context.WithValue(ctx, requestIDKey{}, id)
 	ctx = httpclient.WithRequestID(ctx, id)
+	ctx = dto.WithRequestID(ctx, id)
 	return ctx
 }
 
@@ -2,12 +2,15 @@ package middleware_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"regexp"
 	"testing"
 
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/dto"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 )
 
 var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
@@ -94,3 +97,24 @@ func TestWithRequestID_StoresInContext(t *testing.T) {
 		t.Errorf("RequestIDFromContext = %q, want %q", got, "test-id")
 	}
 }
+
+func TestRequestID_ReportedInErrorResponses(t *testing.T) {
+	t.Parallel()
+
+	handler := middleware.RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		dto.WriteErrorResponse(w, r, domain.ErrNotFound)
+	}))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("X-Request-ID", "incoming-123")
+	handler.ServeHTTP(rec, req)
+
+	var body dto.ErrorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode error = %v", err)
+	}
+	if body.RequestID != "incoming-123" {
+		t.Errorf("request_id = %q, want %q", body.RequestID, "incoming-123")
+	}
+}
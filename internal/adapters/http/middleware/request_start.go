@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// requestStartKey is the context key for the request start time.
+type requestStartKey struct{}
+
+// WithRequestStart returns a new context with t stored as the request start
+// time.
+func WithRequestStart(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, requestStartKey{}, t)
+}
+
+// RequestStart returns the request start time stored by StartTime. Returns
+// the zero time if none is stored.
+func RequestStart(ctx context.Context) time.Time {
+	if t, ok := ctx.Value(requestStartKey{}).(time.Time); ok {
+		return t
+	}
+	return time.Time{}
+}
+
+// requestStartOrNow returns the stored start time for r, or the current time
+// when StartTime is not in the chain.
+func requestStartOrNow(r *http.Request) time.Time {
+	if t := RequestStart(r.Context()); !t.IsZero() {
+		return t
+	}
+	return time.Now()
+}
+
+// StartTime returns middleware that records when the request arrived. It
+// should be registered near the top of the chain so that Logging,
+// OpenTelemetry, and AppContext all measure durations from the same instant,
+// read back with RequestStart.
+func StartTime() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(WithRequestStart(r.Context(), time.Now())))
+		})
+	}
+}
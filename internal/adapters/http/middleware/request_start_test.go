@@ -0,0 +1,43 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/middleware"
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
+)
+
+func TestStartTime_ReadableDownstream(t *testing.T) {
+	t.Parallel()
+
+	before := time.Now()
+	var start, rcStart time.Time
+	handler := middleware.StartTime()(middleware.AppContext()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		start = middleware.RequestStart(r.Context())
+		rcStart = appctx.FromContext(r.Context()).Started()
+	})))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+	if start.IsZero() {
+		t.Fatal("RequestStart() is zero downstream of StartTime")
+	}
+	if start.Before(before) || start.After(time.Now()) {
+		t.Errorf("RequestStart() = %v, want between %v and now", start, before)
+	}
+	if !rcStart.Equal(start) {
+		t.Errorf("RequestContext.Started() = %v, want %v", rcStart, start)
+	}
+}
+
+func TestRequestStart_ZeroWithoutMiddleware(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	if got := middleware.RequestStart(req.Context()); !got.IsZero() {
+		t.Errorf("RequestStart() = %v, want zero", got)
+	}
+}
@@ -3,17 +3,19 @@ package http
 
 import (
 	"net/http"
+	"net/http/pprof"
 
 	"github.com/go-chi/chi/v5"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/handlers"
 )
 
-// NewRouter creates an HTTP handler with all application routes registered.
-// Middleware is applied globally in the order given.
+// NewRouter creates the public API handler with all /api/v1 routes
+// registered. Middleware is applied globally in the order given. Health and
+// admin routes live on NewAdminRouter instead.
 func NewRouter(
 	projectHandler *handlers.ProjectHandler,
-	healthHandler *handlers.HealthHandler,
+	todoHandler *handlers.TodoHandler,
 	middlewares ...func(http.Handler) http.Handler,
 ) http.Handler {
 	r := chi.NewRouter()
@@ -22,25 +24,76 @@ func NewRouter(
 		r.Use(mw)
 	}
 
-	// Health endpoints (outside /api/v1 prefix).
-	r.Get("/health/live", healthHandler.Liveness)
-	r.Get("/health/ready", healthHandler.Readiness)
-
 	// API v1 routes.
-	r.Route("/api/v1", func(r chi.Router) {
+	r.Route(handlers.APIBasePath, func(r chi.Router) {
 		// Project CRUD.
 		r.Get("/projects", projectHandler.ListProjects)
 		r.Post("/projects", projectHandler.CreateProject)
 		r.Get("/projects/{id}", projectHandler.GetProject)
+		r.Head("/projects/{id}", projectHandler.HeadProject)
 		r.Patch("/projects/{id}", projectHandler.UpdateProject)
 		r.Delete("/projects/{id}", projectHandler.DeleteProject)
+		r.Post("/projects/{id}/archive", projectHandler.ArchiveProject)
+		r.Post("/projects/{id}/unarchive", projectHandler.UnarchiveProject)
+
+		// Todo CRUD.
+		r.Get("/todos", todoHandler.ListTodos)
+		r.Post("/todos", todoHandler.CreateTodo)
+		r.Get("/todos/{id}", todoHandler.GetTodo)
+		r.Head("/todos/{id}", todoHandler.HeadTodo)
+		r.Put("/todos/{id}", todoHandler.ReplaceTodo)
+		r.Patch("/todos/{id}", todoHandler.UpdateTodo)
+		r.Delete("/todos/{id}", todoHandler.DeleteTodo)
 
 		// Nested project-todo operations.
 		r.Post("/projects/{projectId}/todos", projectHandler.AddProjectTodo)
 		r.Patch("/projects/{projectId}/todos/bulk", projectHandler.BulkUpdateProjectTodos)
+		r.Post("/projects/{projectId}/todos/bulk-delete", projectHandler.BulkDeleteProjectTodos)
 		r.Patch("/projects/{projectId}/todos/{todoId}", projectHandler.UpdateProjectTodo)
 		r.Delete("/projects/{projectId}/todos/{todoId}", projectHandler.RemoveProjectTodo)
 	})
 
 	return r
 }
+
+// NewAdminRouter creates the operational handler served on the admin
+// listener (server.admin_port): health probes and admin endpoints that must
+// not be reachable on the public API port. When enablePprof is set the
+// net/http/pprof handlers are registered under /debug/pprof/; otherwise
+// those paths return 404. Middleware is applied globally in the order given.
+func NewAdminRouter(
+	healthHandler *handlers.HealthHandler,
+	adminHandler *handlers.AdminHandler,
+	enablePprof bool,
+	middlewares ...func(http.Handler) http.Handler,
+) http.Handler {
+	r := chi.NewRouter()
+
+	for _, mw := range middlewares {
+		r.Use(mw)
+	}
+
+	// Health endpoints.
+	r.Get("/health/live", healthHandler.Liveness)
+	r.Get("/health/ready", healthHandler.Readiness)
+
+	// Operational endpoints.
+	r.Post("/admin/log-level", adminHandler.SetLogLevel)
+	r.Post("/admin/breakers/{service}/{action}", adminHandler.SetBreakerState)
+
+	// Profiling endpoints (non-prod only).
+	if enablePprof {
+		r.Route("/debug/pprof", func(r chi.Router) {
+			r.Get("/cmdline", pprof.Cmdline)
+			r.Get("/profile", pprof.Profile)
+			r.Get("/symbol", pprof.Symbol)
+			r.Post("/symbol", pprof.Symbol)
+			r.Get("/trace", pprof.Trace)
+			// Index serves the listing at / and named profiles (heap,
+			// goroutine, allocs, ...) at /{name}.
+			r.Get("/*", pprof.Index)
+		})
+	}
+
+	return r
+}
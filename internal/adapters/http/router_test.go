@@ -1,6 +1,7 @@
 package http_test
 
 import (
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -10,6 +11,7 @@ import (
 
 	adapthttp "github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/adapters/http/handlers"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
 	"github.com/jsamuelsen11/go-service-template-v2/mocks"
 )
@@ -17,15 +19,44 @@ import (
 func newTestRouter(t *testing.T) (http.Handler, *mocks.MockProjectService) {
 	t.Helper()
 	svc := mocks.NewMockProjectService(t)
-	registry := mocks.NewMockHealthRegistry(t)
 
 	ph := handlers.NewProjectHandler(svc)
-	hh := handlers.NewHealthHandler(registry)
+	th := handlers.NewTodoHandler(mocks.NewMockTodoService(t))
 
-	router := adapthttp.NewRouter(ph, hh)
+	router := adapthttp.NewRouter(ph, th)
 	return router, svc
 }
 
+func newTestAdminRouter(t *testing.T) (http.Handler, *mocks.MockHealthRegistry) {
+	t.Helper()
+	registry := mocks.NewMockHealthRegistry(t)
+
+	hh := handlers.NewHealthHandler(registry)
+	ah := handlers.NewAdminHandler(new(slog.LevelVar), nil)
+
+	return adapthttp.NewAdminRouter(hh, ah, false), registry
+}
+
+// registeredRoutes returns the "METHOD /pattern" keys registered on a chi router.
+func registeredRoutes(t *testing.T, router http.Handler) map[string]bool {
+	t.Helper()
+
+	chiRouter, ok := router.(*chi.Mux)
+	if !ok {
+		t.Fatal("router is not *chi.Mux")
+	}
+
+	registered := make(map[string]bool)
+	err := chi.Walk(chiRouter, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		registered[method+" "+route] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("chi.Walk error: %v", err)
+	}
+	return registered
+}
+
 func TestRouter_AllRoutesRegistered(t *testing.T) {
 	t.Parallel()
 
@@ -35,48 +66,82 @@ func TestRouter_AllRoutesRegistered(t *testing.T) {
 		method string
 		path   string
 	}{
-		{http.MethodGet, "/health/live"},
-		{http.MethodGet, "/health/ready"},
 		{http.MethodGet, "/api/v1/projects"},
 		{http.MethodPost, "/api/v1/projects"},
 		{http.MethodGet, "/api/v1/projects/{id}"},
+		{http.MethodHead, "/api/v1/projects/{id}"},
 		{http.MethodPatch, "/api/v1/projects/{id}"},
 		{http.MethodDelete, "/api/v1/projects/{id}"},
+		{http.MethodPost, "/api/v1/projects/{id}/archive"},
+		{http.MethodPost, "/api/v1/projects/{id}/unarchive"},
+		{http.MethodGet, "/api/v1/todos"},
+		{http.MethodPost, "/api/v1/todos"},
+		{http.MethodGet, "/api/v1/todos/{id}"},
+		{http.MethodHead, "/api/v1/todos/{id}"},
+		{http.MethodPut, "/api/v1/todos/{id}"},
+		{http.MethodPatch, "/api/v1/todos/{id}"},
+		{http.MethodDelete, "/api/v1/todos/{id}"},
 		{http.MethodPost, "/api/v1/projects/{projectId}/todos"},
 		{http.MethodPatch, "/api/v1/projects/{projectId}/todos/bulk"},
+		{http.MethodPost, "/api/v1/projects/{projectId}/todos/bulk-delete"},
 		{http.MethodPatch, "/api/v1/projects/{projectId}/todos/{todoId}"},
 		{http.MethodDelete, "/api/v1/projects/{projectId}/todos/{todoId}"},
 	}
 
-	chiRouter, ok := router.(*chi.Mux)
-	if !ok {
-		t.Fatal("router is not *chi.Mux")
+	registered := registeredRoutes(t, router)
+	for _, expected := range expectedRoutes {
+		key := expected.method + " " + expected.path
+		if !registered[key] {
+			t.Errorf("route %s not registered", key)
+		}
 	}
+}
 
-	registered := make(map[string]bool)
-	err := chi.Walk(chiRouter, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
-		registered[method+" "+route] = true
-		return nil
-	})
-	if err != nil {
-		t.Fatalf("chi.Walk error: %v", err)
-	}
+func TestAdminRouter_AllRoutesRegistered(t *testing.T) {
+	t.Parallel()
 
-	for _, expected := range expectedRoutes {
-		key := expected.method + " " + expected.path
+	router, _ := newTestAdminRouter(t)
+
+	registered := registeredRoutes(t, router)
+	for _, key := range []string{
+		"GET /health/live",
+		"GET /health/ready",
+		"POST /admin/log-level",
+		"POST /admin/breakers/{service}/{action}",
+	} {
 		if !registered[key] {
 			t.Errorf("route %s not registered", key)
 		}
 	}
+	if len(registered) != 4 {
+		t.Errorf("admin router has %d routes, want 4: %v", len(registered), registered)
+	}
+}
+
+func TestRouter_OperationalRoutesNotOnAPI(t *testing.T) {
+	t.Parallel()
+
+	router, _ := newTestRouter(t)
+
+	for _, path := range []string{"/health/live", "/health/ready"} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("GET %s on API router status = %d, want %d", path, rec.Code, http.StatusNotFound)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/breakers/todo-api/open", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("POST /admin/breakers on API router status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
 }
 
 func TestRouter_MiddlewareApplied(t *testing.T) {
 	t.Parallel()
 
-	svc := mocks.NewMockProjectService(t)
 	registry := mocks.NewMockHealthRegistry(t)
-
-	ph := handlers.NewProjectHandler(svc)
 	hh := handlers.NewHealthHandler(registry)
 
 	called := false
@@ -87,9 +152,9 @@ func TestRouter_MiddlewareApplied(t *testing.T) {
 		})
 	}
 
-	router := adapthttp.NewRouter(ph, hh, testMW)
+	router := adapthttp.NewAdminRouter(hh, handlers.NewAdminHandler(new(slog.LevelVar), nil), false, testMW)
 
-	registry.EXPECT().CheckAll(mock.Anything).Return(map[string]error{})
+	registry.EXPECT().Snapshot(mock.Anything).Return(nil)
 
 	rec := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/health/ready", nil)
@@ -105,7 +170,7 @@ func TestRouter_IntegrationListProjects(t *testing.T) {
 
 	router, svc := newTestRouter(t)
 
-	svc.EXPECT().ListProjects(mock.Anything).Return([]project.Project{}, nil)
+	svc.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return([]project.Project{}, nil)
 
 	rec := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects", nil)
@@ -116,6 +181,37 @@ func TestRouter_IntegrationListProjects(t *testing.T) {
 	}
 }
 
+func TestRouter_HeadProject(t *testing.T) {
+	t.Parallel()
+
+	router, svc := newTestRouter(t)
+
+	p := project.Project{ID: 1, Name: "Sprint 1"}
+	svc.EXPECT().GetProject(mock.Anything, int64(1)).Return(&p, nil)
+	svc.EXPECT().GetProject(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodHead, "/api/v1/projects/1", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("HEAD existing status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("HEAD existing body = %q, want empty", rec.Body.String())
+	}
+	if rec.Header().Get("ETag") == "" {
+		t.Error("HEAD existing should set ETag")
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodHead, "/api/v1/projects/99", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("HEAD missing status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("HEAD missing body = %q, want empty", rec.Body.String())
+	}
+}
+
 func TestRouter_NotFoundReturns404(t *testing.T) {
 	t.Parallel()
 
@@ -143,3 +239,34 @@ func TestRouter_MethodNotAllowed(t *testing.T) {
 		t.Errorf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
 	}
 }
+
+func TestAdminRouter_Pprof(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		enabled    bool
+		wantStatus int
+	}{
+		{name: "enabled", enabled: true, wantStatus: http.StatusOK},
+		{name: "disabled", enabled: false, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hh := handlers.NewHealthHandler(mocks.NewMockHealthRegistry(t))
+			ah := handlers.NewAdminHandler(new(slog.LevelVar), nil)
+			router := adapthttp.NewAdminRouter(hh, ah, tt.enabled)
+
+			for _, path := range []string{"/debug/pprof/", "/debug/pprof/heap", "/debug/pprof/cmdline"} {
+				rec := httptest.NewRecorder()
+				router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+				if rec.Code != tt.wantStatus {
+					t.Errorf("GET %s status = %d, want %d", path, rec.Code, tt.wantStatus)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/app/identity"
+)
+
+// WithAuditLogger sets the logger that receives an audit record for every
+// successful project and todo mutation. The default nil logger records
+// nothing.
+func WithAuditLogger(logger *slog.Logger) Option {
+	return func(s *ProjectService) {
+		s.audit = logger
+	}
+}
+
+// recordAudit writes one audit record per entity ID for a successful
+// mutation, with the actor from identity.FromContext and the correlation ID
+// from appctx.CorrelationID. Safe to call with no audit logger.
+func (s *ProjectService) recordAudit(ctx context.Context, entity, operation string, ids ...int64) {
+	if s.audit == nil {
+		return
+	}
+	caller, _ := identity.FromContext(ctx)
+	for _, id := range ids {
+		s.audit.LogAttrs(ctx, slog.LevelInfo, "audit",
+			slog.String("actor", caller.UserID),
+			slog.String("entity_type", entity),
+			slog.Int64("entity_id", id),
+			slog.String("operation", operation),
+			slog.String("correlation_id", appctx.CorrelationID(ctx)),
+		)
+	}
+}
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/app/identity"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/mocks"
+)
+
+func newAuditedService(t *testing.T) (*ProjectService, *mocks.MockTodoClient, *bytes.Buffer) {
+	t.Helper()
+	var buf bytes.Buffer
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger(),
+		WithAuditLogger(slog.New(slog.NewJSONHandler(&buf, nil))))
+	return svc, mockClient, &buf
+}
+
+// auditRecords decodes the JSON lines written to buf.
+func auditRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var rec map[string]any
+		if err := dec.Decode(&rec); err != nil {
+			t.Fatalf("decoding audit record: %v", err)
+		}
+		records = append(records, rec)
+	}
+	return records
+}
+
+func TestProjectService_Audit_CreateProject(t *testing.T) {
+	t.Parallel()
+	svc, mockClient, buf := newAuditedService(t)
+
+	input := &project.Project{Name: "New Project", Description: "A new project"}
+	mockClient.EXPECT().CreateProject(mock.Anything, input).
+		Return(&project.Project{ID: 5, Name: "New Project", Description: "A new project"}, nil)
+
+	ctx := identity.WithIdentity(context.Background(), identity.Identity{UserID: "alice"})
+	ctx = appctx.WithCorrelationID(ctx, "corr-123")
+	if _, err := svc.CreateProject(ctx, input); err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+
+	records := auditRecords(t, buf)
+	if len(records) != 1 {
+		t.Fatalf("got %d audit records, want 1", len(records))
+	}
+	want := map[string]any{
+		"msg":            "audit",
+		"actor":          "alice",
+		"entity_type":    "project",
+		"entity_id":      float64(5),
+		"operation":      "create",
+		"correlation_id": "corr-123",
+	}
+	for key, v := range want {
+		if got := records[0][key]; got != v {
+			t.Errorf("record[%q] = %v, want %v", key, got, v)
+		}
+	}
+}
+
+func TestProjectService_Audit_SkipsFailedMutation(t *testing.T) {
+	t.Parallel()
+	svc, mockClient, buf := newAuditedService(t)
+
+	mockClient.EXPECT().DeleteProject(mock.Anything, int64(9)).Return(domain.ErrNotFound)
+
+	if err := svc.DeleteProject(context.Background(), 9); !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("DeleteProject() error = %v, want ErrNotFound", err)
+	}
+	if records := auditRecords(t, buf); len(records) != 0 {
+		t.Errorf("got %d audit records, want none for a failed delete", len(records))
+	}
+}
+
+func TestProjectService_Audit_BulkRemoveRecordsEachTodo(t *testing.T) {
+	t.Parallel()
+	svc, mockClient, buf := newAuditedService(t)
+
+	proj := validProject()
+	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+	existing := []todo.Todo{
+		{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
+		{ID: 11, Title: "B", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
+	}
+	mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{10, 11}).Return(existing, nil)
+	mockClient.EXPECT().DeleteTodo(mock.Anything, mock.Anything).Return(nil)
+
+	if err := svc.BulkRemoveTodos(context.Background(), 1, []int64{10, 11}); err != nil {
+		t.Fatalf("BulkRemoveTodos() error = %v", err)
+	}
+
+	records := auditRecords(t, buf)
+	if len(records) != 2 {
+		t.Fatalf("got %d audit records, want 2", len(records))
+	}
+	for i, rec := range records {
+		if rec["entity_type"] != "todo" || rec["operation"] != "delete" || rec["entity_id"] != float64(10+i) {
+			t.Errorf("record %d = %v, want todo delete of %d", i, rec, 10+i)
+		}
+	}
+}
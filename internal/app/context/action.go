@@ -2,8 +2,10 @@ package appctx
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/logging"
@@ -15,23 +17,46 @@ type actionItem interface {
 	execute(ctx context.Context) error
 	rollback(ctx context.Context) error
 	description() string
+	// priority orders the item within Commit; lower values run first.
+	priority() int
 }
 
 // singleAction wraps a domain.Action to satisfy the actionItem interface.
 type singleAction struct {
-	action domain.Action
+	action          domain.Action
+	rollbackTimeout time.Duration
+	order           int // priority set by AddActionWithPriority
 }
 
-func (s *singleAction) execute(ctx context.Context) error  { return s.action.Execute(ctx) }
-func (s *singleAction) rollback(ctx context.Context) error { return s.action.Rollback(ctx) }
-func (s *singleAction) description() string                { return s.action.Description() }
+func (s *singleAction) execute(ctx context.Context) error { return s.action.Execute(ctx) }
+func (s *singleAction) rollback(ctx context.Context) error {
+	return rollbackAction(ctx, s.rollbackTimeout, s.action)
+}
+func (s *singleAction) description() string { return s.action.Description() }
+
+func (s *singleAction) priority() int { return s.order }
+
+// rollbackAction runs a.Rollback with a fresh deadline. The rollback context
+// keeps ctx's values (logger, trace span) but not its cancellation, so
+// cleanup still runs after the request context is done.
+func rollbackAction(ctx context.Context, timeout time.Duration, a domain.Action) error {
+	if timeout <= 0 {
+		timeout = DefaultRollbackTimeout
+	}
+	rbCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+	return a.Rollback(rbCtx)
+}
 
 // actionGroup holds multiple actions that execute in parallel. If any action
 // fails, in-progress actions are canceled via context and successfully
-// completed actions are rolled back in reverse insertion order.
+// completed actions are rolled back in reverse insertion order. A positive
+// limit caps how many actions run at once.
 type actionGroup struct {
-	actions   []domain.Action
-	completed []domain.Action
+	actions         []domain.Action
+	completed       []domain.Action
+	limit           int
+	rollbackTimeout time.Duration
 }
 
 func (g *actionGroup) execute(ctx context.Context) error {
@@ -49,8 +74,24 @@ func (g *actionGroup) execute(ctx context.Context) error {
 
 	results := make(chan result, len(g.actions))
 
+	// sem bounds concurrency when a limit is set; nil means unbounded.
+	var sem chan struct{}
+	if g.limit > 0 {
+		sem = make(chan struct{}, g.limit)
+	}
+
 	for i, action := range g.actions {
 		go func(idx int, a domain.Action) {
+			if sem != nil {
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+				case <-groupCtx.Done():
+					// A sibling failed before this action started.
+					results <- result{index: idx, err: groupCtx.Err()}
+					return
+				}
+			}
 			results <- result{index: idx, err: a.Execute(groupCtx)}
 		}(i, action)
 	}
@@ -78,35 +119,39 @@ func (g *actionGroup) execute(ctx context.Context) error {
 	}
 
 	if firstErr != nil {
-		g.rollbackCompleted(ctx)
-		return firstErr
+		return errors.Join(firstErr, g.rollbackCompleted(ctx))
 	}
 
 	return nil
 }
 
 func (g *actionGroup) rollback(ctx context.Context) error {
-	g.rollbackCompleted(ctx)
-	return nil
+	return g.rollbackCompleted(ctx)
 }
 
 // rollbackCompleted rolls back successfully completed actions in reverse
 // insertion order. Rollback errors are logged but do not stop the rollback
-// of remaining actions.
-func (g *actionGroup) rollbackCompleted(ctx context.Context) {
+// of remaining actions. Returns the rollback errors joined, or nil.
+func (g *actionGroup) rollbackCompleted(ctx context.Context) error {
 	logger := logging.FromContext(ctx)
+	var errs []error
 	for i := len(g.completed) - 1; i >= 0; i-- {
 		action := g.completed[i]
-		if err := action.Rollback(ctx); err != nil {
+		if err := rollbackAction(ctx, g.rollbackTimeout, action); err != nil {
 			logger.ErrorContext(ctx, "rollback failed in action group",
 				slog.String("operation", "ActionGroup.rollback"),
 				slog.String("action", action.Description()),
 				slog.Any("error", err),
 			)
+			errs = append(errs, fmt.Errorf("rolling back %s: %w", action.Description(), err))
 		}
 	}
+	return errors.Join(errs...)
 }
 
+// priority returns DefaultPriority: groups are always staged at the default.
+func (g *actionGroup) priority() int { return DefaultPriority }
+
 func (g *actionGroup) description() string {
 	switch len(g.actions) {
 	case 0:
@@ -118,12 +163,62 @@ func (g *actionGroup) description() string {
 	}
 }
 
+// ActionFunc returns a [domain.Action] built from closures, for staging
+// inline operations without declaring a type. A nil exec or rollback is
+// treated as a no-op.
+//
+//	rc.AddAction(appctx.ActionFunc("mark todo 123 as done",
+//		func(ctx context.Context) error { return client.MarkDone(ctx, 123) },
+//		func(ctx context.Context) error { return client.MarkPending(ctx, 123) },
+//	))
+func ActionFunc(desc string, exec, rollback func(context.Context) error) domain.Action {
+	return &funcAction{desc: desc, exec: exec, rollbackFn: rollback}
+}
+
+// funcAction adapts closures to [domain.Action].
+type funcAction struct {
+	desc       string
+	exec       func(context.Context) error
+	rollbackFn func(context.Context) error
+}
+
+func (f *funcAction) Execute(ctx context.Context) error {
+	if f.exec == nil {
+		return nil
+	}
+	return f.exec(ctx)
+}
+
+func (f *funcAction) Rollback(ctx context.Context) error {
+	if f.rollbackFn == nil {
+		return nil
+	}
+	return f.rollbackFn(ctx)
+}
+
+func (f *funcAction) Description() string { return f.desc }
+
+// DefaultPriority is the priority of items staged without one: everything
+// except AddActionWithPriority.
+const DefaultPriority = 0
+
 // AddAction stages a single action for later execution by Commit.
 // Returns ErrNilAction if action is nil, or ErrAlreadyCommitted if the
 // RequestContext has already been committed.
 //
 // AddAction is safe for concurrent use.
 func (rc *RequestContext) AddAction(action domain.Action) error {
+	return rc.AddActionWithPriority(DefaultPriority, action)
+}
+
+// AddActionWithPriority is like AddAction but sets the action's priority.
+// Commit runs items in ascending priority order, keeping insertion order
+// among equal priorities, so an action that must run after everything else
+// (cache invalidation, say) is staged with a priority above
+// DefaultPriority, and one that must run first with a negative priority.
+//
+// AddActionWithPriority is safe for concurrent use.
+func (rc *RequestContext) AddActionWithPriority(priority int, action domain.Action) error {
 	if action == nil {
 		return ErrNilAction
 	}
@@ -134,7 +229,7 @@ func (rc *RequestContext) AddAction(action domain.Action) error {
 	if rc.committed {
 		return ErrAlreadyCommitted
 	}
-	rc.items = append(rc.items, &singleAction{action: action})
+	rc.items = append(rc.items, &singleAction{action: action, rollbackTimeout: rc.rollbackTimeout, order: priority})
 	return nil
 }
 
@@ -145,6 +240,16 @@ func (rc *RequestContext) AddAction(action domain.Action) error {
 //
 // AddGroup is safe for concurrent use.
 func (rc *RequestContext) AddGroup(actions ...domain.Action) error {
+	return rc.AddGroupN(0, actions...)
+}
+
+// AddGroupN is like AddGroup but runs at most limit actions of the group
+// concurrently. Rollback semantics are unchanged: if any action fails, the
+// actions not yet started are skipped and completed ones are rolled back.
+// A non-positive limit means unbounded, matching AddGroup.
+//
+// AddGroupN is safe for concurrent use.
+func (rc *RequestContext) AddGroupN(limit int, actions ...domain.Action) error {
 	for _, a := range actions {
 		if a == nil {
 			return ErrNilAction
@@ -157,6 +262,6 @@ func (rc *RequestContext) AddGroup(actions ...domain.Action) error {
 	if rc.committed {
 		return ErrAlreadyCommitted
 	}
-	rc.items = append(rc.items, &actionGroup{actions: actions})
+	rc.items = append(rc.items, &actionGroup{actions: actions, limit: limit, rollbackTimeout: rc.rollbackTimeout})
 	return nil
 }
@@ -1,16 +1,22 @@
 package appctx
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/logging"
 )
 
-// Commit executes all staged actions and action groups in insertion order.
+// Commit executes all staged actions and action groups in ascending priority
+// order, and in insertion order within a priority (see AddActionWithPriority).
 // If any item fails, previously completed items are rolled back in reverse
-// order. Rollback errors are logged but do not affect the returned error.
+// execution order. Rollback errors are logged and joined with the execute failure in
+// the returned error, each prefixed with its action description;
+// errors.Is and errors.As still match the primary cause.
 //
 // After Commit returns (whether success or failure), the RequestContext is
 // marked as committed and no further actions can be staged.
@@ -30,9 +36,13 @@ func (rc *RequestContext) Commit(ctx context.Context) error {
 	// Snapshot items under lock. Once committed=true, no goroutine can
 	// append to rc.items via AddAction/AddGroup/Stage, so iterating the
 	// snapshot without holding the lock is safe.
-	items := rc.items
+	items := slices.Clone(rc.items)
 	rc.queueMu.Unlock()
 
+	slices.SortStableFunc(items, func(a, b actionItem) int {
+		return cmp.Compare(a.priority(), b.priority())
+	})
+
 	logger := logging.FromContext(ctx)
 
 	for i, item := range items {
@@ -50,8 +60,11 @@ func (rc *RequestContext) Commit(ctx context.Context) error {
 				slog.String("action", item.description()),
 				slog.Any("error", err),
 			)
-			rollbackItems(ctx, items, i-1, logger)
-			return fmt.Errorf("executing %s: %w", item.description(), err)
+			execErr := fmt.Errorf("executing %s: %w", item.description(), err)
+			if rbErr := rollbackItems(ctx, items, i-1, logger); rbErr != nil {
+				return errors.Join(execErr, rbErr)
+			}
+			return execErr
 		}
 	}
 
@@ -60,8 +73,9 @@ func (rc *RequestContext) Commit(ctx context.Context) error {
 
 // rollbackItems rolls back items 0..upTo (inclusive) in reverse order.
 // Rollback errors are logged at ERROR level and do not stop the rollback
-// of remaining items.
-func rollbackItems(ctx context.Context, items []actionItem, upTo int, logger *slog.Logger) {
+// of remaining items. Returns the rollback errors joined, or nil.
+func rollbackItems(ctx context.Context, items []actionItem, upTo int, logger *slog.Logger) error {
+	var errs []error
 	for i := upTo; i >= 0; i-- {
 		item := items[i]
 
@@ -78,6 +92,8 @@ func rollbackItems(ctx context.Context, items []actionItem, upTo int, logger *sl
 				slog.String("action", item.description()),
 				slog.Any("error", err),
 			)
+			errs = append(errs, fmt.Errorf("rolling back %s: %w", item.description(), err))
 		}
 	}
+	return errors.Join(errs...)
 }
@@ -28,7 +28,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 )
@@ -49,6 +52,10 @@ var ErrNilAction = errors.New("appctx: nil action")
 // error where the same cache key is used with different types.
 var ErrTypeMismatch = errors.New("appctx: cached value type mismatch")
 
+// DefaultRollbackTimeout bounds each Rollback call when New is not given
+// [WithRollbackTimeout].
+const DefaultRollbackTimeout = 5 * time.Second
+
 // RequestContext is a request-scoped context wrapper providing a thread-safe
 // in-memory cache and staged action execution. It embeds context.Context and
 // adds memoization via GetOrFetch, shared mutable access via GetRef, and
@@ -71,6 +78,43 @@ type RequestContext struct {
 	queueMu   sync.Mutex
 	items     []actionItem
 	committed bool
+
+	// rollbackTimeout bounds each Rollback call made during Commit.
+	rollbackTimeout time.Duration
+
+	// degraded is set by MarkDegraded when a read was served from a
+	// fallback instead of the downstream.
+	degraded atomic.Bool
+
+	// started is when the RequestContext was created, i.e. when the
+	// request entered the appctx middleware.
+	started time.Time
+}
+
+// Option configures a RequestContext created by New.
+type Option func(*RequestContext)
+
+// WithRollbackTimeout sets how long each Rollback may run. Rollbacks run
+// with a fresh deadline detached from the commit context's cancellation, so
+// cleanup proceeds even after the request context is done. Non-positive
+// values keep [DefaultRollbackTimeout].
+func WithRollbackTimeout(d time.Duration) Option {
+	return func(rc *RequestContext) {
+		if d > 0 {
+			rc.rollbackTimeout = d
+		}
+	}
+}
+
+// WithStarted sets the time reported by Started, for callers that already
+// recorded when the request began. The zero time keeps the default of
+// time.Now at construction.
+func WithStarted(t time.Time) Option {
+	return func(rc *RequestContext) {
+		if !t.IsZero() {
+			rc.started = t
+		}
+	}
 }
 
 // cacheEntry stores the result of a GetOrFetch call, including any error.
@@ -83,12 +127,23 @@ type cacheEntry struct {
 
 // New creates a RequestContext wrapping the given context.Context.
 // The returned RequestContext has an empty cache and no staged actions.
-func New(ctx context.Context) *RequestContext {
-	return &RequestContext{
-		Context: ctx,
-		cache:   make(map[string]cacheEntry),
-		refs:    make(map[string]any),
+func New(ctx context.Context, opts ...Option) *RequestContext {
+	rc := &RequestContext{
+		Context:         ctx,
+		cache:           make(map[string]cacheEntry),
+		refs:            make(map[string]any),
+		rollbackTimeout: DefaultRollbackTimeout,
+		started:         time.Now(),
+	}
+	for _, opt := range opts {
+		opt(rc)
 	}
+	return rc
+}
+
+// Started returns the time the RequestContext was created.
+func (rc *RequestContext) Started() time.Time {
+	return rc.started
 }
 
 // GetOrFetch returns a cached value for the given key, or calls fetchFn to
@@ -210,10 +265,11 @@ func Put[T any](rc *RequestContext, key string, val T) {
 	rc.cacheMu.Lock()
 	defer rc.cacheMu.Unlock()
 
-	// Update existing SafeRef if one exists.
+	// Update existing SafeRef if one exists. The value came from the
+	// source, so the ref is left clean.
 	if r, ok := rc.refs[key]; ok {
 		if ref, ok := r.(*SafeRef[T]); ok {
-			ref.Set(val)
+			ref.store(val)
 			return
 		}
 	}
@@ -235,6 +291,57 @@ func (rc *RequestContext) Invalidate(key string) {
 	rc.cacheMu.Unlock()
 }
 
+// DirtyRefs returns the keys of refs mutated via SafeRef.Set or
+// SafeRef.Update since they were fetched or last staged by StageDirtyRefs,
+// in sorted order.
+//
+// DirtyRefs is safe for concurrent use.
+func (rc *RequestContext) DirtyRefs() []string {
+	rc.cacheMu.RLock()
+	defer rc.cacheMu.RUnlock()
+
+	var keys []string
+	for key, r := range rc.refs {
+		if d, ok := r.(interface{ isDirty() bool }); ok && d.isDirty() {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// StageDirtyRefs queues a write-back action for every dirty ref holding a
+// T, in key order. writeFn builds the action from the ref's key and
+// current value; refs of other types are left untouched. Each staged ref is
+// marked clean, so calling StageDirtyRefs again only picks up later
+// mutations.
+//
+// Returns the number of actions staged. Returns ErrNilAction if writeFn
+// returns nil, or ErrAlreadyCommitted if the RequestContext has already
+// been committed; the ref that failed stays dirty.
+func StageDirtyRefs[T any](rc *RequestContext, writeFn func(key string, val T) domain.Action) (int, error) {
+	staged := 0
+	for _, key := range rc.DirtyRefs() {
+		rc.cacheMu.RLock()
+		ref, ok := rc.refs[key].(*SafeRef[T])
+		rc.cacheMu.RUnlock()
+		if !ok {
+			continue
+		}
+
+		val, dirty := ref.takeDirty()
+		if !dirty {
+			continue
+		}
+		if err := rc.AddAction(writeFn(key, val)); err != nil {
+			ref.markDirty()
+			return staged, fmt.Errorf("staging write-back for %q: %w", key, err)
+		}
+		staged++
+	}
+	return staged, nil
+}
+
 // DataProvider is a type-safe wrapper around GetOrFetch for a specific data
 // type. It binds a cache key and fetch function together, allowing callers
 // to retrieve data without specifying the key and function each time.
@@ -281,7 +388,7 @@ func (rc *RequestContext) Stage(key string, entity any, action domain.Action) er
 	rc.cache[key] = cacheEntry{value: entity, err: nil}
 	rc.cacheMu.Unlock()
 
-	rc.items = append(rc.items, &singleAction{action: action})
+	rc.items = append(rc.items, &singleAction{action: action, rollbackTimeout: rc.rollbackTimeout})
 	return nil
 }
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -411,6 +413,159 @@ func TestCommit_ErrorWrapsDescription(t *testing.T) {
 	}
 }
 
+func TestCommit_JoinsRollbackErrors(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background())
+
+	errBoom := errors.New("smtp timeout")
+	_ = rc.AddAction(&testAction{desc: "reserve slot", rollbackErr: errors.New("slot already released")})
+	_ = rc.AddAction(&testAction{desc: "send email", executeErr: errBoom})
+
+	err := rc.Commit(context.Background())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !errors.Is(err, errBoom) {
+		t.Errorf("errors.Is(err, primary) = false, want true; err = %v", err)
+	}
+
+	msg := err.Error()
+	for _, want := range []string{
+		"executing send email: smtp timeout",
+		"rolling back reserve slot: slot already released",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q does not contain %q", msg, want)
+		}
+	}
+}
+
+func TestCommit_RollbackRunsAfterContextCanceled(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background(), WithRollbackTimeout(time.Second))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var rollbackErr error
+	var hasDeadline bool
+	done := &testAction{desc: "create todo"}
+	rollbackFn := &rollbackFuncAction{
+		testAction: done,
+		fn: func(ctx context.Context) error {
+			_, hasDeadline = ctx.Deadline()
+			rollbackErr = ctx.Err()
+			return nil
+		},
+	}
+	failing := &testAction{desc: "notify", executeFn: func(context.Context) error {
+		cancel()
+		return errors.New("notify failed")
+	}}
+
+	_ = rc.AddAction(rollbackFn)
+	_ = rc.AddAction(failing)
+
+	if err := rc.Commit(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+	if !done.rolledBack {
+		t.Fatal("rollback did not run after context cancellation")
+	}
+	if rollbackErr != nil {
+		t.Errorf("rollback ctx.Err() = %v, want nil", rollbackErr)
+	}
+	if !hasDeadline {
+		t.Error("rollback context has no deadline, want rollback timeout")
+	}
+}
+
+func TestCommit_RollbackTimeoutBoundsSlowRollback(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background(), WithRollbackTimeout(20*time.Millisecond))
+
+	slow := &rollbackFuncAction{
+		testAction: &testAction{desc: "slow"},
+		fn: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	_ = rc.AddAction(slow)
+	_ = rc.AddAction(&testAction{desc: "fail", executeErr: errors.New("boom")})
+
+	start := time.Now()
+	err := rc.Commit(context.Background())
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Commit took %v, want rollback bounded by timeout", elapsed)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Commit() error = %v, want it to include context.DeadlineExceeded", err)
+	}
+}
+
+func TestActionFunc(t *testing.T) {
+	t.Parallel()
+
+	var executed, rolledBack bool
+	a := ActionFunc("send email",
+		func(context.Context) error { executed = true; return nil },
+		func(context.Context) error { rolledBack = true; return nil },
+	)
+
+	if got := a.Description(); got != "send email" {
+		t.Errorf("Description() = %q, want %q", got, "send email")
+	}
+	if err := a.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !executed {
+		t.Error("Execute() did not call exec")
+	}
+	if err := a.Rollback(context.Background()); err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+	if !rolledBack {
+		t.Error("Rollback() did not call rollback")
+	}
+}
+
+func TestActionFunc_NilRollbackDuringCommit(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background())
+
+	var executed bool
+	_ = rc.AddAction(ActionFunc("no undo", func(context.Context) error {
+		executed = true
+		return nil
+	}, nil))
+	_ = rc.AddAction(ActionFunc("fail", func(context.Context) error {
+		return errors.New("boom")
+	}, nil))
+
+	err := rc.Commit(context.Background())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !executed {
+		t.Error("first action was not executed")
+	}
+	if got := err.Error(); got != "executing fail: boom" {
+		t.Errorf("Commit() error = %q, want %q", got, "executing fail: boom")
+	}
+}
+
+// rollbackFuncAction overrides testAction's Rollback with fn.
+type rollbackFuncAction struct {
+	*testAction
+	fn func(ctx context.Context) error
+}
+
+func (a *rollbackFuncAction) Rollback(ctx context.Context) error {
+	a.rolledBack = true
+	return a.fn(ctx)
+}
+
 func TestCommit_ExecutionOrder(t *testing.T) {
 	t.Parallel()
 	rc := New(context.Background())
@@ -464,6 +619,73 @@ func TestCommit_RollbackOrder(t *testing.T) {
 	}
 }
 
+func TestCommit_PriorityOrder(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background())
+	var order []string
+
+	stage := func(priority int, desc string) {
+		t.Helper()
+		if err := rc.AddActionWithPriority(priority, &testAction{desc: desc, order: &order}); err != nil {
+			t.Fatalf("AddActionWithPriority(%q) error = %v", desc, err)
+		}
+	}
+	stage(10, "invalidate")
+	_ = rc.AddAction(&testAction{desc: "a", order: &order})
+	stage(-1, "first")
+	_ = rc.AddGroup(&testAction{desc: "group", order: &order})
+	stage(10, "notify")
+	_ = rc.AddAction(&testAction{desc: "b", order: &order})
+
+	if err := rc.Commit(context.Background()); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+
+	want := []string{
+		"execute:first", "execute:a", "execute:group", "execute:b",
+		"execute:invalidate", "execute:notify",
+	}
+	if !slices.Equal(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+}
+
+func TestCommit_PriorityRollbackOrder(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background())
+	var order []string
+
+	_ = rc.AddActionWithPriority(5, &testAction{desc: "late", order: &order, executeErr: errors.New("fail")})
+	_ = rc.AddActionWithPriority(1, &testAction{desc: "middle", order: &order})
+	_ = rc.AddAction(&testAction{desc: "early", order: &order})
+
+	if err := rc.Commit(context.Background()); err == nil {
+		t.Fatal("Commit() error = nil, want failure")
+	}
+
+	// Execution is early, middle, then late fails; rollback reverses it.
+	want := []string{
+		"execute:early", "execute:middle",
+		"rollback:middle", "rollback:early",
+	}
+	if !slices.Equal(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+}
+
+func TestAddActionWithPriority_Rejects(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background())
+
+	if err := rc.AddActionWithPriority(1, nil); !errors.Is(err, ErrNilAction) {
+		t.Errorf("AddActionWithPriority(nil) error = %v, want ErrNilAction", err)
+	}
+	_ = rc.Commit(context.Background())
+	if err := rc.AddActionWithPriority(1, &testAction{desc: "x"}); !errors.Is(err, ErrAlreadyCommitted) {
+		t.Errorf("AddActionWithPriority() after Commit error = %v, want ErrAlreadyCommitted", err)
+	}
+}
+
 // --- ActionGroup tests ---
 
 func TestActionGroup_ParallelExecution(t *testing.T) {
@@ -546,6 +768,66 @@ func TestActionGroup_CancelsInProgress(t *testing.T) {
 	}
 }
 
+func TestAddGroupN_LimitsConcurrency(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background())
+
+	const limit = 2
+	var running, maxRunning, total atomic.Int32
+	actions := make([]domain.Action, 10)
+	for i := range actions {
+		actions[i] = &testAction{
+			desc: fmt.Sprintf("a%d", i),
+			executeFn: func(_ context.Context) error {
+				n := running.Add(1)
+				for {
+					m := maxRunning.Load()
+					if n <= m || maxRunning.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				running.Add(-1)
+				total.Add(1)
+				return nil
+			},
+		}
+	}
+
+	if err := rc.AddGroupN(limit, actions...); err != nil {
+		t.Fatalf("AddGroupN() error = %v", err)
+	}
+	if err := rc.Commit(context.Background()); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if got := total.Load(); got != 10 {
+		t.Errorf("executed = %d, want 10", got)
+	}
+	if got := maxRunning.Load(); got > limit {
+		t.Errorf("max concurrency = %d, want <= %d", got, limit)
+	}
+}
+
+func TestAddGroupN_FailureSkipsPendingAndRollsBack(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background())
+
+	first := &testAction{desc: "first"}
+	failing := &testAction{desc: "fail", executeErr: errors.New("boom")}
+	pending := &testAction{desc: "pending"}
+
+	_ = rc.AddGroupN(1, first, failing, pending)
+	if err := rc.Commit(context.Background()); err == nil {
+		t.Fatal("expected error from group")
+	}
+	if first.executed && !first.rolledBack {
+		t.Error("completed action should be rolled back")
+	}
+	if pending.executed && !pending.rolledBack {
+		t.Error("action started after failure should be rolled back")
+	}
+}
+
 func TestActionGroup_EmptyGroup(t *testing.T) {
 	t.Parallel()
 	rc := New(context.Background())
@@ -1109,6 +1391,94 @@ func TestPut_UpdatesSafeRef(t *testing.T) {
 	}
 }
 
+func TestStageDirtyRefs_StagesWriteForEachMutatedRef(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background())
+
+	fetch := func(v string) func(context.Context) (string, error) {
+		return func(context.Context) (string, error) { return v, nil }
+	}
+	a, _ := GetRef(rc, "todo:1", fetch("a"))
+	b, _ := GetRef(rc, "todo:2", fetch("b"))
+	_, _ = GetRef(rc, "todo:3", fetch("c")) // fetched but never mutated
+
+	a.Set("a2")
+	b.Update(func(v *string) { *v += "2" })
+
+	if got, want := rc.DirtyRefs(), []string{"todo:1", "todo:2"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("DirtyRefs() = %v, want %v", got, want)
+	}
+
+	var written []string
+	n, err := StageDirtyRefs(rc, func(key, val string) domain.Action {
+		return ActionFunc("write "+key, func(context.Context) error {
+			written = append(written, key+"="+val)
+			return nil
+		}, nil)
+	})
+	if err != nil {
+		t.Fatalf("StageDirtyRefs() error = %v", err)
+	}
+	if n != 2 {
+		t.Fatalf("StageDirtyRefs() staged %d, want 2", n)
+	}
+	if got := rc.DirtyRefs(); len(got) != 0 {
+		t.Errorf("DirtyRefs() after staging = %v, want empty", got)
+	}
+
+	if err := rc.Commit(context.Background()); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if got, want := strings.Join(written, ","), "todo:1=a2,todo:2=b2"; got != want {
+		t.Errorf("written = %q, want %q", got, want)
+	}
+}
+
+func TestStageDirtyRefs_SkipsOtherTypesAndPutValues(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background())
+
+	s, _ := GetRef(rc, "str", func(context.Context) (string, error) { return "x", nil })
+	i, _ := GetRef(rc, "int", func(context.Context) (int, error) { return 1, nil })
+	_, _ = GetRef(rc, "put", func(context.Context) (string, error) { return "p", nil })
+
+	s.Set("y")
+	i.Set(2)
+	Put(rc, "put", "persisted")
+
+	n, err := StageDirtyRefs(rc, func(key, _ string) domain.Action {
+		return ActionFunc("write "+key, nil, nil)
+	})
+	if err != nil {
+		t.Fatalf("StageDirtyRefs() error = %v", err)
+	}
+	if n != 1 {
+		t.Errorf("StageDirtyRefs() staged %d, want 1", n)
+	}
+	if got, want := rc.DirtyRefs(), []string{"int"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("DirtyRefs() = %v, want %v", got, want)
+	}
+}
+
+func TestStageDirtyRefs_AfterCommitKeepsRefDirty(t *testing.T) {
+	t.Parallel()
+	rc := New(context.Background())
+
+	ref, _ := GetRef(rc, "k", func(context.Context) (string, error) { return "v", nil })
+	ref.Set("v2")
+	_ = rc.Commit(context.Background())
+
+	_, err := StageDirtyRefs(rc, func(key, _ string) domain.Action {
+		return ActionFunc("write "+key, nil, nil)
+	})
+	if !errors.Is(err, ErrAlreadyCommitted) {
+		t.Fatalf("StageDirtyRefs() error = %v, want ErrAlreadyCommitted", err)
+	}
+	if _, dirty := ref.Snapshot(); !dirty {
+		t.Error("ref should stay dirty when staging fails")
+	}
+}
+
 func TestInvalidate_ConcurrentSafe(t *testing.T) {
 	t.Parallel()
 	rc := New(context.Background())
@@ -1198,3 +1568,15 @@ func TestFromContext_NoRC(t *testing.T) {
 		t.Fatalf("expected nil, got %v", got)
 	}
 }
+
+func TestWithStarted(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 2, 12, 15, 4, 5, 0, time.UTC)
+	if got := New(context.Background(), WithStarted(start)).Started(); !got.Equal(start) {
+		t.Errorf("Started() = %v, want %v", got, start)
+	}
+	if got := New(context.Background(), WithStarted(time.Time{})).Started(); got.IsZero() {
+		t.Error("Started() is zero, want the construction time")
+	}
+}
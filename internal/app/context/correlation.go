@@ -0,0 +1,20 @@
+package appctx
+
+import "context"
+
+// correlationIDKey is the unexported key type for the correlation ID in
+// context. appctx keeps its own key, like dto and httpclient, so the
+// application layer does not depend on the middleware package.
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a new context carrying the request's
+// correlation ID for the application layer.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationID returns the ID stored by WithCorrelationID, or "" if none.
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
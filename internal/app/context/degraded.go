@@ -0,0 +1,21 @@
+package appctx
+
+import "context"
+
+// MarkDegraded records that the current request was served in degraded mode,
+// i.e. a read fell back to an empty or partial result because the downstream
+// was unavailable. It is a no-op if ctx carries no RequestContext.
+//
+// MarkDegraded is safe for concurrent use.
+func MarkDegraded(ctx context.Context) {
+	if rc := FromContext(ctx); rc != nil {
+		rc.degraded.Store(true)
+	}
+}
+
+// IsDegraded reports whether MarkDegraded was called for the RequestContext
+// carried by ctx. Handlers use it to flag degraded responses to clients.
+func IsDegraded(ctx context.Context) bool {
+	rc := FromContext(ctx)
+	return rc != nil && rc.degraded.Load()
+}
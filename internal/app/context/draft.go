@@ -0,0 +1,19 @@
+package appctx
+
+import "context"
+
+// draftKey is the unexported key type for the draft flag in context.
+type draftKey struct{}
+
+// WithDraft returns a new context marking the todo being created as a
+// draft. Application services that honor the flag validate it with
+// todo.Todo.ValidateDraft, which does not require a description.
+func WithDraft(ctx context.Context) context.Context {
+	return context.WithValue(ctx, draftKey{}, true)
+}
+
+// IsDraft reports whether the context was marked by WithDraft.
+func IsDraft(ctx context.Context) bool {
+	draft, _ := ctx.Value(draftKey{}).(bool)
+	return draft
+}
@@ -0,0 +1,19 @@
+package appctx
+
+import "context"
+
+// dryRunKey is the unexported key type for the dry-run flag in context.
+type dryRunKey struct{}
+
+// WithDryRun returns a new context marked as a dry run. Application services
+// that honor the flag validate and log the intended change but make no
+// downstream calls and stage no actions, returning the would-be result.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether the context was marked by WithDryRun.
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
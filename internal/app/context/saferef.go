@@ -11,9 +11,13 @@ import "sync"
 //
 // Use Get for simple reads (returns a value copy), Set to replace the value,
 // and Update for atomic in-place mutations.
+//
+// Set and Update mark the ref dirty so that mutations made since the value
+// was fetched can be written back with StageDirtyRefs.
 type SafeRef[T any] struct {
-	mu  sync.RWMutex
-	val T
+	mu    sync.RWMutex
+	val   T
+	dirty bool
 }
 
 // NewRef creates a SafeRef initialized with the given value.
@@ -29,18 +33,67 @@ func (r *SafeRef[T]) Get() T {
 	return r.val
 }
 
-// Set replaces the current value under a write lock.
+// Snapshot returns a copy of the current value together with whether it
+// has been mutated via Set or Update since it was fetched or last written
+// back. Both are read under the same lock, so they are consistent.
+func (r *SafeRef[T]) Snapshot() (val T, dirty bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.val, r.dirty
+}
+
+// Set replaces the current value under a write lock and marks the ref dirty.
 func (r *SafeRef[T]) Set(val T) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.val = val
+	r.dirty = true
 }
 
 // Update applies fn to the value under a write lock, allowing atomic
 // in-place mutations. The function receives a pointer to the value;
-// modifications are visible to subsequent Get and Update calls.
+// modifications are visible to subsequent Get and Update calls. The ref is
+// marked dirty.
 func (r *SafeRef[T]) Update(fn func(*T)) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	fn(&r.val)
+	r.dirty = true
+}
+
+// store replaces the value without marking the ref dirty. Put uses it for
+// write-through caching, where the new value already reflects the source.
+func (r *SafeRef[T]) store(val T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.val = val
+	r.dirty = false
+}
+
+// takeDirty returns the current value and clears the dirty flag in one
+// step. ok is false if the ref was clean.
+func (r *SafeRef[T]) takeDirty() (val T, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.dirty {
+		var zero T
+		return zero, false
+	}
+	r.dirty = false
+	return r.val, true
+}
+
+// markDirty sets the dirty flag without changing the value.
+func (r *SafeRef[T]) markDirty() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dirty = true
+}
+
+// isDirty reports whether the ref has unsaved mutations. It lets
+// RequestContext inspect refs without knowing their type parameter.
+func (r *SafeRef[T]) isDirty() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.dirty
 }
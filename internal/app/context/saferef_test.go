@@ -115,3 +115,24 @@ func TestSafeRef_ConcurrentUpdates(t *testing.T) {
 		t.Errorf("final value = %d, want %d", got, expected.Load())
 	}
 }
+
+func TestSafeRef_SnapshotTracksDirty(t *testing.T) {
+	t.Parallel()
+
+	ref := appctx.NewRef("initial")
+
+	if val, dirty := ref.Snapshot(); val != "initial" || dirty {
+		t.Fatalf("Snapshot() = (%q, %v), want (%q, false)", val, dirty, "initial")
+	}
+
+	ref.Set("set")
+	if val, dirty := ref.Snapshot(); val != "set" || !dirty {
+		t.Fatalf("Snapshot() after Set = (%q, %v), want (%q, true)", val, dirty, "set")
+	}
+
+	other := appctx.NewRef(1)
+	other.Update(func(v *int) { *v++ })
+	if val, dirty := other.Snapshot(); val != 2 || !dirty {
+		t.Fatalf("Snapshot() after Update = (%d, %v), want (2, true)", val, dirty)
+	}
+}
@@ -0,0 +1,31 @@
+// Package identity carries the authenticated caller of a request through its
+// context. Inbound middleware stores the identity; application services read
+// it for audit records and ownership checks.
+package identity
+
+import "context"
+
+// Identity is the authenticated caller of a request.
+type Identity struct {
+	// UserID identifies the caller, as asserted by a trusted gateway header
+	// or the subject claim of a verified token.
+	UserID string
+	// Claims holds every claim of the verified token when the identity came
+	// from a JWT; it is nil otherwise.
+	Claims map[string]any
+}
+
+// contextKey is the unexported key type for storing an Identity in context.
+type contextKey struct{}
+
+// WithIdentity returns a new context carrying id.
+func WithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the identity stored by WithIdentity and whether there
+// was one.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(contextKey{}).(Identity)
+	return id, ok
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
+)
+
+// Entity and operation values passed to ports.EntityMetrics.
+const (
+	entityTodo    = "todo"
+	entityProject = "project"
+
+	opCreate = "create"
+	opUpdate = "update"
+	opDelete = "delete"
+)
+
+// recordEntityOp reports count successful mutations to metrics. Safe to call
+// with nil metrics or a zero count.
+func recordEntityOp(ctx context.Context, metrics ports.EntityMetrics, entity, operation string, count int) {
+	if metrics == nil || count == 0 {
+		return
+	}
+	metrics.RecordEntityOperation(ctx, entity, operation, count)
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/app/identity"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+)
+
+// WithOwnershipEnforcement restricts projects to their owners. Operations
+// on a project owned by someone else fail with domain.ErrForbidden,
+// ListProjects returns only the caller's projects, and a caller without an
+// identity gets domain.ErrUnauthenticated. Projects without an owner are
+// accessible to no one while enforcement is on. Ownership is recorded on
+// create whether or not it is enforced.
+func WithOwnershipEnforcement(enabled bool) Option {
+	return func(s *ProjectService) {
+		s.enforceOwnership = enabled
+	}
+}
+
+// WithTodoOwnershipEnforcement applies the WithOwnershipEnforcement rules
+// to todos through the project each belongs to. Every operation requires an
+// identified caller; reading, changing, or deleting a todo in a project the
+// caller does not own, or creating or moving one into such a project, fails
+// with domain.ErrForbidden. ListTodos checks the project_id filter when set
+// and otherwise drops todos from projects the caller cannot access. Todos
+// outside any project are not owned and stay accessible to every caller.
+func WithTodoOwnershipEnforcement(enabled bool) TodoOption {
+	return func(s *TodoService) {
+		s.owners = nil
+		if enabled {
+			s.owners = NewProjectService(s.todoClient, s.logger, WithOwnershipEnforcement(true))
+		}
+	}
+}
+
+// caller returns the identified caller when ownership is enforced. ok is
+// false when enforcement is off, in which case every project is accessible.
+func (s *ProjectService) caller(ctx context.Context) (_ identity.Identity, ok bool, err error) {
+	if !s.enforceOwnership {
+		return identity.Identity{}, false, nil
+	}
+	caller, found := identity.FromContext(ctx)
+	if !found || caller.UserID == "" {
+		return identity.Identity{}, false, fmt.Errorf("project access requires an identified caller: %w",
+			domain.ErrUnauthenticated)
+	}
+	return caller, true, nil
+}
+
+// authorizeProject returns domain.ErrForbidden if ownership is enforced and
+// the caller does not own p.
+func (s *ProjectService) authorizeProject(ctx context.Context, p *project.Project) error {
+	caller, ok, err := s.caller(ctx)
+	if err != nil || !ok {
+		return err
+	}
+	if !p.OwnedBy(caller.UserID) {
+		return fmt.Errorf("project %d is not owned by the caller: %w", p.ID, domain.ErrForbidden)
+	}
+	return nil
+}
+
+// verifyProjectOwner fetches project id for its ownership check before an
+// operation that would not otherwise read it. It is a no-op when ownership
+// is not enforced.
+func (s *ProjectService) verifyProjectOwner(ctx context.Context, operation string, id int64) error {
+	if !s.enforceOwnership {
+		return nil
+	}
+	if _, err := s.fetchProject(ctx, id); err != nil {
+		s.logger.WarnContext(ctx, "project ownership check failed",
+			slog.String("operation", operation),
+			slog.Int64("id", id),
+			slog.Any("error", err),
+		)
+		return fmt.Errorf("verifying project: %w", err)
+	}
+	return nil
+}
+
+// verifyTodoProject checks that the caller may access projectID before a
+// todo operation. A nil projectID is a todo outside any project, which only
+// needs an identified caller. It is a no-op when ownership is not enforced.
+func (s *TodoService) verifyTodoProject(ctx context.Context, operation string, projectID *int64) error {
+	if s.owners == nil {
+		return nil
+	}
+	if _, _, err := s.owners.caller(ctx); err != nil {
+		return err
+	}
+	if projectID == nil {
+		return nil
+	}
+	return s.owners.verifyProjectOwner(ctx, operation, *projectID)
+}
+
+// verifyTodoAccess fetches todo id and checks that the caller may access its
+// project, before an operation that would not otherwise read it. It is a
+// no-op when ownership is not enforced.
+func (s *TodoService) verifyTodoAccess(ctx context.Context, operation string, id int64) error {
+	if s.owners == nil {
+		return nil
+	}
+	existing, err := s.fetchTodo(ctx, id)
+	if err != nil {
+		s.logger.WarnContext(ctx, "todo ownership check failed",
+			slog.String("operation", operation),
+			slog.Int64("id", id),
+			slog.Any("error", err),
+		)
+		return fmt.Errorf("verifying todo: %w", err)
+	}
+	return s.verifyTodoProject(ctx, operation, existing.ProjectID)
+}
+
+// accessibleTodos drops the todos in projects the caller may not access,
+// fetching each distinct project once. A project that no longer exists is
+// treated as inaccessible.
+func (s *TodoService) accessibleTodos(ctx context.Context, todos []todo.Todo) ([]todo.Todo, error) {
+	allowed := make(map[int64]bool)
+	kept := todos[:0]
+	for _, td := range todos {
+		if td.ProjectID != nil {
+			ok, seen := allowed[*td.ProjectID]
+			if !seen {
+				_, err := s.owners.fetchProject(ctx, *td.ProjectID)
+				switch {
+				case err == nil:
+					ok = true
+				case errors.Is(err, domain.ErrForbidden), errors.Is(err, domain.ErrNotFound):
+				default:
+					return nil, fmt.Errorf("verifying project: %w", err)
+				}
+				allowed[*td.ProjectID] = ok
+			}
+			if !ok {
+				continue
+			}
+		}
+		kept = append(kept, td)
+	}
+	return kept, nil
+}
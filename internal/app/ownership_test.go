@@ -0,0 +1,358 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/app/identity"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/mocks"
+)
+
+func newOwnedService(t *testing.T) (*ProjectService, *mocks.MockTodoClient) {
+	t.Helper()
+	mockClient := mocks.NewMockTodoClient(t)
+	return NewProjectService(mockClient, discardLogger(), WithOwnershipEnforcement(true)), mockClient
+}
+
+func asUser(userID string) context.Context {
+	return identity.WithIdentity(context.Background(), identity.Identity{UserID: userID})
+}
+
+func ownedProject(id int64, owner string) project.Project {
+	p := validProject()
+	p.ID = id
+	p.OwnerID = owner
+	return p
+}
+
+func TestProjectService_Ownership_GetProject(t *testing.T) {
+	t.Parallel()
+
+	t.Run("owner allowed", func(t *testing.T) {
+		t.Parallel()
+		svc, mockClient := newOwnedService(t)
+
+		proj := ownedProject(1, "alice")
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(nil, nil)
+
+		got, err := svc.GetProject(asUser("alice"), 1)
+		if err != nil {
+			t.Fatalf("GetProject() error = %v", err)
+		}
+		if got.OwnerID != "alice" {
+			t.Errorf("OwnerID = %q, want %q", got.OwnerID, "alice")
+		}
+	})
+
+	t.Run("non-owner forbidden", func(t *testing.T) {
+		t.Parallel()
+		svc, mockClient := newOwnedService(t)
+
+		proj := ownedProject(1, "alice")
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+		_, err := svc.GetProject(asUser("bob"), 1)
+		if !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("GetProject() error = %v, want ErrForbidden", err)
+		}
+	})
+
+	t.Run("unowned project forbidden", func(t *testing.T) {
+		t.Parallel()
+		svc, mockClient := newOwnedService(t)
+
+		proj := ownedProject(1, "")
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+		_, err := svc.GetProject(asUser("alice"), 1)
+		if !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("GetProject() error = %v, want ErrForbidden", err)
+		}
+	})
+
+	t.Run("anonymous caller unauthenticated", func(t *testing.T) {
+		t.Parallel()
+		svc, mockClient := newOwnedService(t)
+
+		proj := ownedProject(1, "alice")
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+		_, err := svc.GetProject(context.Background(), 1)
+		if !errors.Is(err, domain.ErrUnauthenticated) {
+			t.Errorf("GetProject() error = %v, want ErrUnauthenticated", err)
+		}
+	})
+
+	t.Run("not enforced", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		proj := ownedProject(1, "alice")
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(nil, nil)
+
+		if _, err := svc.GetProject(asUser("bob"), 1); err != nil {
+			t.Errorf("GetProject() error = %v, want nil", err)
+		}
+	})
+}
+
+func TestProjectService_Ownership_ListProjectsFiltersToCaller(t *testing.T) {
+	t.Parallel()
+	svc, mockClient := newOwnedService(t)
+
+	mockClient.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return([]project.Project{
+		ownedProject(1, "alice"),
+		ownedProject(2, "bob"),
+		ownedProject(3, ""),
+		ownedProject(4, "alice"),
+	}, nil)
+
+	got, err := svc.ListProjects(asUser("alice"), project.Filter{})
+	if err != nil {
+		t.Fatalf("ListProjects() error = %v", err)
+	}
+	if len(got) != 2 || got[0].ID != 1 || got[1].ID != 4 {
+		t.Errorf("ListProjects() = %+v, want projects 1 and 4", got)
+	}
+}
+
+func TestProjectService_Ownership_CreateProjectRecordsOwner(t *testing.T) {
+	t.Parallel()
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger())
+
+	mockClient.EXPECT().CreateProject(mock.Anything, mock.MatchedBy(func(p *project.Project) bool {
+		return p.OwnerID == "alice"
+	})).Return(&project.Project{ID: 5, OwnerID: "alice"}, nil)
+
+	got, err := svc.CreateProject(asUser("alice"), &project.Project{Name: "New", Description: "A new project"})
+	if err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	if got.OwnerID != "alice" {
+		t.Errorf("OwnerID = %q, want %q", got.OwnerID, "alice")
+	}
+}
+
+func TestProjectService_Ownership_WritesForbiddenForNonOwner(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		call func(svc *ProjectService, ctx context.Context) error
+	}{
+		{"UpdateProject", func(svc *ProjectService, ctx context.Context) error {
+			_, err := svc.UpdateProject(ctx, 1, &project.Project{Name: "Renamed"})
+			return err
+		}},
+		{"DeleteProject", func(svc *ProjectService, ctx context.Context) error {
+			return svc.DeleteProject(ctx, 1)
+		}},
+		{"AddTodo", func(svc *ProjectService, ctx context.Context) error {
+			td := validTodo()
+			_, err := svc.AddTodo(ctx, 1, &td)
+			return err
+		}},
+		{"RemoveTodo", func(svc *ProjectService, ctx context.Context) error {
+			return svc.RemoveTodo(ctx, 1, 10)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			svc, mockClient := newOwnedService(t)
+
+			proj := ownedProject(1, "alice")
+			mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+			if err := tt.call(svc, asUser("bob")); !errors.Is(err, domain.ErrForbidden) {
+				t.Errorf("%s() error = %v, want ErrForbidden", tt.name, err)
+			}
+		})
+	}
+}
+
+func newOwnedTodoService(t *testing.T) (*TodoService, *mocks.MockTodoClient) {
+	t.Helper()
+	mockClient := mocks.NewMockTodoClient(t)
+	return NewTodoService(mockClient, discardLogger(), WithTodoOwnershipEnforcement(true)), mockClient
+}
+
+// todoInProject returns a valid todo with the given ID in projectID.
+func todoInProject(id, projectID int64) *todo.Todo {
+	td := validTodo()
+	td.ID = id
+	td.ProjectID = &projectID
+	return &td
+}
+
+func TestTodoService_Ownership_ForbiddenForNonOwner(t *testing.T) {
+	t.Parallel()
+
+	// Todo 1 lives in project 7, which alice owns; bob calls the service
+	// method behind each /todos route.
+	expectTodo := func(m *mocks.MockTodoClient) {
+		m.EXPECT().GetTodo(mock.Anything, int64(1)).Return(todoInProject(1, 7), nil)
+	}
+	expectProject := func(m *mocks.MockTodoClient) {
+		proj := ownedProject(7, "alice")
+		m.EXPECT().GetProject(mock.Anything, int64(7)).Return(&proj, nil)
+	}
+
+	tests := []struct {
+		name   string
+		expect func(*mocks.MockTodoClient)
+		call   func(context.Context, *TodoService) error
+	}{
+		{
+			name:   "GET /todos?project_id",
+			expect: expectProject,
+			call: func(ctx context.Context, svc *TodoService) error {
+				projectID := int64(7)
+				_, err := svc.ListTodos(ctx, todo.Filter{ProjectID: &projectID})
+				return err
+			},
+		},
+		{
+			name: "GET and HEAD /todos/{id}",
+			expect: func(m *mocks.MockTodoClient) {
+				expectTodo(m)
+				expectProject(m)
+			},
+			call: func(ctx context.Context, svc *TodoService) error {
+				_, err := svc.GetTodo(ctx, 1)
+				return err
+			},
+		},
+		{
+			name:   "POST /todos",
+			expect: expectProject,
+			call: func(ctx context.Context, svc *TodoService) error {
+				_, err := svc.CreateTodo(ctx, todoInProject(0, 7))
+				return err
+			},
+		},
+		{
+			name: "PUT /todos/{id}",
+			expect: func(m *mocks.MockTodoClient) {
+				expectTodo(m)
+				expectProject(m)
+			},
+			call: func(ctx context.Context, svc *TodoService) error {
+				td := validTodo()
+				_, err := svc.UpdateTodo(ctx, 1, &td)
+				return err
+			},
+		},
+		{
+			name: "PATCH /todos/{id}",
+			expect: func(m *mocks.MockTodoClient) {
+				expectTodo(m)
+				expectProject(m)
+			},
+			call: func(ctx context.Context, svc *TodoService) error {
+				_, err := svc.PatchTodo(ctx, 1, func(td *todo.Todo) *todo.Todo {
+					td.Title = "Renamed"
+					return td
+				})
+				return err
+			},
+		},
+		{
+			name: "DELETE /todos/{id}",
+			expect: func(m *mocks.MockTodoClient) {
+				expectTodo(m)
+				expectProject(m)
+			},
+			call: func(ctx context.Context, svc *TodoService) error {
+				return svc.DeleteTodo(ctx, 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			svc, mockClient := newOwnedTodoService(t)
+			tt.expect(mockClient)
+
+			if err := tt.call(asUser("bob"), svc); !errors.Is(err, domain.ErrForbidden) {
+				t.Errorf("error = %v, want ErrForbidden", err)
+			}
+		})
+	}
+}
+
+func TestTodoService_Ownership_OwnerAllowed(t *testing.T) {
+	t.Parallel()
+	svc, mockClient := newOwnedTodoService(t)
+
+	proj := ownedProject(7, "alice")
+	mockClient.EXPECT().GetTodo(mock.Anything, int64(1)).Return(todoInProject(1, 7), nil)
+	mockClient.EXPECT().GetProject(mock.Anything, int64(7)).Return(&proj, nil)
+	mockClient.EXPECT().DeleteTodo(mock.Anything, int64(1)).Return(nil)
+
+	if err := svc.DeleteTodo(asUser("alice"), 1); err != nil {
+		t.Errorf("DeleteTodo() error = %v, want nil", err)
+	}
+}
+
+func TestTodoService_Ownership_MoveIntoForeignProjectForbidden(t *testing.T) {
+	t.Parallel()
+	svc, mockClient := newOwnedTodoService(t)
+
+	mine, theirs := ownedProject(7, "alice"), ownedProject(9, "bob")
+	mockClient.EXPECT().GetTodo(mock.Anything, int64(1)).Return(todoInProject(1, 7), nil)
+	mockClient.EXPECT().GetProject(mock.Anything, int64(7)).Return(&mine, nil)
+	mockClient.EXPECT().GetProject(mock.Anything, int64(9)).Return(&theirs, nil)
+
+	_, err := svc.UpdateTodo(asUser("alice"), 1, todoInProject(1, 9))
+	if !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("UpdateTodo() error = %v, want ErrForbidden", err)
+	}
+}
+
+func TestTodoService_Ownership_ListTodosFiltersToCaller(t *testing.T) {
+	t.Parallel()
+	svc, mockClient := newOwnedTodoService(t)
+
+	unassigned := validTodo()
+	unassigned.ID = 4
+	mine, theirs := ownedProject(7, "alice"), ownedProject(9, "bob")
+	mockClient.EXPECT().ListTodos(mock.Anything, todo.Filter{}).Return([]todo.Todo{
+		*todoInProject(1, 7),
+		*todoInProject(2, 9),
+		*todoInProject(3, 7),
+		unassigned,
+	}, nil)
+	mockClient.EXPECT().GetProject(mock.Anything, int64(7)).Return(&mine, nil).Once()
+	mockClient.EXPECT().GetProject(mock.Anything, int64(9)).Return(&theirs, nil).Once()
+
+	got, err := svc.ListTodos(asUser("alice"), todo.Filter{})
+	if err != nil {
+		t.Fatalf("ListTodos() error = %v", err)
+	}
+	if len(got) != 3 || got[0].ID != 1 || got[1].ID != 3 || got[2].ID != 4 {
+		t.Errorf("ListTodos() = %+v, want todos 1, 3, and 4", got)
+	}
+}
+
+func TestTodoService_Ownership_AnonymousCallerUnauthenticated(t *testing.T) {
+	t.Parallel()
+	svc, _ := newOwnedTodoService(t)
+
+	_, err := svc.ListTodos(context.Background(), todo.Filter{})
+	if !errors.Is(err, domain.ErrUnauthenticated) {
+		t.Errorf("ListTodos() error = %v, want ErrUnauthenticated", err)
+	}
+}
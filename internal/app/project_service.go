@@ -4,11 +4,16 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/app/fanout"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/app/identity"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
@@ -30,21 +35,97 @@ var _ ports.ProjectService = (*ProjectService)(nil)
 // downstream TODO API through the TodoClient port. It handles validation,
 // structured logging, and multi-step coordination but contains no business logic.
 type ProjectService struct {
-	todoClient ports.TodoClient
-	logger     *slog.Logger
+	todoClient   ports.TodoClient
+	logger       *slog.Logger
+	clock        domain.Clock
+	metrics      ports.EntityMetrics // nil disables entity metrics
+	audit        *slog.Logger        // nil disables audit records
+	tracer       trace.Tracer
+	degradeReads bool
+	partialReads bool
+	maxTodos     int    // per project; 0 means unlimited
+	defaultSort  string // for ListProjects when the filter has none
+
+	enforceOwnership bool
+}
+
+// Option configures a ProjectService.
+type Option func(*ProjectService)
+
+// WithDegradeReads enables graceful degradation for ListProjects: when the
+// downstream is unavailable or times out it logs the failure, marks the request degraded
+// via appctx.MarkDegraded, and returns an empty list instead of an error.
+// Write operations are unaffected.
+func WithDegradeReads(enabled bool) Option {
+	return func(s *ProjectService) {
+		s.degradeReads = enabled
+	}
+}
+
+// WithPartialProjectReads makes GetProject return the project even when its
+// todos fail to load: Todos is left nil, TodosLoadError records the failure,
+// and the request is marked degraded via appctx.MarkDegraded. By default the
+// todos error fails the whole call.
+func WithPartialProjectReads(enabled bool) Option {
+	return func(s *ProjectService) {
+		s.partialReads = enabled
+	}
+}
+
+// WithMaxTodosPerProject caps how many todos a project may hold. AddTodo and
+// MoveTodo reject a todo that would take the target project past n with
+// domain.ErrConflict. A non-positive n disables the limit.
+func WithMaxTodosPerProject(n int) Option {
+	return func(s *ProjectService) {
+		s.maxTodos = n
+	}
+}
+
+// WithDefaultProjectSort sets the sort ListProjects forwards when the filter
+// has none, so clients get a stable order. The value is expected to pass
+// project.Filter.Validate; an empty sort keeps the downstream's order.
+func WithDefaultProjectSort(sort string) Option {
+	return func(s *ProjectService) {
+		s.defaultSort = sort
+	}
+}
+
+// WithClock sets the clock used for timestamps the service derives itself,
+// which are those on dry-run previews. Persisted timestamps are assigned by
+// the downstream. The default is domain.SystemClock.
+func WithClock(clock domain.Clock) Option {
+	return func(s *ProjectService) {
+		if clock != nil {
+			s.clock = clock
+		}
+	}
+}
+
+// WithMetrics reports each successful create, update, and delete to metrics.
+// A nil metrics disables recording.
+func WithMetrics(metrics ports.EntityMetrics) Option {
+	return func(s *ProjectService) {
+		s.metrics = metrics
+	}
 }
 
 // NewProjectService creates a ProjectService. The client port provides access
 // to the downstream TODO API for project and todo operations. If logger is nil,
 // a no-op logger is used.
-func NewProjectService(client ports.TodoClient, logger *slog.Logger) *ProjectService {
+func NewProjectService(client ports.TodoClient, logger *slog.Logger, opts ...Option) *ProjectService {
 	if logger == nil {
 		logger = slog.New(slog.DiscardHandler)
 	}
-	return &ProjectService{
+	s := &ProjectService{
 		todoClient: client,
 		logger:     logger,
+		clock:      domain.SystemClock{},
+		tracer:     defaultTracer(),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // projectCacheKey returns the appctx cache key for a project by ID.
@@ -54,21 +135,100 @@ func projectCacheKey(id int64) string {
 
 // fetchProject returns a project by ID, using the RequestContext's memoized
 // cache when available. If no RequestContext is in the context (e.g., in unit
-// tests without middleware), it falls back to a direct client call.
+// tests without middleware), it falls back to a direct client call. Every
+// project-scoped operation goes through it, so it also applies the ownership
+// check (see WithOwnershipEnforcement).
 func (s *ProjectService) fetchProject(ctx context.Context, id int64) (*project.Project, error) {
+	var (
+		proj *project.Project
+		err  error
+	)
 	if rc := appctx.FromContext(ctx); rc != nil {
-		return appctx.GetOrFetch(rc, projectCacheKey(id), func(ctx context.Context) (*project.Project, error) {
+		proj, err = appctx.GetOrFetch(rc, projectCacheKey(id), func(ctx context.Context) (*project.Project, error) {
 			return s.todoClient.GetProject(ctx, id)
 		})
+	} else {
+		proj, err = s.todoClient.GetProject(ctx, id)
 	}
-	return s.todoClient.GetProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeProject(ctx, proj); err != nil {
+		return nil, err
+	}
+	return proj, nil
 }
 
-// ListProjects returns all projects without populating their todos.
-func (s *ProjectService) ListProjects(ctx context.Context) ([]project.Project, error) {
-	s.logger.InfoContext(ctx, "listing projects")
+// projectTodosCacheKey returns the appctx cache key for a project's todos.
+func projectTodosCacheKey(projectID int64) string {
+	return fmt.Sprintf("project-todos:%d", projectID)
+}
+
+// fetchProjectTodos returns all todos in a project, memoized in the
+// RequestContext like fetchProject.
+func (s *ProjectService) fetchProjectTodos(ctx context.Context, projectID int64) ([]todo.Todo, error) {
+	fetch := func(ctx context.Context) ([]todo.Todo, error) {
+		return s.todoClient.GetProjectTodos(ctx, projectID, todo.Filter{})
+	}
+	if rc := appctx.FromContext(ctx); rc != nil {
+		return appctx.GetOrFetch(rc, projectTodosCacheKey(projectID), fetch)
+	}
+	return fetch(ctx)
+}
 
-	projects, err := s.todoClient.ListProjects(ctx)
+// checkProjectCapacity returns domain.ErrConflict if projectID already holds
+// the maximum number of todos. It is a no-op when no limit is configured.
+func (s *ProjectService) checkProjectCapacity(ctx context.Context, operation string, projectID int64) error {
+	if s.maxTodos <= 0 {
+		return nil
+	}
+
+	todos, err := s.fetchProjectTodos(ctx, projectID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to count project todos",
+			slog.String("operation", operation),
+			slog.Int64("project_id", projectID),
+			slog.Any("error", err),
+		)
+		return fmt.Errorf("counting project todos: %w", err)
+	}
+	if len(todos) >= s.maxTodos {
+		return fmt.Errorf("project %d already has the maximum of %d todos: %w",
+			projectID, s.maxTodos, domain.ErrConflict)
+	}
+	return nil
+}
+
+// ListProjects returns the projects matching filter without populating their
+// todos. A filter without a sort uses the WithDefaultProjectSort default. The
+// downstream returns every project; archived ones are dropped here unless
+// filter.IncludeArchived is set.
+func (s *ProjectService) ListProjects(ctx context.Context, filter project.Filter) (_ []project.Project, err error) {
+	ctx, span := s.startSpan(ctx, "ListProjects")
+	defer func() { endSpan(span, err) }()
+
+	if filter.Sort == "" {
+		filter.Sort = s.defaultSort
+	}
+	s.logger.InfoContext(ctx, "listing projects", slog.String("sort", filter.Sort))
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	caller, owned, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.todoClient.ListProjects(ctx, filter)
+	if err != nil && s.degradeReads && (errors.Is(err, domain.ErrUnavailable) || errors.Is(err, domain.ErrTimeout)) {
+		s.logger.WarnContext(ctx, "downstream unavailable, serving degraded project list",
+			slog.String("operation", "ListProjects"),
+			slog.Any("error", err),
+		)
+		appctx.MarkDegraded(ctx)
+		return []project.Project{}, nil
+	}
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to list projects",
 			slog.String("operation", "ListProjects"),
@@ -77,11 +237,25 @@ func (s *ProjectService) ListProjects(ctx context.Context) ([]project.Project, e
 		return nil, fmt.Errorf("listing projects: %w", err)
 	}
 
-	return projects, nil
+	matched := make([]project.Project, 0, len(projects))
+	for i := range projects {
+		if owned && !projects[i].OwnedBy(caller.UserID) {
+			continue
+		}
+		if filter.Matches(&projects[i]) {
+			matched = append(matched, projects[i])
+		}
+	}
+	return matched, nil
 }
 
-// GetProject returns a single project by ID with its todos populated.
-func (s *ProjectService) GetProject(ctx context.Context, id int64) (*project.Project, error) {
+// GetProject returns a single project by ID with its todos populated. See
+// WithPartialProjectReads for returning the project when only the todos
+// fail to load.
+func (s *ProjectService) GetProject(ctx context.Context, id int64) (_ *project.Project, err error) {
+	ctx, span := s.startSpan(ctx, "GetProject", attribute.Int64(attrProjectID, id))
+	defer func() { endSpan(span, err) }()
+
 	s.logger.InfoContext(ctx, "fetching project", slog.Int64("id", id))
 
 	proj, err := s.fetchProject(ctx, id)
@@ -94,7 +268,18 @@ func (s *ProjectService) GetProject(ctx context.Context, id int64) (*project.Pro
 		return nil, fmt.Errorf("fetching project: %w", err)
 	}
 
-	todos, err := s.todoClient.GetProjectTodos(ctx, id, todo.Filter{})
+	todos, err := s.fetchProjectTodos(ctx, id)
+	if err != nil && s.partialReads {
+		s.logger.WarnContext(ctx, "failed to fetch project todos, serving project without them",
+			slog.String("operation", "GetProject"),
+			slog.Int64("project_id", id),
+			slog.Any("error", err),
+		)
+		appctx.MarkDegraded(ctx)
+		proj.Todos = nil
+		proj.TodosLoadError = fmt.Errorf("fetching project todos: %w", err)
+		return proj, nil
+	}
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to fetch project todos",
 			slog.String("operation", "GetProject"),
@@ -104,13 +289,22 @@ func (s *ProjectService) GetProject(ctx context.Context, id int64) (*project.Pro
 		return nil, fmt.Errorf("fetching project todos: %w", err)
 	}
 
+	// Normalize a missing list so callers never have to distinguish
+	// nil from empty.
+	if todos == nil {
+		todos = []todo.Todo{}
+	}
 	proj.Todos = todos
 	return proj, nil
 }
 
 // CreateProject validates and creates a new project, returning the created
-// entity with server-assigned fields (ID, timestamps).
-func (s *ProjectService) CreateProject(ctx context.Context, p *project.Project) (*project.Project, error) {
+// entity with server-assigned fields (ID, timestamps). The identified
+// caller, if any, becomes the project's owner.
+func (s *ProjectService) CreateProject(ctx context.Context, p *project.Project) (_ *project.Project, err error) {
+	ctx, span := s.startSpan(ctx, "CreateProject")
+	defer func() { endSpan(span, err) }()
+
 	if p == nil {
 		return nil, &domain.ValidationError{Fields: map[string]string{"project": "is required"}}
 	}
@@ -120,6 +314,12 @@ func (s *ProjectService) CreateProject(ctx context.Context, p *project.Project)
 	if err := p.Validate(); err != nil {
 		return nil, err
 	}
+	if _, _, err := s.caller(ctx); err != nil {
+		return nil, err
+	}
+	if caller, ok := identity.FromContext(ctx); ok {
+		p.OwnerID = caller.UserID
+	}
 
 	created, err := s.todoClient.CreateProject(ctx, p)
 	if err != nil {
@@ -130,18 +330,26 @@ func (s *ProjectService) CreateProject(ctx context.Context, p *project.Project)
 		return nil, fmt.Errorf("creating project: %w", err)
 	}
 
+	recordEntityOp(ctx, s.metrics, entityProject, opCreate, 1)
+	s.recordAudit(ctx, entityProject, opCreate, created.ID)
 	return created, nil
 }
 
 // UpdateProject validates and updates an existing project's metadata.
-func (s *ProjectService) UpdateProject(ctx context.Context, id int64, p *project.Project) (*project.Project, error) {
+func (s *ProjectService) UpdateProject(ctx context.Context, id int64, p *project.Project) (_ *project.Project, err error) {
+	ctx, span := s.startSpan(ctx, "UpdateProject", attribute.Int64(attrProjectID, id))
+	defer func() { endSpan(span, err) }()
+
 	if p == nil {
 		return nil, &domain.ValidationError{Fields: map[string]string{"project": "is required"}}
 	}
 
 	s.logger.InfoContext(ctx, "updating project", slog.Int64("id", id))
 
-	if err := p.Validate(); err != nil {
+	if err := p.ValidateForUpdate(); err != nil {
+		return nil, err
+	}
+	if err := s.verifyProjectOwner(ctx, "UpdateProject", id); err != nil {
 		return nil, err
 	}
 
@@ -155,13 +363,85 @@ func (s *ProjectService) UpdateProject(ctx context.Context, id int64, p *project
 		return nil, fmt.Errorf("updating project: %w", err)
 	}
 
+	if rc := appctx.FromContext(ctx); rc != nil {
+		rc.Invalidate(projectCacheKey(id))
+	}
+
+	recordEntityOp(ctx, s.metrics, entityProject, opUpdate, 1)
+	s.recordAudit(ctx, entityProject, opUpdate, id)
 	return updated, nil
 }
 
+// PatchProject reads the stored project, applies patch to a copy, and saves
+// the result through UpdateProject. Only the project itself is read; its
+// todos are not loaded. When the patch changes nothing the stored project is
+// returned without a downstream write.
+func (s *ProjectService) PatchProject(ctx context.Context, id int64, patch func(*project.Project) *project.Project) (_ *project.Project, err error) {
+	ctx, span := s.startSpan(ctx, "PatchProject", attribute.Int64(attrProjectID, id))
+	defer func() { endSpan(span, err) }()
+
+	s.logger.InfoContext(ctx, "patching project", slog.Int64("id", id))
+
+	existing, err := s.fetchProject(ctx, id)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to fetch project",
+			slog.String("operation", "PatchProject"),
+			slog.Int64("id", id),
+			slog.Any("error", err),
+		)
+		return nil, fmt.Errorf("fetching project: %w", err)
+	}
+
+	current := *existing
+	current.Todos = nil
+	merged := patch(&current)
+	if merged != nil && merged.SameContent(existing) {
+		s.logger.InfoContext(ctx, "no-op patch: project unchanged", slog.Int64("id", id))
+		unchanged := *existing
+		unchanged.Todos = nil
+		return &unchanged, nil
+	}
+	return s.UpdateProject(ctx, id, merged)
+}
+
+// ArchiveProject sets the project's archived flag through PatchProject, so
+// archiving an archived project makes no downstream write.
+func (s *ProjectService) ArchiveProject(ctx context.Context, id int64) (_ *project.Project, err error) {
+	ctx, span := s.startSpan(ctx, "ArchiveProject", attribute.Int64(attrProjectID, id))
+	defer func() { endSpan(span, err) }()
+
+	s.logger.InfoContext(ctx, "archiving project", slog.Int64("id", id))
+	return s.setArchived(ctx, id, true)
+}
+
+// UnarchiveProject clears the project's archived flag like ArchiveProject.
+func (s *ProjectService) UnarchiveProject(ctx context.Context, id int64) (_ *project.Project, err error) {
+	ctx, span := s.startSpan(ctx, "UnarchiveProject", attribute.Int64(attrProjectID, id))
+	defer func() { endSpan(span, err) }()
+
+	s.logger.InfoContext(ctx, "unarchiving project", slog.Int64("id", id))
+	return s.setArchived(ctx, id, false)
+}
+
+// setArchived patches the project's archived flag to archived.
+func (s *ProjectService) setArchived(ctx context.Context, id int64, archived bool) (*project.Project, error) {
+	return s.PatchProject(ctx, id, func(p *project.Project) *project.Project {
+		p.Archived = archived
+		return p
+	})
+}
+
 // DeleteProject deletes a project. Todos in the project become ungrouped.
-func (s *ProjectService) DeleteProject(ctx context.Context, id int64) error {
+func (s *ProjectService) DeleteProject(ctx context.Context, id int64) (err error) {
+	ctx, span := s.startSpan(ctx, "DeleteProject", attribute.Int64(attrProjectID, id))
+	defer func() { endSpan(span, err) }()
+
 	s.logger.InfoContext(ctx, "deleting project", slog.Int64("id", id))
 
+	if err := s.verifyProjectOwner(ctx, "DeleteProject", id); err != nil {
+		return err
+	}
+
 	if err := s.todoClient.DeleteProject(ctx, id); err != nil {
 		s.logger.ErrorContext(ctx, "failed to delete project",
 			slog.String("operation", "DeleteProject"),
@@ -171,18 +451,27 @@ func (s *ProjectService) DeleteProject(ctx context.Context, id int64) error {
 		return fmt.Errorf("deleting project: %w", err)
 	}
 
+	recordEntityOp(ctx, s.metrics, entityProject, opDelete, 1)
+	s.recordAudit(ctx, entityProject, opDelete, id)
 	return nil
 }
 
-// AddTodo creates a new todo within the specified project.
-func (s *ProjectService) AddTodo(ctx context.Context, projectID int64, td *todo.Todo) (*todo.Todo, error) {
+// AddTodo creates a new todo within the specified project. In a dry run
+// (see appctx.WithDryRun) the todo is validated and the read-only checks
+// (project existence, capacity) still run, but nothing is created: the
+// would-be todo is returned timestamped with the service clock. A draft
+// (see appctx.WithDraft) may omit its description.
+func (s *ProjectService) AddTodo(ctx context.Context, projectID int64, td *todo.Todo) (_ *todo.Todo, err error) {
+	ctx, span := s.startSpan(ctx, "AddTodo", attribute.Int64(attrProjectID, projectID))
+	defer func() { endSpan(span, err) }()
+
 	if td == nil {
 		return nil, &domain.ValidationError{Fields: map[string]string{"todo": "is required"}}
 	}
 
 	s.logger.InfoContext(ctx, "adding todo to project", slog.Int64("project_id", projectID))
 
-	if err := td.Validate(); err != nil {
+	if err := validateNewTodo(ctx, td); err != nil {
 		return nil, err
 	}
 
@@ -195,8 +484,23 @@ func (s *ProjectService) AddTodo(ctx context.Context, projectID int64, td *todo.
 		return nil, fmt.Errorf("verifying project: %w", err)
 	}
 
+	if err := s.checkProjectCapacity(ctx, "AddTodo", projectID); err != nil {
+		return nil, err
+	}
+
 	td.ProjectID = &projectID
 
+	if appctx.IsDryRun(ctx) {
+		s.logger.InfoContext(ctx, "dry run: skipping todo creation",
+			slog.String("operation", "AddTodo"),
+			slog.Int64("project_id", projectID),
+			slog.String("title", td.Title),
+		)
+		now := s.clock.Now()
+		td.CreatedAt, td.UpdatedAt = now, now
+		return td, nil
+	}
+
 	created, err := s.todoClient.CreateTodo(ctx, td)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to create todo",
@@ -207,11 +511,26 @@ func (s *ProjectService) AddTodo(ctx context.Context, projectID int64, td *todo.
 		return nil, fmt.Errorf("creating todo: %w", err)
 	}
 
+	if rc := appctx.FromContext(ctx); rc != nil {
+		rc.Invalidate(projectTodosCacheKey(projectID))
+	}
+
+	recordEntityOp(ctx, s.metrics, entityTodo, opCreate, 1)
+	s.recordAudit(ctx, entityTodo, opCreate, created.ID)
 	return created, nil
 }
 
-// UpdateTodo updates an existing todo within the specified project.
-func (s *ProjectService) UpdateTodo(ctx context.Context, projectID, todoID int64, td *todo.Todo) (*todo.Todo, error) {
+// UpdateTodo updates an existing todo within the specified project. In a dry
+// run the update is validated and the project and ownership checks still
+// run, but nothing is written: the would-be todo is returned with UpdatedAt
+// taken from the service clock.
+func (s *ProjectService) UpdateTodo(ctx context.Context, projectID, todoID int64, td *todo.Todo) (_ *todo.Todo, err error) {
+	ctx, span := s.startSpan(ctx, "UpdateTodo",
+		attribute.Int64(attrProjectID, projectID),
+		attribute.Int64(attrTodoID, todoID),
+	)
+	defer func() { endSpan(span, err) }()
+
 	if td == nil {
 		return nil, &domain.ValidationError{Fields: map[string]string{"todo": "is required"}}
 	}
@@ -225,37 +544,91 @@ func (s *ProjectService) UpdateTodo(ctx context.Context, projectID, todoID int64
 		return nil, err
 	}
 
-	if _, err := s.fetchProject(ctx, projectID); err != nil {
-		s.logger.ErrorContext(ctx, "failed to verify project",
-			slog.String("operation", "UpdateTodo"),
+	if _, err := s.fetchProjectTodo(ctx, "UpdateTodo", projectID, todoID); err != nil {
+		return nil, err
+	}
+
+	return s.saveTodo(ctx, "UpdateTodo", projectID, todoID, td)
+}
+
+// PatchTodo reads the project and the stored todo, applies patch to a copy
+// of the todo, and saves the validated result. It performs the same checks
+// and honors dry runs the same way as UpdateTodo. When the patch changes
+// nothing the stored todo is returned without a downstream write.
+func (s *ProjectService) PatchTodo(ctx context.Context, projectID, todoID int64, patch func(*todo.Todo) *todo.Todo) (_ *todo.Todo, err error) {
+	ctx, span := s.startSpan(ctx, "PatchTodo",
+		attribute.Int64(attrProjectID, projectID),
+		attribute.Int64(attrTodoID, todoID),
+	)
+	defer func() { endSpan(span, err) }()
+
+	s.logger.InfoContext(ctx, "patching todo in project",
+		slog.Int64("project_id", projectID),
+		slog.Int64("todo_id", todoID),
+	)
+
+	existing, err := s.fetchProjectTodo(ctx, "PatchTodo", projectID, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := *existing
+	td := patch(&current)
+	if td == nil {
+		return nil, &domain.ValidationError{Fields: map[string]string{"todo": "is required"}}
+	}
+	if td.SameContent(existing) {
+		s.logger.InfoContext(ctx, "no-op patch: todo unchanged",
 			slog.Int64("project_id", projectID),
 			slog.Int64("todo_id", todoID),
-			slog.Any("error", err),
 		)
-		return nil, fmt.Errorf("verifying project: %w", err)
+		unchanged := *existing
+		return &unchanged, nil
+	}
+	if err := td.Validate(); err != nil {
+		return nil, err
 	}
 
-	existing, err := s.todoClient.GetTodo(ctx, todoID)
-	if err != nil {
-		s.logger.ErrorContext(ctx, "failed to fetch todo",
-			slog.String("operation", "UpdateTodo"),
+	return s.saveTodo(ctx, "PatchTodo", projectID, todoID, td)
+}
+
+// fetchProjectTodo verifies that projectID exists and returns todoID, which
+// must belong to it. The operation name is used for logging.
+func (s *ProjectService) fetchProjectTodo(ctx context.Context, operation string, projectID, todoID int64) (*todo.Todo, error) {
+	if _, err := s.fetchProject(ctx, projectID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to verify project",
+			slog.String("operation", operation),
 			slog.Int64("project_id", projectID),
 			slog.Int64("todo_id", todoID),
 			slog.Any("error", err),
 		)
-		return nil, fmt.Errorf("fetching todo: %w", err)
+		return nil, fmt.Errorf("verifying project: %w", err)
 	}
 
-	if existing.ProjectID == nil || *existing.ProjectID != projectID {
-		return nil, fmt.Errorf("todo %d does not belong to project %d: %w", todoID, projectID, domain.ErrNotFound)
-	}
+	return s.fetchOwnedTodo(ctx, operation, projectID, todoID)
+}
 
+// saveTodo writes a validated todo as todoID within projectID. In a dry run
+// nothing is written and the would-be todo is returned with UpdatedAt taken
+// from the service clock.
+func (s *ProjectService) saveTodo(ctx context.Context, operation string, projectID, todoID int64, td *todo.Todo) (*todo.Todo, error) {
 	td.ProjectID = &projectID
 
+	if appctx.IsDryRun(ctx) {
+		s.logger.InfoContext(ctx, "dry run: skipping todo update",
+			slog.String("operation", operation),
+			slog.Int64("project_id", projectID),
+			slog.Int64("todo_id", todoID),
+		)
+		td.ID = todoID
+		td.UpdatedAt = s.clock.Now()
+		return td, nil
+	}
+
 	updated, err := s.todoClient.UpdateTodo(ctx, todoID, td)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to update todo",
-			slog.String("operation", "UpdateTodo"),
+			slog.String("operation", operation),
 			slog.Int64("project_id", projectID),
 			slog.Int64("todo_id", todoID),
 			slog.Any("error", err),
@@ -263,52 +636,179 @@ func (s *ProjectService) UpdateTodo(ctx context.Context, projectID, todoID int64
 		return nil, fmt.Errorf("updating todo: %w", err)
 	}
 
+	recordEntityOp(ctx, s.metrics, entityTodo, opUpdate, 1)
+	s.recordAudit(ctx, entityTodo, opUpdate, todoID)
 	return updated, nil
 }
 
-// RemoveTodo deletes a todo from the specified project.
-func (s *ProjectService) RemoveTodo(ctx context.Context, projectID, todoID int64) error {
+// RemoveTodo deletes a todo from the specified project. In a dry run the
+// project and ownership checks still run, then the deletion is logged and
+// skipped.
+func (s *ProjectService) RemoveTodo(ctx context.Context, projectID, todoID int64) (err error) {
+	ctx, span := s.startSpan(ctx, "RemoveTodo",
+		attribute.Int64(attrProjectID, projectID),
+		attribute.Int64(attrTodoID, todoID),
+	)
+	defer func() { endSpan(span, err) }()
+
 	s.logger.InfoContext(ctx, "removing todo from project",
 		slog.Int64("project_id", projectID),
 		slog.Int64("todo_id", todoID),
 	)
 
-	if _, err := s.fetchProject(ctx, projectID); err != nil {
-		s.logger.ErrorContext(ctx, "failed to verify project",
+	if _, err := s.fetchProjectTodo(ctx, "RemoveTodo", projectID, todoID); err != nil {
+		return err
+	}
+
+	if appctx.IsDryRun(ctx) {
+		s.logger.InfoContext(ctx, "dry run: skipping todo deletion",
+			slog.String("operation", "RemoveTodo"),
+			slog.Int64("project_id", projectID),
+			slog.Int64("todo_id", todoID),
+		)
+		return nil
+	}
+
+	if err := s.todoClient.DeleteTodo(ctx, todoID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete todo",
 			slog.String("operation", "RemoveTodo"),
 			slog.Int64("project_id", projectID),
 			slog.Int64("todo_id", todoID),
 			slog.Any("error", err),
 		)
-		return fmt.Errorf("verifying project: %w", err)
+		return fmt.Errorf("deleting todo: %w", err)
 	}
 
+	recordEntityOp(ctx, s.metrics, entityTodo, opDelete, 1)
+	s.recordAudit(ctx, entityTodo, opDelete, todoID)
+	return nil
+}
+
+// fetchOwnedTodo fetches a todo and verifies that it belongs to projectID.
+// A todo in another project (or in none) is reported as domain.ErrNotFound so
+// callers cannot probe todos outside the project. The operation name is used
+// for logging.
+func (s *ProjectService) fetchOwnedTodo(ctx context.Context, operation string, projectID, todoID int64) (*todo.Todo, error) {
 	existing, err := s.todoClient.GetTodo(ctx, todoID)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to fetch todo",
-			slog.String("operation", "RemoveTodo"),
+			slog.String("operation", operation),
 			slog.Int64("project_id", projectID),
 			slog.Int64("todo_id", todoID),
 			slog.Any("error", err),
 		)
-		return fmt.Errorf("fetching todo: %w", err)
+		return nil, fmt.Errorf("fetching todo: %w", err)
 	}
 
 	if existing.ProjectID == nil || *existing.ProjectID != projectID {
-		return fmt.Errorf("todo %d does not belong to project %d: %w", todoID, projectID, domain.ErrNotFound)
+		return nil, fmt.Errorf("todo %d does not belong to project %d: %w", todoID, projectID, domain.ErrNotFound)
 	}
 
-	if err := s.todoClient.DeleteTodo(ctx, todoID); err != nil {
-		s.logger.ErrorContext(ctx, "failed to delete todo",
-			slog.String("operation", "RemoveTodo"),
-			slog.Int64("project_id", projectID),
+	return existing, nil
+}
+
+// MoveTodo moves a todo from one project to another. Both projects must
+// exist and the todo must currently belong to fromProjectID; otherwise
+// domain.ErrNotFound is returned.
+//
+// The update is staged as a single action carrying a rollback that restores
+// the original project, and committed on its own RequestContext. In a dry run
+// the checks still run but the moved todo is returned without being written.
+func (s *ProjectService) MoveTodo(ctx context.Context, fromProjectID, toProjectID, todoID int64) (_ *todo.Todo, err error) {
+	ctx, span := s.startSpan(ctx, "MoveTodo",
+		attribute.Int64(attrFromProjectID, fromProjectID),
+		attribute.Int64(attrToProjectID, toProjectID),
+		attribute.Int64(attrTodoID, todoID),
+	)
+	defer func() { endSpan(span, err) }()
+
+	if fromProjectID == toProjectID {
+		return nil, &domain.ValidationError{Fields: map[string]string{
+			"to_project_id": "must differ from the current project",
+		}}
+	}
+
+	s.logger.InfoContext(ctx, "moving todo between projects",
+		slog.Int64("from_project_id", fromProjectID),
+		slog.Int64("to_project_id", toProjectID),
+		slog.Int64("todo_id", todoID),
+	)
+
+	for _, id := range []int64{fromProjectID, toProjectID} {
+		if _, err := s.fetchProject(ctx, id); err != nil {
+			s.logger.ErrorContext(ctx, "failed to verify project",
+				slog.String("operation", "MoveTodo"),
+				slog.Int64("project_id", id),
+				slog.Int64("todo_id", todoID),
+				slog.Any("error", err),
+			)
+			return nil, fmt.Errorf("verifying project %d: %w", id, err)
+		}
+	}
+
+	existing, err := s.fetchOwnedTodo(ctx, "MoveTodo", fromProjectID, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkProjectCapacity(ctx, "MoveTodo", toProjectID); err != nil {
+		return nil, err
+	}
+
+	moved := *existing
+	moved.ProjectID = &toProjectID
+
+	if appctx.IsDryRun(ctx) {
+		s.logger.InfoContext(ctx, "dry run: skipping todo move",
+			slog.String("operation", "MoveTodo"),
+			slog.Int64("todo_id", todoID),
+		)
+		moved.UpdatedAt = s.clock.Now()
+		return &moved, nil
+	}
+
+	var updated *todo.Todo
+	original := *existing
+	move := appctx.ActionFunc(
+		fmt.Sprintf("move todo %d from project %d to %d", todoID, fromProjectID, toProjectID),
+		func(ctx context.Context) error {
+			var err error
+			updated, err = s.todoClient.UpdateTodo(ctx, todoID, &moved)
+			return err
+		},
+		func(ctx context.Context) error {
+			_, err := s.todoClient.UpdateTodo(ctx, todoID, &original)
+			return err
+		},
+	)
+
+	rc := appctx.New(ctx)
+	if err := rc.AddAction(move); err != nil {
+		return nil, fmt.Errorf("staging todo move: %w", err)
+	}
+	if err := rc.Commit(ctx); err != nil {
+		s.logger.ErrorContext(ctx, "failed to move todo",
+			slog.String("operation", "MoveTodo"),
+			slog.Int64("from_project_id", fromProjectID),
+			slog.Int64("to_project_id", toProjectID),
 			slog.Int64("todo_id", todoID),
 			slog.Any("error", err),
 		)
-		return fmt.Errorf("deleting todo: %w", err)
+		return nil, fmt.Errorf("moving todo: %w", err)
 	}
 
-	return nil
+	// Both projects' cached todo lists are now stale.
+	if reqRC := appctx.FromContext(ctx); reqRC != nil {
+		reqRC.Invalidate(projectCacheKey(fromProjectID))
+		reqRC.Invalidate(projectCacheKey(toProjectID))
+		reqRC.Invalidate(projectTodosCacheKey(fromProjectID))
+		reqRC.Invalidate(projectTodosCacheKey(toProjectID))
+		appctx.Put(reqRC, todoCacheKey(todoID), updated)
+	}
+
+	recordEntityOp(ctx, s.metrics, entityTodo, opUpdate, 1)
+	s.recordAudit(ctx, entityTodo, opUpdate, todoID)
+	return updated, nil
 }
 
 // validateBulkUpdates checks that the updates slice is non-empty, within the
@@ -346,55 +846,116 @@ func validateBulkUpdates(updates []ports.TodoUpdate) error {
 	return nil
 }
 
-// BulkUpdateTodos updates multiple todos within the specified project
-// concurrently. Each update succeeds or fails independently; the response
-// reports per-item outcomes. Returns a hard error only for request-level
-// failures (validation, project not found, ownership check).
-func (s *ProjectService) BulkUpdateTodos(ctx context.Context, projectID int64, updates []ports.TodoUpdate) (*ports.BulkUpdateResult, error) {
+// prepareBulkUpdates validates updates, checks that projectID exists and
+// owns every todo in them, and assigns each update to projectID. It returns
+// the todos as stored before the update, keyed by ID.
+func (s *ProjectService) prepareBulkUpdates(ctx context.Context, operation string, projectID int64, updates []ports.TodoUpdate) (map[int64]todo.Todo, error) {
+	if err := validateBulkUpdates(updates); err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(updates))
+	for i, u := range updates {
+		ids[i] = u.TodoID
+	}
+	owned, err := s.fetchOwnedTodos(ctx, operation, projectID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range updates {
+		updates[i].Todo.ProjectID = &projectID
+	}
+	return owned, nil
+}
+
+// BulkUpdateTodos updates several todos within the specified project as one
+// unit. Validation, project existence, and ownership of every todo are
+// checked up front, as in BulkRemoveTodos; the updates are then staged as an
+// action group on their own RequestContext and committed together.
+//
+// If any update fails, the completed ones are rolled back by writing the
+// todos back as they were before the request, and the error is returned. On
+// success every todo is in the result's Updated, in request order.
+func (s *ProjectService) BulkUpdateTodos(ctx context.Context, projectID int64, updates []ports.TodoUpdate) (_ *ports.BulkUpdateResult, err error) {
+	ctx, span := s.startSpan(ctx, "BulkUpdateTodos",
+		attribute.Int64(attrProjectID, projectID),
+		attribute.Int(attrItemCount, len(updates)),
+	)
+	defer func() { endSpan(span, err) }()
+
 	s.logger.InfoContext(ctx, "bulk updating todos in project",
 		slog.Int64("project_id", projectID),
 		slog.Int("count", len(updates)),
 	)
 
-	if err := validateBulkUpdates(updates); err != nil {
+	owned, err := s.prepareBulkUpdates(ctx, "BulkUpdateTodos", projectID, updates)
+	if err != nil {
 		return nil, err
 	}
 
-	// Verify project exists (memoized).
-	if _, err := s.fetchProject(ctx, projectID); err != nil {
-		s.logger.ErrorContext(ctx, "failed to verify project",
-			slog.String("operation", "BulkUpdateTodos"),
-			slog.Int64("project_id", projectID),
-			slog.Any("error", err),
+	// Each action writes only its own slot, so the group can fill updated
+	// concurrently.
+	updated := make([]todo.Todo, len(updates))
+	actions := make([]domain.Action, len(updates))
+	for i, u := range updates {
+		original := owned[u.TodoID]
+		actions[i] = appctx.ActionFunc(
+			fmt.Sprintf("update todo %d in project %d", u.TodoID, projectID),
+			func(ctx context.Context) error {
+				td, err := s.todoClient.UpdateTodo(ctx, u.TodoID, u.Todo)
+				if err != nil {
+					return err
+				}
+				updated[i] = *td
+				return nil
+			},
+			func(ctx context.Context) error {
+				_, err := s.todoClient.UpdateTodo(ctx, u.TodoID, &original)
+				return err
+			},
 		)
-		return nil, fmt.Errorf("verifying project: %w", err)
 	}
 
-	// Fetch all project todos to verify ownership in bulk.
-	projectTodos, err := s.todoClient.GetProjectTodos(ctx, projectID, todo.Filter{})
-	if err != nil {
-		s.logger.ErrorContext(ctx, "failed to fetch project todos",
+	rc := appctx.New(ctx)
+	if err := rc.AddGroupN(maxConcurrentUpdates, actions...); err != nil {
+		return nil, fmt.Errorf("staging todo updates: %w", err)
+	}
+	if err := rc.Commit(ctx); err != nil {
+		s.logger.ErrorContext(ctx, "failed to bulk update todos",
 			slog.String("operation", "BulkUpdateTodos"),
 			slog.Int64("project_id", projectID),
 			slog.Any("error", err),
 		)
-		return nil, fmt.Errorf("fetching project todos: %w", err)
+		return nil, fmt.Errorf("updating todos: %w", err)
 	}
 
-	projectTodoIDs := make(map[int64]bool, len(projectTodos))
-	for i := range projectTodos {
-		projectTodoIDs[projectTodos[i].ID] = true
-	}
-	for _, u := range updates {
-		if !projectTodoIDs[u.TodoID] {
-			return nil, fmt.Errorf("todo %d does not belong to project %d: %w",
-				u.TodoID, projectID, domain.ErrNotFound)
-		}
+	recordEntityOp(ctx, s.metrics, entityTodo, opUpdate, len(updated))
+	for i := range updated {
+		s.recordAudit(ctx, entityTodo, opUpdate, updated[i].ID)
 	}
+	return &ports.BulkUpdateResult{Updated: updated}, nil
+}
 
-	// Set ProjectID on each update.
-	for i := range updates {
-		updates[i].Todo.ProjectID = &projectID
+// BulkUpdateTodosEach updates several todos within the specified project
+// concurrently. Each update succeeds or fails independently and nothing is
+// rolled back; the result reports per-item outcomes. Returns a hard error
+// only for request-level failures (validation, project not found, ownership
+// check), as in BulkUpdateTodos.
+func (s *ProjectService) BulkUpdateTodosEach(ctx context.Context, projectID int64, updates []ports.TodoUpdate) (_ *ports.BulkUpdateResult, err error) {
+	ctx, span := s.startSpan(ctx, "BulkUpdateTodosEach",
+		attribute.Int64(attrProjectID, projectID),
+		attribute.Int(attrItemCount, len(updates)),
+	)
+	defer func() { endSpan(span, err) }()
+
+	s.logger.InfoContext(ctx, "bulk updating todos in project independently",
+		slog.Int64("project_id", projectID),
+		slog.Int("count", len(updates)),
+	)
+
+	if _, err := s.prepareBulkUpdates(ctx, "BulkUpdateTodosEach", projectID, updates); err != nil {
+		return nil, err
 	}
 
 	// Fan out updates concurrently with bounded workers.
@@ -409,7 +970,7 @@ func (s *ProjectService) BulkUpdateTodos(ctx context.Context, projectID int64, u
 	for i, r := range results {
 		if r.Err != nil {
 			s.logger.ErrorContext(ctx, "failed to update todo in bulk operation",
-				slog.String("operation", "BulkUpdateTodos"),
+				slog.String("operation", "BulkUpdateTodosEach"),
 				slog.Int64("project_id", projectID),
 				slog.Int64("todo_id", updates[i].TodoID),
 				slog.Any("error", r.Err),
@@ -423,8 +984,13 @@ func (s *ProjectService) BulkUpdateTodos(ctx context.Context, projectID int64, u
 		}
 	}
 
+	recordEntityOp(ctx, s.metrics, entityTodo, opUpdate, len(result.Updated))
+	for i := range result.Updated {
+		s.recordAudit(ctx, entityTodo, opUpdate, result.Updated[i].ID)
+	}
+
 	s.logger.InfoContext(ctx, "bulk update completed",
-		slog.String("operation", "BulkUpdateTodos"),
+		slog.String("operation", "BulkUpdateTodosEach"),
 		slog.Int64("project_id", projectID),
 		slog.Int("succeeded", len(result.Updated)),
 		slog.Int("failed", len(result.Errors)),
@@ -432,3 +998,207 @@ func (s *ProjectService) BulkUpdateTodos(ctx context.Context, projectID int64, u
 
 	return result, nil
 }
+
+// validateBulkRemoves checks that the ID list is non-empty, within the max
+// batch size, and contains only positive, distinct IDs.
+func validateBulkRemoves(todoIDs []int64) error {
+	if len(todoIDs) == 0 {
+		return &domain.ValidationError{Fields: map[string]string{
+			"todo_ids": "must not be empty",
+		}}
+	}
+	if len(todoIDs) > maxBulkUpdateSize {
+		return &domain.ValidationError{Fields: map[string]string{
+			"todo_ids": fmt.Sprintf("exceeds maximum batch size of %d", maxBulkUpdateSize),
+		}}
+	}
+
+	seen := make(map[int64]bool, len(todoIDs))
+	for i, id := range todoIDs {
+		if id <= 0 {
+			return &domain.ValidationError{Fields: map[string]string{
+				fmt.Sprintf("todo_ids[%d]", i): "must be a positive integer",
+			}}
+		}
+		if seen[id] {
+			return &domain.ValidationError{Fields: map[string]string{
+				fmt.Sprintf("todo_ids[%d]", i): fmt.Sprintf("duplicate todo ID %d", id),
+			}}
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// BulkRemoveTodos deletes several todos from the specified project as one
+// unit. Project existence and ownership of every todo are checked up front
+// with a single lookup each; the deletes are then staged as an action group
+// on their own RequestContext and committed together.
+//
+// If any delete fails, the completed ones are rolled back by re-creating the
+// original todos. The downstream assigns new IDs to re-created todos, so a
+// rollback restores content, not identity.
+func (s *ProjectService) BulkRemoveTodos(ctx context.Context, projectID int64, todoIDs []int64) (err error) {
+	ctx, span := s.startSpan(ctx, "BulkRemoveTodos",
+		attribute.Int64(attrProjectID, projectID),
+		attribute.Int(attrItemCount, len(todoIDs)),
+	)
+	defer func() { endSpan(span, err) }()
+
+	s.logger.InfoContext(ctx, "bulk removing todos from project",
+		slog.Int64("project_id", projectID),
+		slog.Int("count", len(todoIDs)),
+	)
+
+	if err := validateBulkRemoves(todoIDs); err != nil {
+		return err
+	}
+
+	owned, err := s.fetchOwnedTodos(ctx, "BulkRemoveTodos", projectID, todoIDs)
+	if err != nil {
+		return err
+	}
+
+	actions := make([]domain.Action, len(todoIDs))
+	for i, id := range todoIDs {
+		original := owned[id]
+		actions[i] = appctx.ActionFunc(
+			fmt.Sprintf("delete todo %d from project %d", id, projectID),
+			func(ctx context.Context) error {
+				return s.todoClient.DeleteTodo(ctx, id)
+			},
+			func(ctx context.Context) error {
+				_, err := s.todoClient.CreateTodo(ctx, &original)
+				return err
+			},
+		)
+	}
+
+	rc := appctx.New(ctx)
+	if err := rc.AddGroupN(maxConcurrentUpdates, actions...); err != nil {
+		return fmt.Errorf("staging todo deletes: %w", err)
+	}
+	if err := rc.Commit(ctx); err != nil {
+		s.logger.ErrorContext(ctx, "failed to bulk remove todos",
+			slog.String("operation", "BulkRemoveTodos"),
+			slog.Int64("project_id", projectID),
+			slog.Any("error", err),
+		)
+		return fmt.Errorf("removing todos: %w", err)
+	}
+
+	s.invalidateRemovedTodos(ctx, projectID, todoIDs)
+	recordEntityOp(ctx, s.metrics, entityTodo, opDelete, len(todoIDs))
+	s.recordAudit(ctx, entityTodo, opDelete, todoIDs...)
+	return nil
+}
+
+// BulkRemoveTodosEach deletes several todos from the specified project
+// independently: each delete succeeds or fails on its own and nothing is
+// rolled back. Validation, project existence, and ownership are still checked
+// for the whole request up front, as in BulkRemoveTodos.
+func (s *ProjectService) BulkRemoveTodosEach(ctx context.Context, projectID int64, todoIDs []int64) (_ *ports.BulkRemoveResult, err error) {
+	ctx, span := s.startSpan(ctx, "BulkRemoveTodosEach",
+		attribute.Int64(attrProjectID, projectID),
+		attribute.Int(attrItemCount, len(todoIDs)),
+	)
+	defer func() { endSpan(span, err) }()
+
+	s.logger.InfoContext(ctx, "bulk removing todos from project independently",
+		slog.Int64("project_id", projectID),
+		slog.Int("count", len(todoIDs)),
+	)
+
+	if err := validateBulkRemoves(todoIDs); err != nil {
+		return nil, err
+	}
+	if _, err := s.fetchOwnedTodos(ctx, "BulkRemoveTodosEach", projectID, todoIDs); err != nil {
+		return nil, err
+	}
+
+	results := fanout.Run(ctx, maxConcurrentUpdates, todoIDs,
+		func(ctx context.Context, id int64) (struct{}, error) {
+			return struct{}{}, s.todoClient.DeleteTodo(ctx, id)
+		},
+	)
+
+	result := &ports.BulkRemoveResult{}
+	for i, r := range results {
+		if r.Err != nil {
+			s.logger.ErrorContext(ctx, "failed to remove todo in bulk operation",
+				slog.String("operation", "BulkRemoveTodosEach"),
+				slog.Int64("project_id", projectID),
+				slog.Int64("todo_id", todoIDs[i]),
+				slog.Any("error", r.Err),
+			)
+			result.Errors = append(result.Errors, ports.BulkUpdateError{TodoID: todoIDs[i], Err: r.Err})
+		} else {
+			result.Removed = append(result.Removed, todoIDs[i])
+		}
+	}
+
+	s.invalidateRemovedTodos(ctx, projectID, result.Removed)
+	recordEntityOp(ctx, s.metrics, entityTodo, opDelete, len(result.Removed))
+	s.recordAudit(ctx, entityTodo, opDelete, result.Removed...)
+
+	s.logger.InfoContext(ctx, "bulk remove completed",
+		slog.String("operation", "BulkRemoveTodosEach"),
+		slog.Int64("project_id", projectID),
+		slog.Int("succeeded", len(result.Removed)),
+		slog.Int("failed", len(result.Errors)),
+	)
+
+	return result, nil
+}
+
+// fetchOwnedTodos verifies that projectID exists (memoized) and that every
+// todo in ids belongs to it, fetching the todos in one call. It returns the
+// todos keyed by ID, or domain.ErrNotFound for the first foreign todo.
+// Failures are logged under operation.
+func (s *ProjectService) fetchOwnedTodos(ctx context.Context, operation string, projectID int64, ids []int64) (map[int64]todo.Todo, error) {
+	if _, err := s.fetchProject(ctx, projectID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to verify project",
+			slog.String("operation", operation),
+			slog.Int64("project_id", projectID),
+			slog.Any("error", err),
+		)
+		return nil, fmt.Errorf("verifying project: %w", err)
+	}
+
+	existing, err := s.todoClient.GetTodosByIDs(ctx, ids)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to fetch todos",
+			slog.String("operation", operation),
+			slog.Int64("project_id", projectID),
+			slog.Any("error", err),
+		)
+		return nil, fmt.Errorf("fetching todos: %w", err)
+	}
+
+	owned := make(map[int64]todo.Todo, len(existing))
+	for i := range existing {
+		if existing[i].ProjectID != nil && *existing[i].ProjectID == projectID {
+			owned[existing[i].ID] = existing[i]
+		}
+	}
+	for _, id := range ids {
+		if _, ok := owned[id]; !ok {
+			return nil, fmt.Errorf("todo %d does not belong to project %d: %w", id, projectID, domain.ErrNotFound)
+		}
+	}
+	return owned, nil
+}
+
+// invalidateRemovedTodos drops the request-scoped cache entries made stale by
+// deleting todoIDs from projectID.
+func (s *ProjectService) invalidateRemovedTodos(ctx context.Context, projectID int64, todoIDs []int64) {
+	reqRC := appctx.FromContext(ctx)
+	if reqRC == nil {
+		return
+	}
+	reqRC.Invalidate(projectCacheKey(projectID))
+	reqRC.Invalidate(projectTodosCacheKey(projectID))
+	for _, id := range todoIDs {
+		reqRC.Invalidate(todoCacheKey(id))
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"maps"
+	"sync"
 	"testing"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 
 	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/domaintest"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
@@ -72,9 +75,9 @@ func TestProjectService_ListProjects(t *testing.T) {
 			{ID: 1, Name: "Project A", Description: "Desc A"},
 			{ID: 2, Name: "Project B", Description: "Desc B"},
 		}
-		mockClient.EXPECT().ListProjects(mock.Anything).Return(want, nil)
+		mockClient.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return(want, nil)
 
-		got, err := svc.ListProjects(context.Background())
+		got, err := svc.ListProjects(context.Background(), project.Filter{})
 		if err != nil {
 			t.Fatalf("ListProjects() error = %v, want nil", err)
 		}
@@ -91,15 +94,173 @@ func TestProjectService_ListProjects(t *testing.T) {
 		mockClient := mocks.NewMockTodoClient(t)
 		svc := NewProjectService(mockClient, discardLogger())
 
-		mockClient.EXPECT().ListProjects(mock.Anything).Return(nil, domain.ErrUnavailable)
+		mockClient.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return(nil, domain.ErrUnavailable)
 
-		_, err := svc.ListProjects(context.Background())
+		_, err := svc.ListProjects(context.Background(), project.Filter{})
 		if !errors.Is(err, domain.ErrUnavailable) {
 			t.Errorf("ListProjects() error = %v, want ErrUnavailable", err)
 		}
 	})
 }
 
+func TestProjectService_ListProjects_DefaultSort(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no sort forwards the configured default", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithDefaultProjectSort("name"))
+
+		mockClient.EXPECT().ListProjects(mock.Anything, project.Filter{Sort: "name"}).Return([]project.Project{}, nil)
+
+		if _, err := svc.ListProjects(context.Background(), project.Filter{}); err != nil {
+			t.Fatalf("ListProjects() error = %v", err)
+		}
+	})
+
+	t.Run("requested sort overrides the default", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithDefaultProjectSort("name"))
+
+		mockClient.EXPECT().ListProjects(mock.Anything, project.Filter{Sort: "-updated_at"}).Return([]project.Project{}, nil)
+
+		if _, err := svc.ListProjects(context.Background(), project.Filter{Sort: "-updated_at"}); err != nil {
+			t.Fatalf("ListProjects() error = %v", err)
+		}
+	})
+
+	t.Run("invalid sort skips client", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		_, err := svc.ListProjects(context.Background(), project.Filter{Sort: "title"})
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("ListProjects() error = %v, want ErrValidation", err)
+		}
+	})
+}
+
+func TestProjectService_ListProjects_Archived(t *testing.T) {
+	t.Parallel()
+
+	stored := []project.Project{
+		{ID: 1, Name: "Active", Description: "d"},
+		{ID: 2, Name: "Archived", Description: "d", Archived: true},
+	}
+
+	t.Run("excludes archived by default", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		mockClient.EXPECT().ListProjects(mock.Anything, mock.Anything).Return(stored, nil)
+
+		got, err := svc.ListProjects(context.Background(), project.Filter{})
+		if err != nil {
+			t.Fatalf("ListProjects() error = %v", err)
+		}
+		if len(got) != 1 || got[0].ID != 1 {
+			t.Errorf("ListProjects() = %+v, want only project 1", got)
+		}
+	})
+
+	t.Run("includes archived when requested", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		mockClient.EXPECT().ListProjects(mock.Anything, mock.Anything).Return(stored, nil)
+
+		got, err := svc.ListProjects(context.Background(), project.Filter{IncludeArchived: true})
+		if err != nil {
+			t.Fatalf("ListProjects() error = %v", err)
+		}
+		if len(got) != 2 {
+			t.Errorf("ListProjects() len = %d, want 2", len(got))
+		}
+	})
+}
+
+func TestProjectService_ListProjects_DegradeReads(t *testing.T) {
+	t.Parallel()
+
+	t.Run("degraded returns empty list on unavailable", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithDegradeReads(true))
+
+		mockClient.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return(nil, domain.ErrUnavailable)
+
+		ctx := ctxWithRC()
+		got, err := svc.ListProjects(ctx, project.Filter{})
+		if err != nil {
+			t.Fatalf("ListProjects() error = %v, want nil", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Errorf("ListProjects() = %v, want empty non-nil slice", got)
+		}
+		if !appctx.IsDegraded(ctx) {
+			t.Error("IsDegraded() = false, want true")
+		}
+	})
+
+	t.Run("degraded returns empty list on timeout", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithDegradeReads(true))
+
+		mockClient.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return(nil, domain.ErrTimeout)
+
+		ctx := ctxWithRC()
+		got, err := svc.ListProjects(ctx, project.Filter{})
+		if err != nil {
+			t.Fatalf("ListProjects() error = %v, want nil", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Errorf("ListProjects() = %v, want empty non-nil slice", got)
+		}
+		if !appctx.IsDegraded(ctx) {
+			t.Error("IsDegraded() = false, want true")
+		}
+	})
+
+	t.Run("degraded still propagates other errors", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithDegradeReads(true))
+
+		mockClient.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return(nil, domain.ErrForbidden)
+
+		ctx := ctxWithRC()
+		_, err := svc.ListProjects(ctx, project.Filter{})
+		if !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("ListProjects() error = %v, want ErrForbidden", err)
+		}
+		if appctx.IsDegraded(ctx) {
+			t.Error("IsDegraded() = true, want false")
+		}
+	})
+
+	t.Run("default propagates unavailable", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		mockClient.EXPECT().ListProjects(mock.Anything, project.Filter{}).Return(nil, domain.ErrUnavailable)
+
+		ctx := ctxWithRC()
+		_, err := svc.ListProjects(ctx, project.Filter{})
+		if !errors.Is(err, domain.ErrUnavailable) {
+			t.Errorf("ListProjects() error = %v, want ErrUnavailable", err)
+		}
+		if appctx.IsDegraded(ctx) {
+			t.Error("IsDegraded() = true, want false")
+		}
+	})
+}
+
 // --- GetProject ---
 
 func TestProjectService_GetProject(t *testing.T) {
@@ -131,6 +292,24 @@ func TestProjectService_GetProject(t *testing.T) {
 		}
 	})
 
+	t.Run("normalizes nil todos to empty slice", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(nil, nil)
+
+		got, err := svc.GetProject(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("GetProject() error = %v, want nil", err)
+		}
+		if got.Todos == nil {
+			t.Error("GetProject().Todos = nil, want empty slice")
+		}
+	})
+
 	t.Run("returns error when project not found", func(t *testing.T) {
 		t.Parallel()
 		mockClient := mocks.NewMockTodoClient(t)
@@ -158,6 +337,47 @@ func TestProjectService_GetProject(t *testing.T) {
 			t.Errorf("GetProject() error = %v, want ErrUnavailable", err)
 		}
 	})
+
+	t.Run("partial reads return project when todos fail", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithPartialProjectReads(true))
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(nil, domain.ErrUnavailable)
+
+		ctx := ctxWithRC()
+		got, err := svc.GetProject(ctx, 1)
+		if err != nil {
+			t.Fatalf("GetProject() error = %v, want nil", err)
+		}
+		if got.ID != 1 {
+			t.Errorf("GetProject().ID = %d, want 1", got.ID)
+		}
+		if got.Todos != nil {
+			t.Errorf("GetProject().Todos = %v, want nil", got.Todos)
+		}
+		if !errors.Is(got.TodosLoadError, domain.ErrUnavailable) {
+			t.Errorf("GetProject().TodosLoadError = %v, want ErrUnavailable", got.TodosLoadError)
+		}
+		if !appctx.IsDegraded(ctx) {
+			t.Error("IsDegraded() = false, want true")
+		}
+	})
+
+	t.Run("partial reads still fail when project fetch fails", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithPartialProjectReads(true))
+
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(nil, domain.ErrUnavailable)
+
+		_, err := svc.GetProject(context.Background(), 1)
+		if !errors.Is(err, domain.ErrUnavailable) {
+			t.Errorf("GetProject() error = %v, want ErrUnavailable", err)
+		}
+	})
 }
 
 // --- CreateProject ---
@@ -247,6 +467,21 @@ func TestProjectService_UpdateProject(t *testing.T) {
 		}
 	})
 
+	t.Run("partial update leaves empty fields unchecked", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		input := &project.Project{Name: "Renamed"}
+		updated := &project.Project{ID: 1, Name: "Renamed", Description: "Existing desc"}
+
+		mockClient.EXPECT().UpdateProject(mock.Anything, int64(1), input).Return(updated, nil)
+
+		if _, err := svc.UpdateProject(context.Background(), 1, input); err != nil {
+			t.Fatalf("UpdateProject() error = %v, want nil", err)
+		}
+	})
+
 	t.Run("returns validation error for nil project", func(t *testing.T) {
 		t.Parallel()
 		mockClient := mocks.NewMockTodoClient(t)
@@ -263,7 +498,7 @@ func TestProjectService_UpdateProject(t *testing.T) {
 		mockClient := mocks.NewMockTodoClient(t)
 		svc := NewProjectService(mockClient, discardLogger())
 
-		invalid := &project.Project{Name: "", Description: ""}
+		invalid := &project.Project{Name: "  ", Description: ""}
 
 		_, err := svc.UpdateProject(context.Background(), 1, invalid)
 		if !errors.Is(err, domain.ErrValidation) {
@@ -286,6 +521,88 @@ func TestProjectService_UpdateProject(t *testing.T) {
 	})
 }
 
+// --- ArchiveProject / UnarchiveProject ---
+
+func TestProjectService_ArchiveProject(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sets the archived flag via update", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		stored := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&stored, nil)
+		mockClient.EXPECT().UpdateProject(mock.Anything, int64(1), mock.MatchedBy(func(p *project.Project) bool {
+			return p.Archived && p.Name == stored.Name && p.Description == stored.Description
+		})).RunAndReturn(func(_ context.Context, _ int64, p *project.Project) (*project.Project, error) {
+			return p, nil
+		})
+
+		got, err := svc.ArchiveProject(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("ArchiveProject() error = %v", err)
+		}
+		if !got.Archived {
+			t.Error("Archived = false, want true")
+		}
+	})
+
+	t.Run("already archived skips the downstream write", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		stored := validProject()
+		stored.Archived = true
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&stored, nil)
+
+		got, err := svc.ArchiveProject(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("ArchiveProject() error = %v", err)
+		}
+		if !got.Archived {
+			t.Error("Archived = false, want true")
+		}
+	})
+
+	t.Run("returns not found", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		mockClient.EXPECT().GetProject(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
+
+		_, err := svc.ArchiveProject(context.Background(), 99)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("ArchiveProject() error = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestProjectService_UnarchiveProject(t *testing.T) {
+	t.Parallel()
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger())
+
+	stored := validProject()
+	stored.Archived = true
+	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&stored, nil)
+	mockClient.EXPECT().UpdateProject(mock.Anything, int64(1), mock.MatchedBy(func(p *project.Project) bool {
+		return !p.Archived
+	})).RunAndReturn(func(_ context.Context, _ int64, p *project.Project) (*project.Project, error) {
+		return p, nil
+	})
+
+	got, err := svc.UnarchiveProject(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("UnarchiveProject() error = %v", err)
+	}
+	if got.Archived {
+		t.Error("Archived = true, want false")
+	}
+}
+
 // --- DeleteProject ---
 
 func TestProjectService_DeleteProject(t *testing.T) {
@@ -566,106 +883,268 @@ func TestProjectService_UpdateTodo_Ownership(t *testing.T) {
 	})
 }
 
-// --- RemoveTodo ---
+// --- PatchProject / PatchTodo ---
 
-func TestProjectService_RemoveTodo(t *testing.T) {
+func TestProjectService_PatchProject(t *testing.T) {
 	t.Parallel()
 
-	t.Run("removes todo from project", func(t *testing.T) {
+	t.Run("merges onto stored project without loading todos", func(t *testing.T) {
 		t.Parallel()
 		mockClient := mocks.NewMockTodoClient(t)
 		svc := NewProjectService(mockClient, discardLogger())
 
-		proj := validProject()
-		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		stored := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&stored, nil)
+		mockClient.EXPECT().UpdateProject(mock.Anything, int64(1), mock.MatchedBy(func(p *project.Project) bool {
+			return p.Name == "Renamed" && p.Description == stored.Description
+		})).RunAndReturn(func(_ context.Context, _ int64, p *project.Project) (*project.Project, error) {
+			return p, nil
+		})
+
+		got, err := svc.PatchProject(context.Background(), 1, func(p *project.Project) *project.Project {
+			p.Name = "Renamed"
+			return p
+		})
+		if err != nil {
+			t.Fatalf("PatchProject() error = %v", err)
+		}
+		if got.Name != "Renamed" {
+			t.Errorf("Name = %q, want %q", got.Name, "Renamed")
+		}
+		if stored.Name == "Renamed" {
+			t.Error("PatchProject() modified the stored project in place")
+		}
+	})
 
-		existing := validTodo()
-		existing.ID = 10
-		existing.ProjectID = int64Ptr(1)
-		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
-		mockClient.EXPECT().DeleteTodo(mock.Anything, int64(10)).Return(nil)
+	t.Run("unchanged project skips the downstream write", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
 
-		err := svc.RemoveTodo(context.Background(), 1, 10)
+		stored := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&stored, nil)
+
+		got, err := svc.PatchProject(context.Background(), 1, func(p *project.Project) *project.Project { return p })
 		if err != nil {
-			t.Errorf("RemoveTodo() error = %v, want nil", err)
+			t.Fatalf("PatchProject() error = %v", err)
+		}
+		if got.ID != stored.ID || !got.SameContent(&stored) {
+			t.Errorf("PatchProject() = %+v, want stored project %+v", got, stored)
 		}
 	})
 
-	t.Run("returns error when project not found", func(t *testing.T) {
+	t.Run("returns not found without calling patch", func(t *testing.T) {
 		t.Parallel()
 		mockClient := mocks.NewMockTodoClient(t)
 		svc := NewProjectService(mockClient, discardLogger())
-
 		mockClient.EXPECT().GetProject(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
 
-		err := svc.RemoveTodo(context.Background(), 99, 10)
+		_, err := svc.PatchProject(context.Background(), 99, func(*project.Project) *project.Project {
+			t.Error("patch called for missing project")
+			return nil
+		})
 		if !errors.Is(err, domain.ErrNotFound) {
-			t.Errorf("RemoveTodo() error = %v, want ErrNotFound", err)
+			t.Errorf("PatchProject() error = %v, want ErrNotFound", err)
 		}
 	})
+}
 
-	t.Run("returns error when fetching todo fails", func(t *testing.T) {
+func TestProjectService_PatchTodo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("merges onto the single stored todo", func(t *testing.T) {
 		t.Parallel()
 		mockClient := mocks.NewMockTodoClient(t)
 		svc := NewProjectService(mockClient, discardLogger())
 
 		proj := validProject()
+		stored := validTodo()
+		stored.ID = 42
+		stored.ProjectID = int64Ptr(1)
 		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
-		mockClient.EXPECT().GetTodo(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
-
-		err := svc.RemoveTodo(context.Background(), 1, 99)
-		if !errors.Is(err, domain.ErrNotFound) {
-			t.Errorf("RemoveTodo() error = %v, want ErrNotFound", err)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(42)).Return(&stored, nil)
+		mockClient.EXPECT().UpdateTodo(mock.Anything, int64(42), mock.MatchedBy(func(td *todo.Todo) bool {
+			return td.Title == "Patched" && td.Description == stored.Description
+		})).RunAndReturn(func(_ context.Context, _ int64, td *todo.Todo) (*todo.Todo, error) {
+			return td, nil
+		})
+
+		got, err := svc.PatchTodo(context.Background(), 1, 42, func(td *todo.Todo) *todo.Todo {
+			td.Title = "Patched"
+			return td
+		})
+		if err != nil {
+			t.Fatalf("PatchTodo() error = %v", err)
+		}
+		if got.Title != "Patched" {
+			t.Errorf("Title = %q, want %q", got.Title, "Patched")
+		}
+		if stored.Title == "Patched" {
+			t.Error("PatchTodo() modified the stored todo in place")
 		}
 	})
 
-	t.Run("returns error when todo belongs to different project", func(t *testing.T) {
+	t.Run("unchanged todo skips the downstream write", func(t *testing.T) {
 		t.Parallel()
 		mockClient := mocks.NewMockTodoClient(t)
 		svc := NewProjectService(mockClient, discardLogger())
 
 		proj := validProject()
+		stored := validTodo()
+		stored.ID = 42
+		stored.ProjectID = int64Ptr(1)
 		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(42)).Return(&stored, nil)
 
-		existing := validTodo()
-		existing.ID = 10
-		existing.ProjectID = int64Ptr(999) // different project
-		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
-
-		err := svc.RemoveTodo(context.Background(), 1, 10)
-		if !errors.Is(err, domain.ErrNotFound) {
-			t.Errorf("RemoveTodo() error = %v, want ErrNotFound", err)
+		got, err := svc.PatchTodo(context.Background(), 1, 42, func(td *todo.Todo) *todo.Todo { return td })
+		if err != nil {
+			t.Fatalf("PatchTodo() error = %v", err)
+		}
+		if got.ID != 42 || !got.SameContent(&stored) {
+			t.Errorf("PatchTodo() = %+v, want stored todo %+v", got, stored)
 		}
 	})
 
-	t.Run("returns error when todo has nil ProjectID", func(t *testing.T) {
+	t.Run("validates the merged todo", func(t *testing.T) {
 		t.Parallel()
 		mockClient := mocks.NewMockTodoClient(t)
 		svc := NewProjectService(mockClient, discardLogger())
 
 		proj := validProject()
+		stored := validTodo()
+		stored.ID = 42
+		stored.ProjectID = int64Ptr(1)
 		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(42)).Return(&stored, nil)
 
-		existing := validTodo()
-		existing.ID = 10
-		existing.ProjectID = nil // ungrouped todo
-		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
-
-		err := svc.RemoveTodo(context.Background(), 1, 10)
-		if !errors.Is(err, domain.ErrNotFound) {
-			t.Errorf("RemoveTodo() error = %v, want ErrNotFound", err)
+		_, err := svc.PatchTodo(context.Background(), 1, 42, func(td *todo.Todo) *todo.Todo {
+			td.Title = ""
+			return td
+		})
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("PatchTodo() error = %v, want ErrValidation", err)
 		}
 	})
 
-	t.Run("returns error when delete fails", func(t *testing.T) {
+	t.Run("returns not found for todo in another project", func(t *testing.T) {
 		t.Parallel()
 		mockClient := mocks.NewMockTodoClient(t)
 		svc := NewProjectService(mockClient, discardLogger())
 
 		proj := validProject()
+		other := validTodo()
+		other.ID = 42
+		other.ProjectID = int64Ptr(2)
 		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(42)).Return(&other, nil)
 
-		existing := validTodo()
+		_, err := svc.PatchTodo(context.Background(), 1, 42, func(td *todo.Todo) *todo.Todo { return td })
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("PatchTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+// --- RemoveTodo ---
+
+func TestProjectService_RemoveTodo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("removes todo from project", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+		existing := validTodo()
+		existing.ID = 10
+		existing.ProjectID = int64Ptr(1)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
+		mockClient.EXPECT().DeleteTodo(mock.Anything, int64(10)).Return(nil)
+
+		err := svc.RemoveTodo(context.Background(), 1, 10)
+		if err != nil {
+			t.Errorf("RemoveTodo() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("returns error when project not found", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		mockClient.EXPECT().GetProject(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
+
+		err := svc.RemoveTodo(context.Background(), 99, 10)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("RemoveTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("returns error when fetching todo fails", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
+
+		err := svc.RemoveTodo(context.Background(), 1, 99)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("RemoveTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("returns error when todo belongs to different project", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+		existing := validTodo()
+		existing.ID = 10
+		existing.ProjectID = int64Ptr(999) // different project
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
+
+		err := svc.RemoveTodo(context.Background(), 1, 10)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("RemoveTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("returns error when todo has nil ProjectID", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+		existing := validTodo()
+		existing.ID = 10
+		existing.ProjectID = nil // ungrouped todo
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
+
+		err := svc.RemoveTodo(context.Background(), 1, 10)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("RemoveTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("returns error when delete fails", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+		existing := validTodo()
 		existing.ID = 10
 		existing.ProjectID = int64Ptr(1)
 		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
@@ -799,6 +1278,212 @@ func TestProjectService_UpdateTodo_MemoizesProjectVerification(t *testing.T) {
 	}
 }
 
+func TestProjectService_MaxTodosPerProject(t *testing.T) {
+	t.Parallel()
+
+	const limit = 2
+	todosOf := func(n int) []todo.Todo {
+		todos := make([]todo.Todo, n)
+		for i := range todos {
+			todos[i] = validTodo()
+			todos[i].ID = int64(i + 1)
+		}
+		return todos
+	}
+
+	t.Run("adds below the limit", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithMaxTodosPerProject(limit))
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(todosOf(limit-1), nil)
+
+		td := validTodo()
+		created := validTodo()
+		created.ID = 42
+		mockClient.EXPECT().CreateTodo(mock.Anything, &td).Return(&created, nil)
+
+		if _, err := svc.AddTodo(context.Background(), 1, &td); err != nil {
+			t.Fatalf("AddTodo() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("rejects at the limit", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithMaxTodosPerProject(limit))
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(todosOf(limit), nil)
+
+		td := validTodo()
+		_, err := svc.AddTodo(context.Background(), 1, &td)
+		if !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("AddTodo() error = %v, want ErrConflict", err)
+		}
+	})
+
+	t.Run("rejects over the limit", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithMaxTodosPerProject(limit))
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(todosOf(limit+3), nil)
+
+		td := validTodo()
+		_, err := svc.AddTodo(context.Background(), 1, &td)
+		if !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("AddTodo() error = %v, want ErrConflict", err)
+		}
+	})
+
+	t.Run("rejects moving into a full project", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithMaxTodosPerProject(limit))
+
+		from, to := validProject(), validProject()
+		from.ID, to.ID = 1, 2
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&from, nil)
+		mockClient.EXPECT().GetProject(mock.Anything, int64(2)).Return(&to, nil)
+
+		existing := validTodo()
+		existing.ID = 10
+		existing.ProjectID = int64Ptr(1)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
+		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(2), todo.Filter{}).Return(todosOf(limit), nil)
+
+		_, err := svc.MoveTodo(context.Background(), 1, 2, 10)
+		if !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("MoveTodo() error = %v, want ErrConflict", err)
+		}
+	})
+
+	t.Run("reuses todos loaded earlier in the request", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithMaxTodosPerProject(limit))
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil).Once()
+		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(todosOf(limit), nil).Once()
+
+		ctx := ctxWithRC()
+		if _, err := svc.GetProject(ctx, 1); err != nil {
+			t.Fatalf("GetProject() error = %v, want nil", err)
+		}
+		td := validTodo()
+		if _, err := svc.AddTodo(ctx, 1, &td); !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("AddTodo() error = %v, want ErrConflict", err)
+		}
+	})
+}
+
+func TestProjectService_MoveTodo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("moves todo to target project", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		from, to := validProject(), validProject()
+		from.ID, to.ID = 1, 2
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&from, nil)
+		mockClient.EXPECT().GetProject(mock.Anything, int64(2)).Return(&to, nil)
+
+		existing := validTodo()
+		existing.ID = 10
+		existing.ProjectID = int64Ptr(1)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
+
+		moved := existing
+		moved.ProjectID = int64Ptr(2)
+		mockClient.EXPECT().UpdateTodo(mock.Anything, int64(10), mock.MatchedBy(func(td *todo.Todo) bool {
+			return td.ProjectID != nil && *td.ProjectID == 2 && td.Title == existing.Title
+		})).Return(&moved, nil)
+
+		got, err := svc.MoveTodo(context.Background(), 1, 2, 10)
+		if err != nil {
+			t.Fatalf("MoveTodo() error = %v", err)
+		}
+		if got.ProjectID == nil || *got.ProjectID != 2 {
+			t.Errorf("ProjectID = %v, want 2", got.ProjectID)
+		}
+	})
+
+	t.Run("returns not found when todo is in another project", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		from, to := validProject(), validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&from, nil)
+		mockClient.EXPECT().GetProject(mock.Anything, int64(2)).Return(&to, nil)
+
+		existing := validTodo()
+		existing.ID = 10
+		existing.ProjectID = int64Ptr(3)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
+
+		_, err := svc.MoveTodo(context.Background(), 1, 2, 10)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("MoveTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("returns not found when target project is missing", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		from := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&from, nil)
+		mockClient.EXPECT().GetProject(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
+
+		_, err := svc.MoveTodo(context.Background(), 1, 99, 10)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("MoveTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("rejects same source and target", func(t *testing.T) {
+		t.Parallel()
+		svc := NewProjectService(mocks.NewMockTodoClient(t), discardLogger())
+
+		_, err := svc.MoveTodo(context.Background(), 1, 1, 10)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("MoveTodo() error = %v, want ErrValidation", err)
+		}
+	})
+
+	t.Run("returns error when update fails", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+
+		from, to := validProject(), validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&from, nil)
+		mockClient.EXPECT().GetProject(mock.Anything, int64(2)).Return(&to, nil)
+
+		existing := validTodo()
+		existing.ID = 10
+		existing.ProjectID = int64Ptr(1)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(10)).Return(&existing, nil)
+		mockClient.EXPECT().UpdateTodo(mock.Anything, int64(10), mock.Anything).Return(nil, domain.ErrUnavailable)
+
+		_, err := svc.MoveTodo(context.Background(), 1, 2, 10)
+		if !errors.Is(err, domain.ErrUnavailable) {
+			t.Errorf("MoveTodo() error = %v, want ErrUnavailable", err)
+		}
+	})
+}
+
 func TestProjectService_RemoveTodo_MemoizesProjectVerification(t *testing.T) {
 	t.Parallel()
 	mockClient := mocks.NewMockTodoClient(t)
@@ -836,6 +1521,266 @@ func TestProjectService_RemoveTodo_MemoizesProjectVerification(t *testing.T) {
 // secondTodoDesc is used across tests for the second todo item.
 const secondTodoDesc = "Second desc"
 
+// --- Dry run ---
+
+func TestProjectService_DryRun_UsesClockForTimestamps(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	clock := domaintest.NewFakeClock(now)
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger(), WithClock(clock))
+	ctx := appctx.WithDryRun(context.Background())
+
+	proj := validProject()
+	owned := validTodo()
+	owned.ID = 42
+	owned.ProjectID = int64Ptr(1)
+	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+	mockClient.EXPECT().GetTodo(mock.Anything, int64(42)).Return(&owned, nil)
+
+	td := validTodo()
+	td.ID = 0
+	added, err := svc.AddTodo(ctx, 1, &td)
+	if err != nil {
+		t.Fatalf("AddTodo() error = %v", err)
+	}
+	if !added.CreatedAt.Equal(now) || !added.UpdatedAt.Equal(now) {
+		t.Errorf("AddTodo() timestamps = (%v, %v), want %v", added.CreatedAt, added.UpdatedAt, now)
+	}
+
+	clock.Advance(time.Hour)
+
+	upd := validTodo()
+	updated, err := svc.UpdateTodo(ctx, 1, 42, &upd)
+	if err != nil {
+		t.Fatalf("UpdateTodo() error = %v", err)
+	}
+	if want := now.Add(time.Hour); !updated.UpdatedAt.Equal(want) {
+		t.Errorf("UpdateTodo() UpdatedAt = %v, want %v", updated.UpdatedAt, want)
+	}
+}
+
+// --- Entity metrics ---
+
+// fakeEntityMetrics is a ports.EntityMetrics that sums recorded operations
+// keyed by "entity/operation".
+type fakeEntityMetrics struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newFakeEntityMetrics() *fakeEntityMetrics {
+	return &fakeEntityMetrics{counts: make(map[string]int)}
+}
+
+func (f *fakeEntityMetrics) RecordEntityOperation(_ context.Context, entity, operation string, n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.counts[entity+"/"+operation] += n
+}
+
+func (f *fakeEntityMetrics) snapshot() map[string]int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return maps.Clone(f.counts)
+}
+
+func TestProjectService_RecordsEntityMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("todo create", func(t *testing.T) {
+		t.Parallel()
+		metrics := newFakeEntityMetrics()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithMetrics(metrics))
+
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		created := validTodo()
+		mockClient.EXPECT().CreateTodo(mock.Anything, mock.Anything).Return(&created, nil)
+
+		td := validTodo()
+		if _, err := svc.AddTodo(context.Background(), 1, &td); err != nil {
+			t.Fatalf("AddTodo() error = %v", err)
+		}
+
+		counts := metrics.snapshot()
+		if got := counts["todo/create"]; got != 1 {
+			t.Errorf(`entity.operation.total{entity="todo",operation="create"} = %d, want 1`, got)
+		}
+		if len(counts) != 1 {
+			t.Errorf("recorded %v, want only todo/create", counts)
+		}
+	})
+
+	t.Run("failed mutation is not counted", func(t *testing.T) {
+		t.Parallel()
+		metrics := newFakeEntityMetrics()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithMetrics(metrics))
+
+		mockClient.EXPECT().DeleteProject(mock.Anything, int64(1)).Return(domain.ErrUnavailable)
+
+		if err := svc.DeleteProject(context.Background(), 1); err == nil {
+			t.Fatal("DeleteProject() error = nil, want error")
+		}
+
+		if counts := metrics.snapshot(); len(counts) != 0 {
+			t.Errorf("recorded %v, want nothing", counts)
+		}
+	})
+
+	t.Run("nil metrics is safe", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger(), WithMetrics(nil))
+
+		created := validProject()
+		mockClient.EXPECT().CreateProject(mock.Anything, mock.Anything).Return(&created, nil)
+
+		p := validProject()
+		if _, err := svc.CreateProject(context.Background(), &p); err != nil {
+			t.Fatalf("CreateProject() error = %v", err)
+		}
+	})
+}
+
+func TestProjectService_DryRun_MakesNoWrites(t *testing.T) {
+	t.Parallel()
+
+	// Only the read-only checks are expected; any write call fails the test.
+	newDryRun := func(t *testing.T) (*ProjectService, context.Context) {
+		t.Helper()
+		mockClient := mocks.NewMockTodoClient(t)
+		proj := validProject()
+		owned := validTodo()
+		owned.ID = 42
+		owned.ProjectID = int64Ptr(1)
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil).Maybe()
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(42)).Return(&owned, nil).Maybe()
+		svc := NewProjectService(mockClient, discardLogger())
+		return svc, appctx.WithDryRun(context.Background())
+	}
+
+	t.Run("AddTodo", func(t *testing.T) {
+		t.Parallel()
+		svc, ctx := newDryRun(t)
+
+		td := validTodo()
+		td.ID = 0
+		got, err := svc.AddTodo(ctx, 1, &td)
+		if err != nil {
+			t.Fatalf("AddTodo() error = %v", err)
+		}
+		if got.ProjectID == nil || *got.ProjectID != 1 {
+			t.Errorf("ProjectID = %v, want 1", got.ProjectID)
+		}
+	})
+
+	t.Run("AddTodo validation still applies", func(t *testing.T) {
+		t.Parallel()
+		svc, ctx := newDryRun(t)
+
+		_, err := svc.AddTodo(ctx, 1, &todo.Todo{})
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("AddTodo() error = %v, want ErrValidation", err)
+		}
+	})
+
+	t.Run("UpdateTodo", func(t *testing.T) {
+		t.Parallel()
+		svc, ctx := newDryRun(t)
+
+		td := validTodo()
+		got, err := svc.UpdateTodo(ctx, 1, 42, &td)
+		if err != nil {
+			t.Fatalf("UpdateTodo() error = %v", err)
+		}
+		if got.ID != 42 {
+			t.Errorf("ID = %d, want 42", got.ID)
+		}
+		if got.ProjectID == nil || *got.ProjectID != 1 {
+			t.Errorf("ProjectID = %v, want 1", got.ProjectID)
+		}
+	})
+
+	t.Run("PatchTodo", func(t *testing.T) {
+		t.Parallel()
+		svc, ctx := newDryRun(t)
+
+		got, err := svc.PatchTodo(ctx, 1, 42, func(td *todo.Todo) *todo.Todo {
+			td.Title = "Patched"
+			return td
+		})
+		if err != nil {
+			t.Fatalf("PatchTodo() error = %v", err)
+		}
+		if got.ID != 42 || got.Title != "Patched" {
+			t.Errorf("got ID %d title %q, want 42 %q", got.ID, got.Title, "Patched")
+		}
+	})
+
+	t.Run("RemoveTodo", func(t *testing.T) {
+		t.Parallel()
+		svc, ctx := newDryRun(t)
+
+		if err := svc.RemoveTodo(ctx, 1, 42); err != nil {
+			t.Fatalf("RemoveTodo() error = %v", err)
+		}
+	})
+}
+
+func TestProjectService_DryRun_RunsReadOnlyChecks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("AddTodo missing project", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+		mockClient.EXPECT().GetProject(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
+
+		td := validTodo()
+		td.ID = 0
+		_, err := svc.AddTodo(appctx.WithDryRun(context.Background()), 99, &td)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("AddTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("UpdateTodo in another project", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+		proj := validProject()
+		other := validTodo()
+		other.ID = 42
+		other.ProjectID = int64Ptr(2)
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(42)).Return(&other, nil)
+
+		td := validTodo()
+		_, err := svc.UpdateTodo(appctx.WithDryRun(context.Background()), 1, 42, &td)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("UpdateTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("RemoveTodo missing todo", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewProjectService(mockClient, discardLogger())
+		proj := validProject()
+		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(42)).Return(nil, domain.ErrNotFound)
+
+		err := svc.RemoveTodo(appctx.WithDryRun(context.Background()), 1, 42)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("RemoveTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+}
+
 // --- BulkUpdateTodos ---
 
 func TestProjectService_BulkUpdateTodos_Success(t *testing.T) {
@@ -846,11 +1791,11 @@ func TestProjectService_BulkUpdateTodos_Success(t *testing.T) {
 	proj := validProject()
 	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
 
-	projectTodos := []todo.Todo{
-		{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork},
-		{ID: 11, Title: "B", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork},
+	existing := []todo.Todo{
+		{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
+		{ID: 11, Title: "B", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
 	}
-	mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(projectTodos, nil)
+	mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{10, 11}).Return(existing, nil)
 
 	td1 := validTodo()
 	updated1 := validTodo()
@@ -883,7 +1828,57 @@ func TestProjectService_BulkUpdateTodos_Success(t *testing.T) {
 	}
 }
 
-func TestProjectService_BulkUpdateTodos_PartialFailure(t *testing.T) {
+func TestProjectService_BulkUpdateTodos_RollsBackOnFailure(t *testing.T) {
+	t.Parallel()
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger())
+
+	proj := validProject()
+	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+	first := todo.Todo{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)}
+	second := todo.Todo{ID: 11, Title: "B", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)}
+	mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{10, 11}).Return([]todo.Todo{first, second}, nil)
+
+	td1 := validTodo()
+	updated1 := validTodo()
+	updated1.ID = 10
+	updated1.ProjectID = int64Ptr(1)
+
+	td2 := validTodo()
+	td2.Title = "Second"
+	td2.Description = secondTodoDesc
+
+	// Hold the failing update until the first one has completed so the
+	// rollback path is deterministic.
+	firstDone := make(chan struct{})
+	mockClient.EXPECT().UpdateTodo(mock.Anything, int64(10), &td1).
+		Run(func(context.Context, int64, *todo.Todo) { close(firstDone) }).
+		Return(&updated1, nil).Once()
+	mockClient.EXPECT().UpdateTodo(mock.Anything, int64(11), &td2).
+		RunAndReturn(func(context.Context, int64, *todo.Todo) (*todo.Todo, error) {
+			<-firstDone
+			return nil, domain.ErrUnavailable
+		}).Once()
+
+	// The completed update is undone by writing back the stored todo.
+	mockClient.EXPECT().UpdateTodo(mock.Anything, int64(10), &first).Return(&first, nil).Once()
+
+	updates := []ports.TodoUpdate{
+		{TodoID: 10, Todo: &td1},
+		{TodoID: 11, Todo: &td2},
+	}
+
+	result, err := svc.BulkUpdateTodos(context.Background(), 1, updates)
+	if !errors.Is(err, domain.ErrUnavailable) {
+		t.Errorf("BulkUpdateTodos() error = %v, want ErrUnavailable", err)
+	}
+	if result != nil {
+		t.Errorf("BulkUpdateTodos() result = %+v, want nil", result)
+	}
+}
+
+func TestProjectService_BulkUpdateTodosEach_PartialFailure(t *testing.T) {
 	t.Parallel()
 	mockClient := mocks.NewMockTodoClient(t)
 	svc := NewProjectService(mockClient, discardLogger())
@@ -891,11 +1886,11 @@ func TestProjectService_BulkUpdateTodos_PartialFailure(t *testing.T) {
 	proj := validProject()
 	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
 
-	projectTodos := []todo.Todo{
-		{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork},
-		{ID: 11, Title: "B", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork},
+	existing := []todo.Todo{
+		{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
+		{ID: 11, Title: "B", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
 	}
-	mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(projectTodos, nil)
+	mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{10, 11}).Return(existing, nil)
 
 	td1 := validTodo()
 	updated1 := validTodo()
@@ -913,9 +1908,9 @@ func TestProjectService_BulkUpdateTodos_PartialFailure(t *testing.T) {
 		{TodoID: 11, Todo: &td2},
 	}
 
-	result, err := svc.BulkUpdateTodos(context.Background(), 1, updates)
+	result, err := svc.BulkUpdateTodosEach(context.Background(), 1, updates)
 	if err != nil {
-		t.Fatalf("BulkUpdateTodos() error = %v, want nil", err)
+		t.Fatalf("BulkUpdateTodosEach() error = %v, want nil", err)
 	}
 	if len(result.Updated) != 1 {
 		t.Errorf("Updated count = %d, want 1", len(result.Updated))
@@ -993,6 +1988,159 @@ func TestProjectService_BulkUpdateTodos_Validation(t *testing.T) {
 	})
 }
 
+func TestProjectService_BulkRemoveTodos_Success(t *testing.T) {
+	t.Parallel()
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger())
+
+	proj := validProject()
+	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+	existing := []todo.Todo{
+		{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
+		{ID: 11, Title: "B", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
+	}
+	mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{10, 11}).Return(existing, nil)
+	mockClient.EXPECT().DeleteTodo(mock.Anything, int64(10)).Return(nil)
+	mockClient.EXPECT().DeleteTodo(mock.Anything, int64(11)).Return(nil)
+
+	if err := svc.BulkRemoveTodos(context.Background(), 1, []int64{10, 11}); err != nil {
+		t.Fatalf("BulkRemoveTodos() error = %v, want nil", err)
+	}
+}
+
+func TestProjectService_BulkRemoveTodos_RollsBackOnFailure(t *testing.T) {
+	t.Parallel()
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger())
+
+	proj := validProject()
+	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+	first := todo.Todo{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)}
+	second := todo.Todo{ID: 11, Title: "B", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)}
+	mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{10, 11}).Return([]todo.Todo{first, second}, nil)
+
+	// Hold the failing delete until the first one has completed so the
+	// rollback path is deterministic.
+	firstDone := make(chan struct{})
+	mockClient.EXPECT().DeleteTodo(mock.Anything, int64(10)).
+		Run(func(context.Context, int64) { close(firstDone) }).
+		Return(nil)
+	mockClient.EXPECT().DeleteTodo(mock.Anything, int64(11)).
+		RunAndReturn(func(context.Context, int64) error {
+			<-firstDone
+			return domain.ErrUnavailable
+		})
+
+	recreated := first
+	recreated.ID = 20
+	mockClient.EXPECT().CreateTodo(mock.Anything, &first).Return(&recreated, nil).Once()
+
+	err := svc.BulkRemoveTodos(context.Background(), 1, []int64{10, 11})
+	if !errors.Is(err, domain.ErrUnavailable) {
+		t.Errorf("BulkRemoveTodos() error = %v, want ErrUnavailable", err)
+	}
+}
+
+func TestProjectService_BulkRemoveTodosEach_ReportsEachItem(t *testing.T) {
+	t.Parallel()
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger())
+
+	proj := validProject()
+	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+	existing := []todo.Todo{
+		{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
+		{ID: 11, Title: "B", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
+	}
+	mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{10, 11}).Return(existing, nil)
+	mockClient.EXPECT().DeleteTodo(mock.Anything, int64(10)).Return(nil)
+	mockClient.EXPECT().DeleteTodo(mock.Anything, int64(11)).Return(domain.ErrUnavailable)
+
+	// No CreateTodo expectation: the completed delete is not rolled back.
+	result, err := svc.BulkRemoveTodosEach(context.Background(), 1, []int64{10, 11})
+	if err != nil {
+		t.Fatalf("BulkRemoveTodosEach() error = %v, want nil", err)
+	}
+	if len(result.Removed) != 1 || result.Removed[0] != 10 {
+		t.Errorf("Removed = %v, want [10]", result.Removed)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].TodoID != 11 || !errors.Is(result.Errors[0].Err, domain.ErrUnavailable) {
+		t.Errorf("Errors = %v, want todo 11 ErrUnavailable", result.Errors)
+	}
+}
+
+func TestProjectService_BulkRemoveTodosEach_TodoNotInProject(t *testing.T) {
+	t.Parallel()
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger())
+
+	proj := validProject()
+	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+	mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{10}).Return([]todo.Todo{
+		{ID: 10, Title: "A", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(2)},
+	}, nil)
+
+	_, err := svc.BulkRemoveTodosEach(context.Background(), 1, []int64{10})
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("BulkRemoveTodosEach() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestProjectService_BulkRemoveTodos_Validation(t *testing.T) {
+	t.Parallel()
+
+	tooMany := make([]int64, maxBulkUpdateSize+1)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 1)
+	}
+
+	tests := []struct {
+		name string
+		ids  []int64
+	}{
+		{"empty", nil},
+		{"exceeds max batch size", tooMany},
+		{"non-positive ID", []int64{0}},
+		{"duplicate IDs", []int64{10, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockClient := mocks.NewMockTodoClient(t)
+			svc := NewProjectService(mockClient, discardLogger())
+
+			err := svc.BulkRemoveTodos(context.Background(), 1, tt.ids)
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Errorf("BulkRemoveTodos() error = %v, want ErrValidation", err)
+			}
+		})
+	}
+}
+
+func TestProjectService_BulkRemoveTodos_TodoNotInProject(t *testing.T) {
+	t.Parallel()
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger())
+
+	proj := validProject()
+	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
+
+	existing := []todo.Todo{
+		{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(1)},
+		{ID: 11, Title: "B", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(2)},
+	}
+	mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{10, 11}).Return(existing, nil)
+
+	err := svc.BulkRemoveTodos(context.Background(), 1, []int64{10, 11})
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("BulkRemoveTodos() error = %v, want ErrNotFound", err)
+	}
+}
+
 func TestProjectService_BulkUpdateTodos_Errors(t *testing.T) {
 	t.Parallel()
 
@@ -1014,14 +2162,14 @@ func TestProjectService_BulkUpdateTodos_Errors(t *testing.T) {
 		}
 	})
 
-	t.Run("GetProjectTodos fails", func(t *testing.T) {
+	t.Run("GetTodosByIDs fails", func(t *testing.T) {
 		t.Parallel()
 		mockClient := mocks.NewMockTodoClient(t)
 		svc := NewProjectService(mockClient, discardLogger())
 
 		proj := validProject()
 		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
-		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(nil, domain.ErrUnavailable)
+		mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{10}).Return(nil, domain.ErrUnavailable)
 
 		td := validTodo()
 		updates := []ports.TodoUpdate{
@@ -1042,14 +2190,14 @@ func TestProjectService_BulkUpdateTodos_Errors(t *testing.T) {
 		proj := validProject()
 		mockClient.EXPECT().GetProject(mock.Anything, int64(1)).Return(&proj, nil)
 
-		projectTodos := []todo.Todo{
-			{ID: 10, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork},
+		existing := []todo.Todo{
+			{ID: 999, Title: "A", Description: "D", Status: todo.StatusPending, Category: todo.CategoryWork, ProjectID: int64Ptr(2)},
 		}
-		mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(projectTodos, nil)
+		mockClient.EXPECT().GetTodosByIDs(mock.Anything, []int64{999}).Return(existing, nil)
 
 		td := validTodo()
 		updates := []ports.TodoUpdate{
-			{TodoID: 999, Todo: &td}, // not in project
+			{TodoID: 999, Todo: &td}, // belongs to another project
 		}
 
 		_, err := svc.BulkUpdateTodos(context.Background(), 1, updates)
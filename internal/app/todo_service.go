@@ -0,0 +1,263 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
+)
+
+// Compile-time check that TodoService implements ports.TodoService.
+var _ ports.TodoService = (*TodoService)(nil)
+
+// TodoService implements ports.TodoService for todo operations that are not
+// scoped to a project. Like ProjectService it validates input, logs failures,
+// and memoizes reads in the RequestContext, delegating persistence to the
+// TodoClient port.
+type TodoService struct {
+	todoClient  ports.TodoClient
+	logger      *slog.Logger
+	metrics     ports.EntityMetrics // nil disables entity metrics
+	defaultSort string              // for ListTodos when the filter has none
+	owners      *ProjectService     // checks project ownership; nil when not enforced
+}
+
+// TodoOption configures a TodoService.
+type TodoOption func(*TodoService)
+
+// WithTodoMetrics reports each successful create, update, and delete to
+// metrics. A nil metrics disables recording.
+func WithTodoMetrics(metrics ports.EntityMetrics) TodoOption {
+	return func(s *TodoService) {
+		s.metrics = metrics
+	}
+}
+
+// WithDefaultTodoSort sets the sort ListTodos forwards when the filter has
+// none, so clients get a stable order. The value is expected to pass
+// todo.Filter.Validate; an empty sort keeps the downstream's order.
+func WithDefaultTodoSort(sort string) TodoOption {
+	return func(s *TodoService) {
+		s.defaultSort = sort
+	}
+}
+
+// NewTodoService creates a TodoService backed by the given client port. If
+// logger is nil, a no-op logger is used.
+func NewTodoService(client ports.TodoClient, logger *slog.Logger, opts ...TodoOption) *TodoService {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	s := &TodoService{
+		todoClient: client,
+		logger:     logger,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// todoCacheKey returns the appctx cache key for a todo by ID.
+func todoCacheKey(id int64) string {
+	return fmt.Sprintf("todo:%d", id)
+}
+
+// fetchTodo returns a todo by ID, using the RequestContext's memoized cache
+// when available and falling back to a direct client call otherwise.
+func (s *TodoService) fetchTodo(ctx context.Context, id int64) (*todo.Todo, error) {
+	if rc := appctx.FromContext(ctx); rc != nil {
+		return appctx.GetOrFetch(rc, todoCacheKey(id), func(ctx context.Context) (*todo.Todo, error) {
+			return s.todoClient.GetTodo(ctx, id)
+		})
+	}
+	return s.todoClient.GetTodo(ctx, id)
+}
+
+// ListTodos validates the filter and returns matching todos. A filter without
+// a sort uses the WithDefaultTodoSort default.
+func (s *TodoService) ListTodos(ctx context.Context, filter todo.Filter) ([]todo.Todo, error) {
+	if filter.Sort == "" {
+		filter.Sort = s.defaultSort
+	}
+	s.logger.InfoContext(ctx, "listing todos", slog.String("filter", filter.String()))
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.verifyTodoProject(ctx, "ListTodos", filter.ProjectID); err != nil {
+		return nil, err
+	}
+
+	todos, err := s.todoClient.ListTodos(ctx, filter)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list todos",
+			slog.String("operation", "ListTodos"),
+			slog.String("filter", filter.String()),
+			slog.Any("error", err),
+		)
+		return nil, fmt.Errorf("listing todos: %w", err)
+	}
+
+	if s.owners != nil && filter.ProjectID == nil {
+		return s.accessibleTodos(ctx, todos)
+	}
+	return todos, nil
+}
+
+// GetTodo returns a single todo by ID.
+func (s *TodoService) GetTodo(ctx context.Context, id int64) (*todo.Todo, error) {
+	s.logger.InfoContext(ctx, "fetching todo", slog.Int64("id", id))
+
+	td, err := s.fetchTodo(ctx, id)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to fetch todo",
+			slog.String("operation", "GetTodo"),
+			slog.Int64("id", id),
+			slog.Any("error", err),
+		)
+		return nil, fmt.Errorf("fetching todo: %w", err)
+	}
+	if err := s.verifyTodoProject(ctx, "GetTodo", td.ProjectID); err != nil {
+		return nil, err
+	}
+
+	return td, nil
+}
+
+// validateNewTodo validates a todo about to be created, with the relaxed
+// draft rules when ctx is marked by appctx.WithDraft.
+func validateNewTodo(ctx context.Context, td *todo.Todo) error {
+	if appctx.IsDraft(ctx) {
+		return td.ValidateDraft()
+	}
+	return td.Validate()
+}
+
+// CreateTodo validates and creates a new todo, caching the result for the
+// rest of the request. A draft (see appctx.WithDraft) may omit its
+// description.
+func (s *TodoService) CreateTodo(ctx context.Context, td *todo.Todo) (*todo.Todo, error) {
+	if td == nil {
+		return nil, &domain.ValidationError{Fields: map[string]string{"todo": "is required"}}
+	}
+
+	s.logger.InfoContext(ctx, "creating todo", slog.String("title", td.Title))
+
+	if err := validateNewTodo(ctx, td); err != nil {
+		return nil, err
+	}
+	if err := s.verifyTodoProject(ctx, "CreateTodo", td.ProjectID); err != nil {
+		return nil, err
+	}
+
+	created, err := s.todoClient.CreateTodo(ctx, td)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create todo",
+			slog.String("operation", "CreateTodo"),
+			slog.Any("error", err),
+		)
+		return nil, fmt.Errorf("creating todo: %w", err)
+	}
+
+	if rc := appctx.FromContext(ctx); rc != nil {
+		appctx.Put(rc, todoCacheKey(created.ID), created)
+	}
+
+	recordEntityOp(ctx, s.metrics, entityTodo, opCreate, 1)
+	return created, nil
+}
+
+// UpdateTodo validates and updates an existing todo, refreshing the cached
+// entry for the rest of the request.
+func (s *TodoService) UpdateTodo(ctx context.Context, id int64, td *todo.Todo) (*todo.Todo, error) {
+	if td == nil {
+		return nil, &domain.ValidationError{Fields: map[string]string{"todo": "is required"}}
+	}
+
+	s.logger.InfoContext(ctx, "updating todo", slog.Int64("id", id))
+
+	if err := td.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.verifyTodoAccess(ctx, "UpdateTodo", id); err != nil {
+		return nil, err
+	}
+	if err := s.verifyTodoProject(ctx, "UpdateTodo", td.ProjectID); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.todoClient.UpdateTodo(ctx, id, td)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to update todo",
+			slog.String("operation", "UpdateTodo"),
+			slog.Int64("id", id),
+			slog.Any("error", err),
+		)
+		return nil, fmt.Errorf("updating todo: %w", err)
+	}
+
+	if rc := appctx.FromContext(ctx); rc != nil {
+		appctx.Put(rc, todoCacheKey(id), updated)
+	}
+
+	recordEntityOp(ctx, s.metrics, entityTodo, opUpdate, 1)
+	return updated, nil
+}
+
+// PatchTodo reads the stored todo, applies patch to a copy, and saves the
+// result through UpdateTodo. When the patch changes nothing the stored todo
+// is returned without a downstream write.
+func (s *TodoService) PatchTodo(ctx context.Context, id int64, patch func(*todo.Todo) *todo.Todo) (*todo.Todo, error) {
+	s.logger.InfoContext(ctx, "patching todo", slog.Int64("id", id))
+
+	existing, err := s.fetchTodo(ctx, id)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to fetch todo",
+			slog.String("operation", "PatchTodo"),
+			slog.Int64("id", id),
+			slog.Any("error", err),
+		)
+		return nil, fmt.Errorf("fetching todo: %w", err)
+	}
+	if err := s.verifyTodoProject(ctx, "PatchTodo", existing.ProjectID); err != nil {
+		return nil, err
+	}
+
+	current := *existing
+	td := patch(&current)
+	if td != nil && td.SameContent(existing) {
+		s.logger.InfoContext(ctx, "no-op patch: todo unchanged", slog.Int64("id", id))
+		unchanged := *existing
+		return &unchanged, nil
+	}
+	return s.UpdateTodo(ctx, id, td)
+}
+
+// DeleteTodo deletes a todo by ID and drops it from the request cache.
+func (s *TodoService) DeleteTodo(ctx context.Context, id int64) error {
+	s.logger.InfoContext(ctx, "deleting todo", slog.Int64("id", id))
+
+	if err := s.verifyTodoAccess(ctx, "DeleteTodo", id); err != nil {
+		return err
+	}
+	if err := s.todoClient.DeleteTodo(ctx, id); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete todo",
+			slog.String("operation", "DeleteTodo"),
+			slog.Int64("id", id),
+			slog.Any("error", err),
+		)
+		return fmt.Errorf("deleting todo: %w", err)
+	}
+
+	if rc := appctx.FromContext(ctx); rc != nil {
+		rc.Invalidate(todoCacheKey(id))
+	}
+
+	recordEntityOp(ctx, s.metrics, entityTodo, opDelete, 1)
+	return nil
+}
@@ -0,0 +1,389 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	appctx "github.com/jsamuelsen11/go-service-template-v2/internal/app/context"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/mocks"
+)
+
+func TestNewTodoService_NilLogger(t *testing.T) {
+	t.Parallel()
+
+	svc := NewTodoService(mocks.NewMockTodoClient(t), nil)
+	if svc.logger == nil {
+		t.Fatal("NewTodoService(nil logger) should create a no-op logger, got nil")
+	}
+}
+
+func TestTodoService_ListTodos(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns todos from client", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		filter := todo.Filter{Status: todo.StatusPending}
+		mockClient.EXPECT().ListTodos(mock.Anything, filter).Return([]todo.Todo{validTodo()}, nil)
+
+		got, err := svc.ListTodos(context.Background(), filter)
+		if err != nil {
+			t.Fatalf("ListTodos() error = %v, want nil", err)
+		}
+		if len(got) != 1 {
+			t.Errorf("ListTodos() len = %d, want 1", len(got))
+		}
+	})
+
+	t.Run("no sort forwards the configured default", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger(), WithDefaultTodoSort("-created_at"))
+
+		want := todo.Filter{Status: todo.StatusPending, Sort: "-created_at"}
+		mockClient.EXPECT().ListTodos(mock.Anything, want).Return([]todo.Todo{}, nil)
+
+		if _, err := svc.ListTodos(context.Background(), todo.Filter{Status: todo.StatusPending}); err != nil {
+			t.Fatalf("ListTodos() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("requested sort overrides the default", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger(), WithDefaultTodoSort("-created_at"))
+
+		filter := todo.Filter{Sort: "title"}
+		mockClient.EXPECT().ListTodos(mock.Anything, filter).Return([]todo.Todo{}, nil)
+
+		if _, err := svc.ListTodos(context.Background(), filter); err != nil {
+			t.Fatalf("ListTodos() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("invalid filter skips client", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		ids := make([]int64, todo.MaxFilterIDs+1)
+		for i := range ids {
+			ids[i] = int64(i + 1)
+		}
+
+		_, err := svc.ListTodos(context.Background(), todo.Filter{IDs: ids})
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("ListTodos() error = %v, want ErrValidation", err)
+		}
+	})
+
+	t.Run("wraps client error", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		mockClient.EXPECT().ListTodos(mock.Anything, todo.Filter{}).Return(nil, domain.ErrUnavailable)
+
+		_, err := svc.ListTodos(context.Background(), todo.Filter{})
+		if !errors.Is(err, domain.ErrUnavailable) {
+			t.Errorf("ListTodos() error = %v, want ErrUnavailable", err)
+		}
+	})
+}
+
+func TestTodoService_GetTodo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
+
+		_, err := svc.GetTodo(context.Background(), 99)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("GetTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("memoizes within a request", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		td := validTodo()
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(1)).Return(&td, nil).Once()
+
+		ctx := ctxWithRC()
+		for range 2 {
+			got, err := svc.GetTodo(ctx, 1)
+			if err != nil {
+				t.Fatalf("GetTodo() error = %v, want nil", err)
+			}
+			if got.ID != 1 {
+				t.Errorf("GetTodo().ID = %d, want 1", got.ID)
+			}
+		}
+	})
+}
+
+func TestTodoService_CreateTodo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil todo", func(t *testing.T) {
+		t.Parallel()
+		svc := NewTodoService(mocks.NewMockTodoClient(t), discardLogger())
+
+		_, err := svc.CreateTodo(context.Background(), nil)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("CreateTodo() error = %v, want ErrValidation", err)
+		}
+	})
+
+	t.Run("invalid todo skips client", func(t *testing.T) {
+		t.Parallel()
+		svc := NewTodoService(mocks.NewMockTodoClient(t), discardLogger())
+
+		td := validTodo()
+		td.Title = ""
+		_, err := svc.CreateTodo(context.Background(), &td)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("CreateTodo() error = %v, want ErrValidation", err)
+		}
+	})
+
+	t.Run("non-draft without description skips client", func(t *testing.T) {
+		t.Parallel()
+		svc := NewTodoService(mocks.NewMockTodoClient(t), discardLogger())
+
+		td := validTodo()
+		td.Description = ""
+		_, err := svc.CreateTodo(context.Background(), &td)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("CreateTodo() error = %v, want ErrValidation", err)
+		}
+	})
+
+	t.Run("draft without description", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		input := validTodo()
+		input.Description = ""
+		created := input
+		created.ID = 8
+		mockClient.EXPECT().CreateTodo(mock.Anything, &input).Return(&created, nil)
+
+		got, err := svc.CreateTodo(appctx.WithDraft(context.Background()), &input)
+		if err != nil {
+			t.Fatalf("CreateTodo() error = %v, want nil", err)
+		}
+		if got.ID != 8 {
+			t.Errorf("CreateTodo().ID = %d, want 8", got.ID)
+		}
+	})
+
+	t.Run("caches created todo", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		input := validTodo()
+		created := validTodo()
+		created.ID = 7
+		mockClient.EXPECT().CreateTodo(mock.Anything, &input).Return(&created, nil)
+
+		ctx := ctxWithRC()
+		if _, err := svc.CreateTodo(ctx, &input); err != nil {
+			t.Fatalf("CreateTodo() error = %v, want nil", err)
+		}
+
+		// No GetTodo expectation: the read is served from the request cache.
+		got, err := svc.GetTodo(ctx, 7)
+		if err != nil {
+			t.Fatalf("GetTodo() error = %v, want nil", err)
+		}
+		if got.ID != 7 {
+			t.Errorf("GetTodo().ID = %d, want 7", got.ID)
+		}
+	})
+}
+
+func TestTodoService_UpdateTodo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		input := validTodo()
+		updated := validTodo()
+		updated.Status = todo.StatusDone
+		mockClient.EXPECT().UpdateTodo(mock.Anything, int64(1), &input).Return(&updated, nil)
+
+		got, err := svc.UpdateTodo(context.Background(), 1, &input)
+		if err != nil {
+			t.Fatalf("UpdateTodo() error = %v, want nil", err)
+		}
+		if got.Status != todo.StatusDone {
+			t.Errorf("UpdateTodo().Status = %q, want %q", got.Status, todo.StatusDone)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		input := validTodo()
+		mockClient.EXPECT().UpdateTodo(mock.Anything, int64(99), &input).Return(nil, domain.ErrNotFound)
+
+		_, err := svc.UpdateTodo(context.Background(), 99, &input)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("UpdateTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestTodoService_PatchTodo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("merges onto stored todo", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		stored := validTodo()
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(1)).Return(&stored, nil)
+		mockClient.EXPECT().UpdateTodo(mock.Anything, int64(1), mock.MatchedBy(func(td *todo.Todo) bool {
+			return td.Status == todo.StatusDone && td.Title == stored.Title
+		})).RunAndReturn(func(_ context.Context, _ int64, td *todo.Todo) (*todo.Todo, error) {
+			return td, nil
+		})
+
+		got, err := svc.PatchTodo(context.Background(), 1, func(td *todo.Todo) *todo.Todo {
+			td.Status = todo.StatusDone
+			return td
+		})
+		if err != nil {
+			t.Fatalf("PatchTodo() error = %v", err)
+		}
+		if got.Status != todo.StatusDone {
+			t.Errorf("Status = %q, want %q", got.Status, todo.StatusDone)
+		}
+	})
+
+	t.Run("unchanged todo skips the downstream write", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		stored := validTodo()
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(1)).Return(&stored, nil)
+
+		got, err := svc.PatchTodo(context.Background(), 1, func(td *todo.Todo) *todo.Todo { return td })
+		if err != nil {
+			t.Fatalf("PatchTodo() error = %v", err)
+		}
+		if got.ID != stored.ID || !got.SameContent(&stored) {
+			t.Errorf("PatchTodo() = %+v, want stored todo %+v", got, stored)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
+
+		_, err := svc.PatchTodo(context.Background(), 99, func(td *todo.Todo) *todo.Todo { return td })
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("PatchTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestTodoService_DeleteTodo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalidates cached todo", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		td := validTodo()
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(1)).Return(&td, nil).Once()
+		mockClient.EXPECT().DeleteTodo(mock.Anything, int64(1)).Return(nil)
+
+		ctx := ctxWithRC()
+		if _, err := svc.GetTodo(ctx, 1); err != nil {
+			t.Fatalf("GetTodo() error = %v", err)
+		}
+		if err := svc.DeleteTodo(ctx, 1); err != nil {
+			t.Fatalf("DeleteTodo() error = %v, want nil", err)
+		}
+
+		mockClient.EXPECT().GetTodo(mock.Anything, int64(1)).Return(nil, domain.ErrNotFound).Once()
+		if _, err := svc.GetTodo(ctx, 1); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("GetTodo() after delete error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("wraps client error", func(t *testing.T) {
+		t.Parallel()
+		mockClient := mocks.NewMockTodoClient(t)
+		svc := NewTodoService(mockClient, discardLogger())
+
+		mockClient.EXPECT().DeleteTodo(mock.Anything, int64(99)).Return(domain.ErrNotFound)
+
+		if err := svc.DeleteTodo(context.Background(), 99); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("DeleteTodo() error = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestTodoService_RecordsEntityMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := newFakeEntityMetrics()
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewTodoService(mockClient, discardLogger(), WithTodoMetrics(metrics))
+
+	created := validTodo()
+	mockClient.EXPECT().CreateTodo(mock.Anything, mock.Anything).Return(&created, nil)
+	mockClient.EXPECT().UpdateTodo(mock.Anything, int64(1), mock.Anything).Return(&created, nil)
+	mockClient.EXPECT().DeleteTodo(mock.Anything, int64(1)).Return(nil)
+	mockClient.EXPECT().DeleteTodo(mock.Anything, int64(2)).Return(domain.ErrNotFound)
+
+	ctx := context.Background()
+	td := validTodo()
+	if _, err := svc.CreateTodo(ctx, &td); err != nil {
+		t.Fatalf("CreateTodo() error = %v", err)
+	}
+	if _, err := svc.UpdateTodo(ctx, 1, &td); err != nil {
+		t.Fatalf("UpdateTodo() error = %v", err)
+	}
+	if err := svc.DeleteTodo(ctx, 1); err != nil {
+		t.Fatalf("DeleteTodo() error = %v", err)
+	}
+	if err := svc.DeleteTodo(ctx, 2); err == nil {
+		t.Fatal("DeleteTodo() error = nil, want error")
+	}
+
+	want := map[string]int{"todo/create": 1, "todo/update": 1, "todo/delete": 1}
+	if got := metrics.snapshot(); !maps.Equal(got, want) {
+		t.Errorf("recorded %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the instrumentation scope of application-layer spans.
+const tracerName = "github.com/jsamuelsen11/go-service-template-v2/internal/app"
+
+// Span attribute keys for the entity IDs a service method operates on.
+const (
+	attrProjectID     = "project.id"
+	attrTodoID        = "todo.id"
+	attrFromProjectID = "project.from_id"
+	attrToProjectID   = "project.to_id"
+	attrItemCount     = "app.item_count"
+)
+
+// WithTracerProvider sets the provider for the service's operation spans.
+// The default is the global provider from otel.GetTracerProvider, which
+// follows a later otel.SetTracerProvider. A nil provider keeps the default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(s *ProjectService) {
+		if tp != nil {
+			s.tracer = tp.Tracer(tracerName)
+		}
+	}
+}
+
+// defaultTracer returns the application tracer from the global provider.
+func defaultTracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// startSpan starts an internal span named "ProjectService.<method>" as a
+// child of any span in ctx. Downstream client spans started with the
+// returned context nest beneath it.
+func (s *ProjectService) startSpan(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, "ProjectService."+method,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// endSpan records err on span, if any, and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/project"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain/todo"
+	"github.com/jsamuelsen11/go-service-template-v2/mocks"
+)
+
+func newTracedService(t *testing.T) (*ProjectService, *mocks.MockTodoClient, *tracetest.InMemoryExporter, trace.Tracer) {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	t.Cleanup(func() {
+		_ = tp.Shutdown(context.Background())
+	})
+
+	mockClient := mocks.NewMockTodoClient(t)
+	svc := NewProjectService(mockClient, discardLogger(), WithTracerProvider(tp))
+	return svc, mockClient, exporter, tp.Tracer("httpclient")
+}
+
+// clientSpan stands in for the span the httpclient starts around a
+// downstream call.
+func clientSpan(ctx context.Context, tracer trace.Tracer) {
+	_, span := tracer.Start(ctx, "HTTP GET todo-api", trace.WithSpanKind(trace.SpanKindClient))
+	span.End()
+}
+
+func spanByName(t *testing.T, spans tracetest.SpanStubs, name string) tracetest.SpanStub {
+	t.Helper()
+	for _, s := range spans {
+		if s.Name == name {
+			return s
+		}
+	}
+	t.Fatalf("no span named %q in %d spans", name, len(spans))
+	return tracetest.SpanStub{}
+}
+
+func TestProjectService_GetProject_Span(t *testing.T) {
+	t.Parallel()
+	svc, mockClient, exporter, clientTracer := newTracedService(t)
+
+	proj := validProject()
+	mockClient.EXPECT().GetProject(mock.Anything, int64(1)).
+		RunAndReturn(func(ctx context.Context, _ int64) (*project.Project, error) {
+			clientSpan(ctx, clientTracer)
+			return &proj, nil
+		})
+	mockClient.EXPECT().GetProjectTodos(mock.Anything, int64(1), todo.Filter{}).Return(nil, nil)
+
+	if _, err := svc.GetProject(context.Background(), 1); err != nil {
+		t.Fatalf("GetProject() error = %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	op := spanByName(t, spans, "ProjectService.GetProject")
+	if op.SpanKind != trace.SpanKindInternal {
+		t.Errorf("SpanKind = %v, want %v", op.SpanKind, trace.SpanKindInternal)
+	}
+	want := attribute.Int64(attrProjectID, 1)
+	found := false
+	for _, kv := range op.Attributes {
+		if kv == want {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("attributes = %v, want %v", op.Attributes, want)
+	}
+
+	child := spanByName(t, spans, "HTTP GET todo-api")
+	if child.Parent.SpanID() != op.SpanContext.SpanID() {
+		t.Errorf("client span parent = %s, want %s", child.Parent.SpanID(), op.SpanContext.SpanID())
+	}
+	if child.SpanContext.TraceID() != op.SpanContext.TraceID() {
+		t.Error("client span is not in the operation span's trace")
+	}
+}
+
+func TestProjectService_Span_RecordsError(t *testing.T) {
+	t.Parallel()
+	svc, mockClient, exporter, _ := newTracedService(t)
+
+	mockClient.EXPECT().GetProject(mock.Anything, int64(99)).Return(nil, domain.ErrNotFound)
+
+	_, err := svc.GetProject(context.Background(), 99)
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("GetProject() error = %v, want ErrNotFound", err)
+	}
+
+	op := spanByName(t, exporter.GetSpans(), "ProjectService.GetProject")
+	if op.Status.Code != codes.Error {
+		t.Errorf("Status = %v, want %v", op.Status.Code, codes.Error)
+	}
+	if len(op.Events) == 0 || op.Events[0].Name != "exception" {
+		t.Errorf("events = %v, want a recorded exception", op.Events)
+	}
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// Clock supplies the current time. Code that derives timestamps itself takes
+// a Clock instead of calling time.Now so that those timestamps are
+// deterministic in tests; domaintest.FakeClock is the test implementation.
+//
+// Timestamps on persisted entities are assigned by the downstream TODO API,
+// not by this service. The Clock covers the values the service computes
+// locally, such as dry-run previews, and the in-memory TodoClient, which
+// stands in for the downstream in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the Clock backed by the system time, in UTC.
+type SystemClock struct{}
+
+// Now returns the current system time in UTC.
+func (SystemClock) Now() time.Time {
+	return time.Now().UTC()
+}
@@ -14,7 +14,9 @@ type Action interface {
 
 	// Rollback reverses the effect of a previously successful Execute call.
 	// Rollback is only called if Execute returned nil. The context may
-	// differ from the one passed to Execute.
+	// differ from the one passed to Execute: it is detached from the
+	// request's cancellation and carries its own rollback deadline.
+	// Rollbacks are best-effort and should be idempotent.
 	Rollback(ctx context.Context) error
 
 	// Description returns a human-readable description of the action for
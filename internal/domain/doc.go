@@ -1,5 +1,5 @@
 // Package domain contains shared domain types used across entity sub-packages.
 // Entity-specific types live in sub-packages (domain/todo, domain/project).
 // This root package holds sentinel errors, validation types, and domain-level
-// interfaces (Action, WriteStager) that are shared across all entities.
+// interfaces (Action, WriteStager, Clock) that are shared across all entities.
 package domain
@@ -0,0 +1,46 @@
+// Package domaintest provides test doubles for domain interfaces. It is
+// imported only by tests.
+package domaintest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+)
+
+// Compile-time check that FakeClock implements domain.Clock.
+var _ domain.Clock = (*FakeClock)(nil)
+
+// FakeClock is a domain.Clock that returns a fixed time until it is moved
+// with Set or Advance. It is safe for concurrent use.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock returns a FakeClock stopped at now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the clock's current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set moves the clock to now.
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// Advance moves the clock forward by d.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
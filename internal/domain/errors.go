@@ -3,19 +3,33 @@ package domain
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 )
 
-// MsgRequired is the validation message for mandatory fields.
-const MsgRequired = "is required"
+// Validation messages shared across entities.
+const (
+	// MsgRequired is the validation message for mandatory fields.
+	MsgRequired = "is required"
+	// MsgMustNotBeEmpty is the validation message for optional fields that,
+	// when supplied, must carry a non-blank value.
+	MsgMustNotBeEmpty = "must not be empty"
+)
 
 // Sentinel errors for errors.Is() checking.
 var (
-	ErrNotFound    = errors.New("not found")
-	ErrValidation  = errors.New("validation error")
-	ErrConflict    = errors.New("conflict")
-	ErrForbidden   = errors.New("forbidden")
-	ErrUnavailable = errors.New("unavailable")
+	ErrNotFound        = errors.New("not found")
+	ErrValidation      = errors.New("validation error")
+	ErrConflict        = errors.New("conflict")
+	ErrForbidden       = errors.New("forbidden")
+	ErrUnavailable     = errors.New("unavailable")
+	ErrTimeout         = errors.New("timeout")
+	ErrURITooLong      = errors.New("uri too long")
+	ErrOverloaded      = errors.New("overloaded")
+	ErrPayloadTooLarge = errors.New("payload too large")
+	ErrRateLimited     = errors.New("rate limited")
+	ErrUnauthenticated = errors.New("unauthenticated")
 )
 
 // ValidationError provides programmatic access to field-level validation failures.
@@ -25,10 +39,28 @@ type ValidationError struct {
 	Fields map[string]string
 }
 
+// FieldError is a single field-level validation failure.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// SortedFields returns the field failures ordered by field name, so callers
+// that render or compare them get the same result on every run.
+func (e *ValidationError) SortedFields() []FieldError {
+	out := make([]FieldError, 0, len(e.Fields))
+	for _, field := range slices.Sorted(maps.Keys(e.Fields)) {
+		out = append(out, FieldError{Field: field, Message: e.Fields[field]})
+	}
+	return out
+}
+
+// Error renders the field failures in sorted field order.
 func (e *ValidationError) Error() string {
-	parts := make([]string, 0, len(e.Fields))
-	for field, msg := range e.Fields {
-		parts = append(parts, field+": "+msg)
+	fields := e.SortedFields()
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		parts = append(parts, f.Field+": "+f.Message)
 	}
 	return fmt.Sprintf("%s: %s", ErrValidation.Error(), strings.Join(parts, "; "))
 }
@@ -0,0 +1,64 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+)
+
+func TestValidationError_ErrorIsSorted(t *testing.T) {
+	t.Parallel()
+
+	verr := &domain.ValidationError{Fields: map[string]string{
+		"title":    domain.MsgRequired,
+		"category": "must be one of: work, personal",
+		"progress": "must be between 0 and 100",
+		"due_date": "must be in the future",
+	}}
+
+	want := "validation error: category: must be one of: work, personal; " +
+		"due_date: must be in the future; progress: must be between 0 and 100; title: is required"
+
+	// Map iteration order is randomized per range, so repeated calls would
+	// expose any dependence on it.
+	for range 50 {
+		if got := verr.Error(); got != want {
+			t.Fatalf("Error() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestValidationError_SortedFields(t *testing.T) {
+	t.Parallel()
+
+	verr := &domain.ValidationError{Fields: map[string]string{
+		"b": "second",
+		"a": "first",
+		"c": "third",
+	}}
+
+	got := verr.SortedFields()
+	want := []domain.FieldError{
+		{Field: "a", Message: "first"},
+		{Field: "b", Message: "second"},
+		{Field: "c", Message: "third"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("SortedFields() len = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("SortedFields()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestValidationError_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	err := &domain.ValidationError{Fields: map[string]string{"title": domain.MsgRequired}}
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("errors.Is(%v, ErrValidation) = false, want true", err)
+	}
+}
@@ -0,0 +1,65 @@
+package project
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+)
+
+// sortFields are the fields a project list may be sorted by.
+var sortFields = []string{"id", "name", "created_at", "updated_at"}
+
+// Filter holds optional criteria for listing projects. The zero value lists
+// active projects in the downstream's order.
+type Filter struct {
+	// IncludeArchived also returns archived projects.
+	IncludeArchived bool
+	// Sort orders results by a field, descending when prefixed with "-".
+	// Empty leaves the downstream's order.
+	Sort string
+}
+
+// ParseFilter builds a Filter from list query parameters: include_archived
+// and sort. Empty parameters are ignored. Every failure is reported together
+// as a *domain.ValidationError keyed by parameter name.
+func ParseFilter(q url.Values) (Filter, error) {
+	var filter Filter
+	fields := make(map[string]string)
+
+	if raw := q.Get("include_archived"); raw != "" {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			fields["include_archived"] = "must be a boolean"
+		}
+		filter.IncludeArchived = v
+	}
+	filter.Sort = q.Get("sort")
+
+	var verr *domain.ValidationError
+	if err := filter.Validate(); errors.As(err, &verr) {
+		for k, v := range verr.Fields {
+			fields[k] = v
+		}
+	}
+
+	if len(fields) > 0 {
+		return Filter{}, &domain.ValidationError{Fields: fields}
+	}
+	return filter, nil
+}
+
+// Validate checks the filter's sort, reported under the "sort" key used by
+// the query parameter. Returns a *domain.ValidationError or nil.
+func (f Filter) Validate() error {
+	if !domain.ValidSort(f.Sort, sortFields) {
+		return &domain.ValidationError{Fields: map[string]string{"sort": domain.SortMessage(sortFields)}}
+	}
+	return nil
+}
+
+// Matches reports whether p should be listed under the filter.
+func (f Filter) Matches(p *Project) bool {
+	return f.IncludeArchived || !p.Archived
+}
@@ -17,6 +17,29 @@ type Project struct {
 	Todos       []todo.Todo
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+
+	// Archived hides the project from default listings without deleting
+	// it. Archived projects can still be fetched and updated by ID.
+	Archived bool
+
+	// OwnerID is the user ID of the caller that created the project, or
+	// empty for projects created without an identified caller.
+	OwnerID string
+
+	// TodosLoadError is set when the project loaded but its todos could not
+	// be fetched and the caller opted into partial results; Todos is nil in
+	// that case.
+	TodosLoadError error
+}
+
+// New returns a validated Project with the given name and description.
+// Returns the *domain.ValidationError from Validate if either is blank.
+func New(name, description string) (*Project, error) {
+	p := &Project{Name: name, Description: description}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
 // Validate checks business rules for the Project entity.
@@ -37,3 +60,36 @@ func (p *Project) Validate() error {
 	}
 	return nil
 }
+
+// ValidateForUpdate checks business rules for a partial update. An empty
+// field means "unchanged", mirroring the PATCH request DTO, so only values
+// that were supplied are checked: a name or description that is present but
+// blank is rejected. Returns a *domain.ValidationError or nil.
+func (p *Project) ValidateForUpdate() error {
+	fields := make(map[string]string)
+
+	if p.Name != "" && strings.TrimSpace(p.Name) == "" {
+		fields["name"] = domain.MsgMustNotBeEmpty
+	}
+	if p.Description != "" && strings.TrimSpace(p.Description) == "" {
+		fields["description"] = domain.MsgMustNotBeEmpty
+	}
+
+	if len(fields) > 0 {
+		return &domain.ValidationError{Fields: fields}
+	}
+	return nil
+}
+
+// OwnedBy reports whether userID owns the project. A project without an
+// owner is owned by no one.
+func (p *Project) OwnedBy(userID string) bool {
+	return p.OwnerID != "" && p.OwnerID == userID
+}
+
+// SameContent reports whether p and other carry the same user-editable
+// fields, the name, description, and archived flag. IDs, timestamps, and
+// todos are ignored.
+func (p *Project) SameContent(other *Project) bool {
+	return p.Name == other.Name && p.Description == other.Description && p.Archived == other.Archived
+}
@@ -2,6 +2,7 @@ package project
 
 import (
 	"errors"
+	"net/url"
 	"testing"
 	"time"
 
@@ -40,6 +41,32 @@ func validProject() Project {
 	}
 }
 
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := New("Sprint 1", "First sprint tasks")
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		if got.Name != "Sprint 1" || got.Description != "First sprint tasks" {
+			t.Errorf("New() = %+v, want name and description set", got)
+		}
+	})
+
+	t.Run("blank description", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := New("Sprint 1", "")
+		requireValidationField(t, err, "description")
+		if got != nil {
+			t.Errorf("New() = %+v, want nil on error", got)
+		}
+	})
+}
+
 func TestProject_Validate(t *testing.T) {
 	t.Parallel()
 
@@ -136,3 +163,141 @@ func TestProject_Validate_MultipleErrors(t *testing.T) {
 		t.Errorf("ValidationError.Fields has %d entries, want %d", len(verr.Fields), len(expectedFields))
 	}
 }
+
+func TestProject_ValidateForUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		p         Project
+		wantErr   bool
+		wantField string
+	}{
+		{
+			name:    "all empty passes (no-op update)",
+			p:       Project{},
+			wantErr: false,
+		},
+		{
+			name:    "valid name passes",
+			p:       Project{Name: "New name"},
+			wantErr: false,
+		},
+		{
+			name:      "whitespace-only name fails",
+			p:         Project{Name: "  "},
+			wantErr:   true,
+			wantField: "name",
+		},
+		{
+			name:    "valid description passes",
+			p:       Project{Description: "New desc"},
+			wantErr: false,
+		},
+		{
+			name:      "whitespace-only description fails",
+			p:         Project{Description: "\t\n"},
+			wantErr:   true,
+			wantField: "description",
+		},
+		{
+			name:    "full update passes",
+			p:       Project{Name: "New name", Description: "New desc"},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.p.ValidateForUpdate()
+			if tt.wantErr {
+				requireValidationField(t, err, tt.wantField)
+			} else if err != nil {
+				t.Errorf("ValidateForUpdate() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestProject_SameContent(t *testing.T) {
+	t.Parallel()
+
+	base := Project{ID: 1, Name: "n", Description: "d", UpdatedAt: time.Unix(1, 0)}
+
+	same := base
+	same.ID = 2
+	same.Todos = []todo.Todo{{ID: 7}}
+	same.UpdatedAt = time.Unix(9, 0)
+	if !base.SameContent(&same) {
+		t.Error("SameContent() = false for a project differing only in ID, todos, and timestamps")
+	}
+
+	renamed := base
+	renamed.Name = "other"
+	if base.SameContent(&renamed) {
+		t.Error("SameContent() = true for a renamed project")
+	}
+
+	archived := base
+	archived.Archived = true
+	if base.SameContent(&archived) {
+		t.Error("SameContent() = true for an archived project")
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query url.Values
+		want  Filter
+	}{
+		{name: "empty", query: url.Values{}, want: Filter{}},
+		{name: "include archived", query: url.Values{"include_archived": {"true"}}, want: Filter{IncludeArchived: true}},
+		{name: "exclude archived", query: url.Values{"include_archived": {"false"}}, want: Filter{}},
+		{name: "blank value ignored", query: url.Values{"include_archived": {""}}, want: Filter{}},
+		{name: "sort", query: url.Values{"sort": {"-name"}}, want: Filter{Sort: "-name"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseFilter(tt.query)
+			if err != nil {
+				t.Fatalf("ParseFilter() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseFilter() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFilter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseFilter(url.Values{"include_archived": {"maybe"}, "sort": {"title"}})
+	requireValidationField(t, err, "include_archived")
+	requireValidationField(t, err, "sort")
+}
+
+func TestFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	active := Project{ID: 1}
+	archived := Project{ID: 2, Archived: true}
+
+	if !(Filter{}).Matches(&active) {
+		t.Error("default filter excludes an active project")
+	}
+	if (Filter{}).Matches(&archived) {
+		t.Error("default filter includes an archived project")
+	}
+	if !(Filter{IncludeArchived: true}).Matches(&archived) {
+		t.Error("IncludeArchived filter excludes an archived project")
+	}
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// ValidSort reports whether sort is empty or names one of fields, optionally
+// prefixed with "-" for descending order.
+func ValidSort(sort string, fields []string) bool {
+	if sort == "" {
+		return true
+	}
+	return slices.Contains(fields, strings.TrimPrefix(sort, "-"))
+}
+
+// SortMessage is the validation message for a sort that ValidSort rejects.
+func SortMessage(fields []string) string {
+	return "must be one of: " + strings.Join(fields, ", ") + " (prefix - for descending)"
+}
@@ -0,0 +1,30 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+)
+
+func TestValidSort(t *testing.T) {
+	t.Parallel()
+
+	fields := []string{"id", "name"}
+	tests := []struct {
+		sort string
+		want bool
+	}{
+		{sort: "", want: true},
+		{sort: "id", want: true},
+		{sort: "-name", want: true},
+		{sort: "title", want: false},
+		{sort: "--id", want: false},
+		{sort: "+id", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := domain.ValidSort(tt.sort, fields); got != tt.want {
+			t.Errorf("ValidSort(%q) = %v, want %v", tt.sort, got, tt.want)
+		}
+	}
+}
@@ -1,5 +1,12 @@
 package todo
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
 // Category represents the categorization of a Todo item.
 type Category string
 
@@ -9,17 +16,61 @@ const (
 	CategoryOther    Category = "other"
 )
 
-// IsValid returns true if the category is one of the defined constants.
+// extraCategories holds deployment-specific categories accepted in addition
+// to the built-in constants. It is installed at startup by
+// RegisterExtraCategories and read by IsValid; nil means none.
+var extraCategories atomic.Pointer[map[Category]struct{}]
+
+// IsValid returns true if the category is one of the defined constants or
+// was registered with RegisterExtraCategories.
 func (c Category) IsValid() bool {
 	switch c {
 	case CategoryPersonal, CategoryWork, CategoryOther:
 		return true
-	default:
-		return false
 	}
+	if extra := extraCategories.Load(); extra != nil {
+		_, ok := (*extra)[c]
+		return ok
+	}
+	return false
 }
 
 // String implements fmt.Stringer.
 func (c Category) String() string {
 	return string(c)
 }
+
+// RegisterExtraCategories replaces the set of extra categories accepted by
+// IsValid. It is meant to be called once at startup from configuration;
+// passing no names clears the set.
+//
+// Returns an error, leaving the current set unchanged, if a name is blank,
+// repeated, or collides with a built-in category.
+func RegisterExtraCategories(names ...string) error {
+	set := make(map[Category]struct{}, len(names))
+	var errs []error
+	for _, name := range names {
+		c := Category(name)
+		switch {
+		case strings.TrimSpace(name) == "":
+			errs = append(errs, errors.New("extra category must not be blank"))
+		case c == CategoryPersonal || c == CategoryWork || c == CategoryOther:
+			errs = append(errs, fmt.Errorf("extra category %q collides with a built-in category", name))
+		default:
+			if _, dup := set[c]; dup {
+				errs = append(errs, fmt.Errorf("extra category %q is listed more than once", name))
+			}
+			set[c] = struct{}{}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if len(set) == 0 {
+		extraCategories.Store(nil)
+		return nil
+	}
+	extraCategories.Store(&set)
+	return nil
+}
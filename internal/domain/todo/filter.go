@@ -1,9 +1,178 @@
 package todo
 
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
+)
+
+// MaxSearchLength is the maximum length, in characters, of a free-text
+// search term after trimming.
+const MaxSearchLength = 256
+
+// MaxFilterIDs is the maximum number of distinct IDs a single batch lookup
+// may request.
+const MaxFilterIDs = 100
+
+// sortFields are the fields a todo list may be sorted by.
+var sortFields = []string{"id", "title", "status", "category", "created_at", "updated_at"}
+
 // Filter holds optional filter criteria for listing todos.
 // Zero-value fields mean "no filter" for that dimension.
 type Filter struct {
 	Status    Status
 	Category  Category
 	ProjectID *int64
+	// Search is a free-text term matched against title and description.
+	Search string
+	// IDs restricts results to the given todo IDs. Duplicates are ignored.
+	IDs []int64
+	// Sort orders results by a field, descending when prefixed with "-".
+	// Empty leaves the downstream's order.
+	Sort string
+}
+
+// ParseFilter builds a Filter from list query parameters: status, category,
+// project_id, q (free-text search), and sort. Empty parameters are ignored. The
+// parsed filter is also run through [Filter.Validate], and every failure is
+// reported together as a *domain.ValidationError keyed by parameter name.
+func ParseFilter(q url.Values) (Filter, error) {
+	var filter Filter
+	fields := make(map[string]string)
+
+	if raw := q.Get("status"); raw != "" {
+		if s := Status(raw); s.IsValid() {
+			filter.Status = s
+		} else {
+			fields["status"] = fmt.Sprintf("invalid: %q", raw)
+		}
+	}
+	if raw := q.Get("category"); raw != "" {
+		if c := Category(raw); c.IsValid() {
+			filter.Category = c
+		} else {
+			fields["category"] = fmt.Sprintf("invalid: %q", raw)
+		}
+	}
+	if raw := q.Get("project_id"); raw != "" {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		switch {
+		case err != nil:
+			fields["project_id"] = "must be a valid integer"
+		case id <= 0:
+			fields["project_id"] = "must be a positive integer"
+		default:
+			filter.ProjectID = &id
+		}
+	}
+	filter.Search = q.Get("q")
+	filter.Sort = q.Get("sort")
+
+	var verr *domain.ValidationError
+	if err := filter.Validate(); errors.As(err, &verr) {
+		for k, v := range verr.Fields {
+			fields[k] = v
+		}
+	}
+
+	if len(fields) > 0 {
+		return Filter{}, &domain.ValidationError{Fields: fields}
+	}
+	return filter, nil
+}
+
+// Validate checks the filter's search term, ID list, and sort, reported under
+// the "q", "ids", and "sort" keys used by the query parameters. Returns a
+// *domain.ValidationError or nil.
+func (f Filter) Validate() error {
+	fields := make(map[string]string)
+	if n := len([]rune(strings.TrimSpace(f.Search))); n > MaxSearchLength {
+		fields["q"] = fmt.Sprintf("must be at most %d characters", MaxSearchLength)
+	}
+	if n := len(f.UniqueIDs()); n > MaxFilterIDs {
+		fields["ids"] = fmt.Sprintf("must contain at most %d ids", MaxFilterIDs)
+	}
+	if !domain.ValidSort(f.Sort, sortFields) {
+		fields["sort"] = domain.SortMessage(sortFields)
+	}
+	if len(fields) > 0 {
+		return &domain.ValidationError{Fields: fields}
+	}
+	return nil
+}
+
+// UniqueIDs returns the filter's IDs with duplicates removed, preserving the
+// order of first occurrence. Returns nil if no IDs are set.
+func (f Filter) UniqueIDs() []int64 {
+	if len(f.IDs) == 0 {
+		return nil
+	}
+	seen := make(map[int64]struct{}, len(f.IDs))
+	ids := make([]int64, 0, len(f.IDs))
+	for _, id := range f.IDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Equal reports whether f and other select the same todos in the same order.
+// ProjectID is compared by value rather than by pointer, and IDs are compared
+// as sets since order and duplicates do not affect which todos match. A nil
+// and an empty IDs list are equal.
+func (f Filter) Equal(other Filter) bool {
+	if f.Status != other.Status || f.Category != other.Category || f.Search != other.Search || f.Sort != other.Sort {
+		return false
+	}
+	if !equalPtr(f.ProjectID, other.ProjectID) {
+		return false
+	}
+
+	a, b := f.UniqueIDs(), other.UniqueIDs()
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[int64]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+	for _, id := range b {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a compact description of the set criteria for logging,
+// such as `Filter{status=done project_id=3 q="milk" ids=[1 2]}`. Unset
+// fields are omitted, so the zero Filter is "Filter{}".
+func (f Filter) String() string {
+	var parts []string
+	if f.Status != "" {
+		parts = append(parts, "status="+f.Status.String())
+	}
+	if f.Category != "" {
+		parts = append(parts, "category="+f.Category.String())
+	}
+	if f.ProjectID != nil {
+		parts = append(parts, "project_id="+strconv.FormatInt(*f.ProjectID, 10))
+	}
+	if f.Search != "" {
+		parts = append(parts, "q="+strconv.Quote(f.Search))
+	}
+	if len(f.IDs) > 0 {
+		parts = append(parts, fmt.Sprintf("ids=%v", f.IDs))
+	}
+	if f.Sort != "" {
+		parts = append(parts, "sort="+f.Sort)
+	}
+	return "Filter{" + strings.Join(parts, " ") + "}"
 }
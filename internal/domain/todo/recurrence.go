@@ -0,0 +1,119 @@
+package todo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoNextOccurrence is returned by NextOccurrence when the rule's UNTIL
+// bound has passed.
+var ErrNoNextOccurrence = errors.New("recurrence has no further occurrences")
+
+// Supported RRULE frequencies.
+const (
+	freqDaily   = "DAILY"
+	freqWeekly  = "WEEKLY"
+	freqMonthly = "MONTHLY"
+	freqYearly  = "YEARLY"
+)
+
+// rruleUntilLayout is the RFC 5545 UTC date-time form (e.g. 20250131T000000Z).
+const rruleUntilLayout = "20060102T150405Z"
+
+// recurrence is a parsed RRULE.
+type recurrence struct {
+	freq     string
+	interval int
+	until    *time.Time
+}
+
+// parseRecurrenceRule parses the supported RFC 5545 RRULE subset:
+// FREQ (DAILY, WEEKLY, MONTHLY, YEARLY; required), INTERVAL (positive
+// integer, default 1), and UNTIL (UTC date-time). An optional "RRULE:"
+// prefix is accepted. Parts are semicolon-separated and case-insensitive.
+func parseRecurrenceRule(rule string) (recurrence, error) {
+	r := recurrence{interval: 1}
+
+	body := strings.TrimSpace(rule)
+	if len(body) >= len("RRULE:") && strings.EqualFold(body[:len("RRULE:")], "RRULE:") {
+		body = body[len("RRULE:"):]
+	}
+	if body == "" {
+		return r, errors.New("must not be empty")
+	}
+
+	seen := make(map[string]bool)
+	for part := range strings.SplitSeq(body, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || key == "" || value == "" {
+			return r, fmt.Errorf("malformed part %q", part)
+		}
+		if seen[key] {
+			return r, fmt.Errorf("duplicate %s", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			switch value {
+			case freqDaily, freqWeekly, freqMonthly, freqYearly:
+				r.freq = value
+			default:
+				return r, fmt.Errorf("unsupported FREQ %q", value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return r, fmt.Errorf("INTERVAL must be a positive integer, got %q", value)
+			}
+			r.interval = n
+		case "UNTIL":
+			until, err := time.Parse(rruleUntilLayout, value)
+			if err != nil {
+				return r, fmt.Errorf("UNTIL must be a UTC date-time like 20250131T000000Z, got %q", value)
+			}
+			r.until = &until
+		default:
+			return r, fmt.Errorf("unsupported part %s", key)
+		}
+	}
+
+	if r.freq == "" {
+		return r, errors.New("FREQ is required")
+	}
+	return r, nil
+}
+
+// NextOccurrence returns the first occurrence of rule after the given time,
+// i.e. after advanced by INTERVAL units of FREQ. Monthly and yearly steps use
+// time.AddDate normalization (Jan 31 + 1 month is Mar 3 or Mar 2). Returns
+// ErrNoNextOccurrence if that time is past the rule's UNTIL bound, or an
+// error if the rule is invalid.
+func NextOccurrence(rule string, after time.Time) (time.Time, error) {
+	r, err := parseRecurrenceRule(rule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+
+	var next time.Time
+	switch r.freq {
+	case freqDaily:
+		next = after.AddDate(0, 0, r.interval)
+	case freqWeekly:
+		next = after.AddDate(0, 0, 7*r.interval)
+	case freqMonthly:
+		next = after.AddDate(0, r.interval, 0)
+	case freqYearly:
+		next = after.AddDate(r.interval, 0, 0)
+	}
+
+	if r.until != nil && next.After(*r.until) {
+		return time.Time{}, ErrNoNextOccurrence
+	}
+	return next, nil
+}
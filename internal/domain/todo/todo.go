@@ -8,7 +8,8 @@ import (
 	"github.com/jsamuelsen11/go-service-template-v2/internal/domain"
 )
 
-// Todo represents a task item with progress tracking.
+// Todo represents a task item with progress tracking. RecurrenceRule is an
+// optional RFC 5545 RRULE (FREQ, INTERVAL, UNTIL subset); see NextOccurrence.
 type Todo struct {
 	ID              int64
 	Title           string
@@ -17,20 +18,79 @@ type Todo struct {
 	Category        Category
 	ProgressPercent int
 	ProjectID       *int64
+	RecurrenceRule  *string
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
 
+// Option sets an optional field on a Todo built by New.
+type Option func(*Todo)
+
+// WithStatus sets the todo's status instead of StatusPending.
+func WithStatus(s Status) Option {
+	return func(t *Todo) { t.Status = s }
+}
+
+// WithCategory sets the todo's category instead of CategoryOther.
+func WithCategory(c Category) Option {
+	return func(t *Todo) { t.Category = c }
+}
+
+// WithProgress sets the todo's progress percentage.
+func WithProgress(percent int) Option {
+	return func(t *Todo) { t.ProgressPercent = percent }
+}
+
+// WithProjectID assigns the todo to a project.
+func WithProjectID(id int64) Option {
+	return func(t *Todo) { t.ProjectID = &id }
+}
+
+// WithRecurrenceRule makes the todo recurring with an RRULE.
+func WithRecurrenceRule(rule string) Option {
+	return func(t *Todo) { t.RecurrenceRule = &rule }
+}
+
+// New returns a validated Todo with the given title and description. The
+// status defaults to StatusPending and the category to CategoryOther unless
+// overridden by opts. Returns the *domain.ValidationError from Validate if
+// the result breaks a business rule.
+func New(title, description string, opts ...Option) (*Todo, error) {
+	t := &Todo{
+		Title:       title,
+		Description: description,
+		Status:      StatusPending,
+		Category:    CategoryOther,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
 // Validate checks business rules for the Todo entity.
 // Returns a *domain.ValidationError (wrapping domain.ErrValidation) with per-field details,
 // or nil if all rules pass.
 func (t *Todo) Validate() error {
+	return t.validate(false)
+}
+
+// ValidateDraft checks the same rules as Validate except that a draft may
+// omit its description.
+func (t *Todo) ValidateDraft() error {
+	return t.validate(true)
+}
+
+func (t *Todo) validate(draft bool) error {
 	fields := make(map[string]string)
 
 	if strings.TrimSpace(t.Title) == "" {
 		fields["title"] = domain.MsgRequired
 	}
-	if strings.TrimSpace(t.Description) == "" {
+	if !draft && strings.TrimSpace(t.Description) == "" {
 		fields["description"] = domain.MsgRequired
 	}
 	if !t.Status.IsValid() {
@@ -45,9 +105,35 @@ func (t *Todo) Validate() error {
 	if t.ProjectID != nil && *t.ProjectID <= 0 {
 		fields["project_id"] = fmt.Sprintf("must be positive, got %d", *t.ProjectID)
 	}
+	if t.RecurrenceRule != nil {
+		if _, err := parseRecurrenceRule(*t.RecurrenceRule); err != nil {
+			fields["recurrence_rule"] = err.Error()
+		}
+	}
 
 	if len(fields) > 0 {
 		return &domain.ValidationError{Fields: fields}
 	}
 	return nil
 }
+
+// SameContent reports whether t and other carry the same user-editable
+// fields: everything except the ID and the timestamps the downstream
+// assigns. Pointer fields are compared by value.
+func (t *Todo) SameContent(other *Todo) bool {
+	return t.Title == other.Title &&
+		t.Description == other.Description &&
+		t.Status == other.Status &&
+		t.Category == other.Category &&
+		t.ProgressPercent == other.ProgressPercent &&
+		equalPtr(t.ProjectID, other.ProjectID) &&
+		equalPtr(t.RecurrenceRule, other.RecurrenceRule)
+}
+
+// equalPtr reports whether a and b are both nil or point to equal values.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -3,6 +3,9 @@ package todo
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -154,6 +157,65 @@ func TestCategory_IsValid(t *testing.T) {
 	}
 }
 
+// TestRegisterExtraCategories mutates the package-level registry, so it
+// does not run in parallel with the other tests.
+func TestRegisterExtraCategories(t *testing.T) {
+	t.Cleanup(func() { _ = RegisterExtraCategories() })
+
+	if err := RegisterExtraCategories("research", "errands"); err != nil {
+		t.Fatalf("RegisterExtraCategories() error = %v, want nil", err)
+	}
+
+	tests := []struct {
+		category Category
+		want     bool
+	}{
+		{"research", true},
+		{"errands", true},
+		{CategoryWork, true},
+		{"gardening", false},
+	}
+	for _, tt := range tests {
+		if got := tt.category.IsValid(); got != tt.want {
+			t.Errorf("Category(%q).IsValid() = %v, want %v", tt.category, got, tt.want)
+		}
+	}
+
+	todo := Todo{Title: "Read paper", Description: "Section 3", Status: StatusPending, Category: "research"}
+	if err := todo.Validate(); err != nil {
+		t.Errorf("Validate() with extra category error = %v, want nil", err)
+	}
+
+	if err := RegisterExtraCategories(); err != nil {
+		t.Fatalf("RegisterExtraCategories() error = %v, want nil", err)
+	}
+	if Category("research").IsValid() {
+		t.Error("Category(\"research\").IsValid() = true after clearing, want false")
+	}
+}
+
+func TestRegisterExtraCategories_Rejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+	}{
+		{"built-in collision", []string{"work"}},
+		{"blank", []string{" "}},
+		{"duplicate", []string{"research", "research"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RegisterExtraCategories(tt.names...); err == nil {
+				t.Fatalf("RegisterExtraCategories(%q) error = nil, want error", tt.names)
+			}
+			if Category("research").IsValid() {
+				t.Error("rejected registration changed the registry")
+			}
+		})
+	}
+}
+
 func TestCategory_String(t *testing.T) {
 	t.Parallel()
 
@@ -189,6 +251,67 @@ func validTodo() Todo {
 	}
 }
 
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := New("Buy milk", "Two litres")
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		if got.Title != "Buy milk" || got.Description != "Two litres" {
+			t.Errorf("New() = %+v, want title and description set", got)
+		}
+		if got.Status != StatusPending || got.Category != CategoryOther {
+			t.Errorf("New() status, category = %q, %q, want %q, %q",
+				got.Status, got.Category, StatusPending, CategoryOther)
+		}
+	})
+
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := New("Report", "Quarterly report",
+			WithStatus(StatusInProgress),
+			WithCategory(CategoryWork),
+			WithProgress(40),
+			WithProjectID(7),
+			WithRecurrenceRule("FREQ=WEEKLY"),
+		)
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		if got.Status != StatusInProgress || got.Category != CategoryWork || got.ProgressPercent != 40 {
+			t.Errorf("New() = %+v, want options applied", got)
+		}
+		if got.ProjectID == nil || *got.ProjectID != 7 {
+			t.Errorf("ProjectID = %v, want 7", got.ProjectID)
+		}
+		if got.RecurrenceRule == nil || *got.RecurrenceRule != "FREQ=WEEKLY" {
+			t.Errorf("RecurrenceRule = %v, want FREQ=WEEKLY", got.RecurrenceRule)
+		}
+	})
+
+	t.Run("blank title", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := New("  ", "Two litres")
+		requireValidationField(t, err, "title")
+		if got != nil {
+			t.Errorf("New() = %+v, want nil on error", got)
+		}
+	})
+
+	t.Run("invalid option", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := New("Buy milk", "Two litres", WithProgress(101))
+		requireValidationField(t, err, "progress_percent")
+	})
+}
+
 func TestTodo_Validate(t *testing.T) {
 	t.Parallel()
 
@@ -321,6 +444,67 @@ func TestTodo_Validate(t *testing.T) {
 	}
 }
 
+func TestTodo_ValidateDraft(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		modify    func(*Todo)
+		wantErr   bool
+		wantField string
+	}{
+		{
+			name:   "draft without description passes",
+			modify: func(td *Todo) { td.Description = "" },
+		},
+		{
+			name:      "draft without title fails",
+			modify:    func(td *Todo) { td.Title = "" },
+			wantErr:   true,
+			wantField: "title",
+		},
+		{
+			name:      "draft with invalid status fails",
+			modify:    func(td *Todo) { td.Description, td.Status = "", "bogus" },
+			wantErr:   true,
+			wantField: "status",
+		},
+		{
+			name:      "draft with invalid category fails",
+			modify:    func(td *Todo) { td.Description, td.Category = "", "bogus" },
+			wantErr:   true,
+			wantField: "category",
+		},
+		{
+			name:      "draft with out-of-range progress fails",
+			modify:    func(td *Todo) { td.Description, td.ProgressPercent = "", 101 },
+			wantErr:   true,
+			wantField: "progress_percent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			td := validTodo()
+			tt.modify(&td)
+			err := td.ValidateDraft()
+
+			if tt.wantErr {
+				requireValidationField(t, err, tt.wantField)
+			} else if err != nil {
+				t.Errorf("ValidateDraft() = %v, want nil", err)
+			}
+		})
+	}
+
+	// The same todo is rejected outside draft mode.
+	td := validTodo()
+	td.Description = ""
+	requireValidationField(t, td.Validate(), "description")
+}
+
 func TestTodo_Validate_MultipleErrors(t *testing.T) {
 	t.Parallel()
 
@@ -424,6 +608,10 @@ func TestSentinelErrors(t *testing.T) {
 		{"ErrConflict", domain.ErrConflict},
 		{"ErrForbidden", domain.ErrForbidden},
 		{"ErrUnavailable", domain.ErrUnavailable},
+		{"ErrTimeout", domain.ErrTimeout},
+		{"ErrOverloaded", domain.ErrOverloaded},
+		{"ErrPayloadTooLarge", domain.ErrPayloadTooLarge},
+		{"ErrRateLimited", domain.ErrRateLimited},
 	}
 
 	for _, tt := range sentinels {
@@ -447,3 +635,347 @@ func TestSentinelErrors(t *testing.T) {
 		}
 	}
 }
+
+func TestFilter_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		search  string
+		wantErr bool
+	}{
+		{name: "empty", search: ""},
+		{name: "at limit", search: strings.Repeat("a", MaxSearchLength)},
+		{name: "at limit after trim", search: "  " + strings.Repeat("a", MaxSearchLength) + "  "},
+		{name: "over limit", search: strings.Repeat("a", MaxSearchLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Filter{Search: tt.search}.Validate()
+			if tt.wantErr {
+				requireValidationField(t, err, "q")
+				return
+			}
+			if err != nil {
+				t.Errorf("Validate() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestFilter_Validate_IDs(t *testing.T) {
+	t.Parallel()
+
+	seq := func(n int) []int64 {
+		ids := make([]int64, n)
+		for i := range ids {
+			ids[i] = int64(i + 1)
+		}
+		return ids
+	}
+
+	if err := (Filter{IDs: seq(MaxFilterIDs)}).Validate(); err != nil {
+		t.Errorf("Validate() at limit = %v, want nil", err)
+	}
+
+	// Duplicates do not count toward the limit.
+	dupes := append(seq(MaxFilterIDs), seq(MaxFilterIDs)...)
+	if err := (Filter{IDs: dupes}).Validate(); err != nil {
+		t.Errorf("Validate() with duplicates = %v, want nil", err)
+	}
+
+	requireValidationField(t, Filter{IDs: seq(MaxFilterIDs + 1)}.Validate(), "ids")
+}
+
+func TestParseFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query url.Values
+		want  Filter
+	}{
+		{name: "empty", query: url.Values{}, want: Filter{}},
+		{name: "status", query: url.Values{"status": {"done"}}, want: Filter{Status: StatusDone}},
+		{name: "category", query: url.Values{"category": {"work"}}, want: Filter{Category: CategoryWork}},
+		{name: "project_id", query: url.Values{"project_id": {"7"}}, want: Filter{ProjectID: int64Ptr(7)}},
+		{name: "search", query: url.Values{"q": {"milk"}}, want: Filter{Search: "milk"}},
+		{name: "sort", query: url.Values{"sort": {"-created_at"}}, want: Filter{Sort: "-created_at"}},
+		{
+			name:  "all params",
+			query: url.Values{"status": {"pending"}, "category": {"personal"}, "project_id": {"3"}, "q": {"x"}},
+			want:  Filter{Status: StatusPending, Category: CategoryPersonal, ProjectID: int64Ptr(3), Search: "x"},
+		},
+		{name: "blank values ignored", query: url.Values{"status": {""}, "project_id": {""}}, want: Filter{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseFilter(tt.query)
+			if err != nil {
+				t.Fatalf("ParseFilter() error = %v", err)
+			}
+			if got.Status != tt.want.Status || got.Category != tt.want.Category || got.Search != tt.want.Search || got.Sort != tt.want.Sort {
+				t.Errorf("ParseFilter() = %+v, want %+v", got, tt.want)
+			}
+			switch {
+			case (got.ProjectID == nil) != (tt.want.ProjectID == nil):
+				t.Errorf("ParseFilter() ProjectID = %v, want %v", got.ProjectID, tt.want.ProjectID)
+			case got.ProjectID != nil && *got.ProjectID != *tt.want.ProjectID:
+				t.Errorf("ParseFilter() ProjectID = %d, want %d", *got.ProjectID, *tt.want.ProjectID)
+			}
+		})
+	}
+}
+
+func TestParseFilter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query url.Values
+		field string
+	}{
+		{name: "unknown status", query: url.Values{"status": {"archived"}}, field: "status"},
+		{name: "unknown category", query: url.Values{"category": {"chores"}}, field: "category"},
+		{name: "non-integer project_id", query: url.Values{"project_id": {"abc"}}, field: "project_id"},
+		{name: "zero project_id", query: url.Values{"project_id": {"0"}}, field: "project_id"},
+		{name: "negative project_id", query: url.Values{"project_id": {"-1"}}, field: "project_id"},
+		{name: "search too long", query: url.Values{"q": {strings.Repeat("a", MaxSearchLength+1)}}, field: "q"},
+		{name: "unknown sort field", query: url.Values{"sort": {"priority"}}, field: "sort"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseFilter(tt.query)
+			requireValidationField(t, err, tt.field)
+			if got.Status != "" || got.Category != "" || got.ProjectID != nil || got.Search != "" {
+				t.Errorf("ParseFilter() filter = %+v, want zero value on error", got)
+			}
+		})
+	}
+}
+
+func TestParseFilter_ReportsAllInvalidParams(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseFilter(url.Values{
+		"status":     {"bogus"},
+		"category":   {"bogus"},
+		"project_id": {"-5"},
+		"q":          {strings.Repeat("a", MaxSearchLength+1)},
+	})
+	for _, field := range []string{"status", "category", "project_id", "q"} {
+		requireValidationField(t, err, field)
+	}
+}
+
+func TestFilter_UniqueIDs(t *testing.T) {
+	t.Parallel()
+
+	got := Filter{IDs: []int64{5, 3, 5, 1, 3}}.UniqueIDs()
+	want := []int64{5, 3, 1}
+	if !slices.Equal(got, want) {
+		t.Errorf("UniqueIDs() = %v, want %v", got, want)
+	}
+	if got := (Filter{}).UniqueIDs(); got != nil {
+		t.Errorf("UniqueIDs() on empty filter = %v, want nil", got)
+	}
+}
+
+func TestFilter_Equal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a, b Filter
+		want bool
+	}{
+		{"zero values", Filter{}, Filter{}, true},
+		{"distinct pointers to equal project IDs", Filter{ProjectID: int64Ptr(1)}, Filter{ProjectID: int64Ptr(1)}, true},
+		{"different project IDs", Filter{ProjectID: int64Ptr(1)}, Filter{ProjectID: int64Ptr(2)}, false},
+		{"nil and set project ID", Filter{}, Filter{ProjectID: int64Ptr(1)}, false},
+		{"set and nil project ID", Filter{ProjectID: int64Ptr(1)}, Filter{}, false},
+		{"nil and empty IDs", Filter{}, Filter{IDs: []int64{}}, true},
+		{"IDs in different order", Filter{IDs: []int64{1, 2}}, Filter{IDs: []int64{2, 1}}, true},
+		{"duplicate IDs", Filter{IDs: []int64{1, 1, 2}}, Filter{IDs: []int64{2, 1}}, true},
+		{"different IDs", Filter{IDs: []int64{1, 2}}, Filter{IDs: []int64{1, 3}}, false},
+		{"IDs subset", Filter{IDs: []int64{1}}, Filter{IDs: []int64{1, 2}}, false},
+		{"different status", Filter{Status: StatusDone}, Filter{}, false},
+		{"different category", Filter{Category: CategoryWork}, Filter{}, false},
+		{"different search", Filter{Search: "milk"}, Filter{Search: "eggs"}, false},
+		{"different sort", Filter{Sort: "id"}, Filter{Sort: "-id"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.a.Equal(tt.b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := tt.b.Equal(tt.a); got != tt.want {
+				t.Errorf("Equal() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{"zero value", Filter{}, "Filter{}"},
+		{"project only", Filter{ProjectID: int64Ptr(3)}, "Filter{project_id=3}"},
+		{
+			"all fields",
+			Filter{Status: StatusDone, Category: CategoryWork, ProjectID: int64Ptr(3), Search: `say "hi"`, IDs: []int64{1, 2}, Sort: "-title"},
+			`Filter{status=done category=work project_id=3 q="say \"hi\"" ids=[1 2] sort=-title}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.filter.String(); got != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_RecurrenceRule(t *testing.T) {
+	t.Parallel()
+
+	rule := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		rule    *string
+		wantErr bool
+	}{
+		{name: "nil rule", rule: nil},
+		{name: "daily", rule: rule("FREQ=DAILY")},
+		{name: "weekly with interval and prefix", rule: rule("RRULE:FREQ=WEEKLY;INTERVAL=2")},
+		{name: "until", rule: rule("FREQ=MONTHLY;UNTIL=20301231T000000Z")},
+		{name: "missing freq", rule: rule("INTERVAL=2"), wantErr: true},
+		{name: "unsupported freq", rule: rule("FREQ=HOURLY"), wantErr: true},
+		{name: "zero interval", rule: rule("FREQ=DAILY;INTERVAL=0"), wantErr: true},
+		{name: "malformed", rule: rule("FREQ"), wantErr: true},
+		{name: "unsupported part", rule: rule("FREQ=DAILY;BYDAY=MO"), wantErr: true},
+		{name: "empty", rule: rule(""), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			td := validTodo()
+			td.RecurrenceRule = tt.rule
+			err := td.Validate()
+			if tt.wantErr {
+				requireValidationField(t, err, "recurrence_rule")
+				return
+			}
+			if err != nil {
+				t.Errorf("Validate() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	after := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		rule string
+		want time.Time
+	}{
+		{name: "daily", rule: "FREQ=DAILY", want: time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC)},
+		{name: "every 3 days", rule: "FREQ=DAILY;INTERVAL=3", want: time.Date(2025, 2, 3, 9, 0, 0, 0, time.UTC)},
+		{name: "weekly", rule: "FREQ=WEEKLY", want: time.Date(2025, 2, 7, 9, 0, 0, 0, time.UTC)},
+		{name: "yearly", rule: "FREQ=YEARLY", want: time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NextOccurrence(tt.rule, after)
+			if err != nil {
+				t.Fatalf("NextOccurrence() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("NextOccurrence() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextOccurrence_PastUntil(t *testing.T) {
+	t.Parallel()
+
+	after := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+	_, err := NextOccurrence("FREQ=DAILY;UNTIL=20250131T235959Z", after)
+	if !errors.Is(err, ErrNoNextOccurrence) {
+		t.Errorf("NextOccurrence() error = %v, want ErrNoNextOccurrence", err)
+	}
+}
+
+func TestNextOccurrence_InvalidRule(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NextOccurrence("FREQ=SECONDLY", time.Now()); err == nil {
+		t.Error("NextOccurrence() error = nil, want error for invalid rule")
+	}
+}
+
+func TestTodo_SameContent(t *testing.T) {
+	t.Parallel()
+
+	base := func() *Todo {
+		return &Todo{
+			ID: 1, Title: "t", Description: "d", Status: StatusPending, Category: CategoryWork,
+			ProjectID: int64Ptr(3), RecurrenceRule: nil,
+			CreatedAt: time.Unix(1, 0), UpdatedAt: time.Unix(2, 0),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Todo)
+		want   bool
+	}{
+		{"identical", func(*Todo) {}, true},
+		{"ID and timestamps ignored", func(td *Todo) { td.ID = 9; td.UpdatedAt = time.Unix(5, 0) }, true},
+		{"equal project ID behind another pointer", func(td *Todo) { td.ProjectID = int64Ptr(3) }, true},
+		{"title differs", func(td *Todo) { td.Title = "other" }, false},
+		{"project ID cleared", func(td *Todo) { td.ProjectID = nil }, false},
+		{"recurrence rule set", func(td *Todo) { r := "FREQ=DAILY"; td.RecurrenceRule = &r }, false},
+		{"progress differs", func(td *Todo) { td.ProgressPercent = 50 }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			other := base()
+			tt.modify(other)
+			if got := base().SameContent(other); got != tt.want {
+				t.Errorf("SameContent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,6 +11,13 @@ type Config struct {
 	Log       LogConfig       `koanf:"log"`
 	Client    ClientConfig    `koanf:"client"`
 	Telemetry TelemetryConfig `koanf:"telemetry"`
+	Service   ServiceConfig   `koanf:"service"`
+	Todo      TodoConfig      `koanf:"todo"`
+	Project   ProjectConfig   `koanf:"project"`
+	Flags     FlagsConfig     `koanf:"flags"`
+	Audit     AuditConfig     `koanf:"audit"`
+	Identity  IdentityConfig  `koanf:"identity"`
+	Startup   StartupConfig   `koanf:"startup"`
 }
 
 // ServerConfig holds HTTP server settings.
@@ -20,29 +27,131 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration `koanf:"read_timeout"`
 	WriteTimeout time.Duration `koanf:"write_timeout"`
 	IdleTimeout  time.Duration `koanf:"idle_timeout"`
+	// ShutdownTimeout is how long in-flight requests may drain after a
+	// shutdown signal. A second signal during the drain exits immediately.
+	ShutdownTimeout time.Duration `koanf:"shutdown_timeout"`
+	// TrustedProxies lists CIDRs of reverse proxies whose X-Forwarded-For
+	// header is honored when resolving the client IP for access logs.
+	TrustedProxies []string `koanf:"trusted_proxies"`
+	// MaxQueryLength caps the raw query string length in bytes. Longer
+	// requests are rejected with 414. Zero disables the check.
+	MaxQueryLength int `koanf:"max_query_length"`
+	// MaxConcurrentRequests caps requests handled at once. Requests over
+	// the limit are rejected with 503 and Retry-After. Zero disables the
+	// limit.
+	MaxConcurrentRequests int `koanf:"max_concurrent_requests"`
+	// AdminPort is where the operational listener serves health and admin
+	// routes, keeping them off the public API port. Must differ from Port.
+	AdminPort int `koanf:"admin_port"`
+	// AdminWriteTimeout replaces WriteTimeout on the admin listener. It is
+	// longer than the API timeout so CPU profiles and execution traces
+	// (/debug/pprof/profile?seconds=N) can stream for their full duration;
+	// captures longer than this are cut off.
+	AdminWriteTimeout time.Duration `koanf:"admin_write_timeout"`
+	// EnablePprof registers the net/http/pprof handlers under /debug/pprof/
+	// on the admin listener. Enabled in non-prod profiles only.
+	EnablePprof bool `koanf:"enable_pprof"`
+	// PrettyJSON indents JSON response bodies on both listeners. Enabled in
+	// local and dev profiles for readability; compact elsewhere.
+	PrettyJSON bool `koanf:"pretty_json"`
+	// HealthCacheTTL is how long a health checker's result is reused by the
+	// readiness probe before the check runs again. Zero runs every check on
+	// every probe.
+	HealthCacheTTL time.Duration `koanf:"health_cache_ttl"`
+	// RateLimit throttles API requests per client IP.
+	RateLimit InboundRateLimitConfig `koanf:"rate_limit"`
+}
+
+// InboundRateLimitConfig limits API requests per client IP, resolved through
+// TrustedProxies. When RequestsPerSecond is zero, inbound rate limiting is
+// disabled.
+type InboundRateLimitConfig struct {
+	RequestsPerSecond float64 `koanf:"requests_per_second"`
+	BurstSize         int     `koanf:"burst_size"`
+	// Bypass lists CIDRs of clients that are never limited, such as
+	// internal callers.
+	Bypass []string `koanf:"bypass"`
+	// MaxClients bounds how many per-client limiters are held; the least
+	// recently seen client is evicted first.
+	MaxClients int `koanf:"max_clients"`
+	// IdleTTL drops the limiter of a client not seen for this long.
+	IdleTTL time.Duration `koanf:"idle_ttl"`
 }
 
 // LogConfig holds structured logging settings.
 type LogConfig struct {
 	Level  string `koanf:"level"`
 	Format string `koanf:"format"`
+	// RedactFields lists additional attribute names (case-insensitive)
+	// whose values are replaced with [REDACTED] in log output.
+	RedactFields []string `koanf:"redact_fields"`
 }
 
 // ClientConfig holds downstream HTTP client settings.
 type ClientConfig struct {
-	BaseURL        string               `koanf:"base_url"`
-	Timeout        time.Duration        `koanf:"timeout"`
+	BaseURL string `koanf:"base_url"`
+	// Timeout bounds the whole request, including reading the body.
+	Timeout time.Duration `koanf:"timeout"`
+	// DialTimeout bounds establishing the TCP connection. Zero means no
+	// limit beyond Timeout.
+	DialTimeout time.Duration `koanf:"dial_timeout"`
+	// TLSHandshakeTimeout bounds the TLS handshake. Zero means no limit
+	// beyond Timeout.
+	TLSHandshakeTimeout time.Duration `koanf:"tls_handshake_timeout"`
+	// ResponseHeaderTimeout bounds the wait for response headers after the
+	// request is written. Zero means no limit beyond Timeout.
+	ResponseHeaderTimeout time.Duration `koanf:"response_header_timeout"`
+	// ExpectContinueTimeout bounds the wait for a 100-continue response
+	// when the request carries "Expect: 100-continue". Zero sends the body
+	// immediately.
+	ExpectContinueTimeout time.Duration `koanf:"expect_continue_timeout"`
+	// MaxResponseBytes caps how much of a successful response body is read
+	// before decoding. Zero means no limit.
+	MaxResponseBytes int64 `koanf:"max_response_bytes"`
+	// StrictTimestamps logs a warning for each malformed RFC3339 timestamp
+	// in downstream todos. They default to the zero time either way.
+	StrictTimestamps bool `koanf:"strict_timestamps"`
+	// ActiveHealthCheck makes the readiness probe ping the downstream
+	// instead of reporting the circuit breaker state. Off by default, since
+	// it adds a downstream round trip to every probe.
+	ActiveHealthCheck bool `koanf:"active_health_check"`
+	// HealthPath is the downstream path the active readiness ping requests.
+	// It must start with "/". Empty falls back to the passive breaker state
+	// even when ActiveHealthCheck is set.
+	HealthPath string `koanf:"health_path"`
+	// UserAgent is sent on every outbound request. Empty uses
+	// "go-service-template/<version> (<service>)".
+	UserAgent      string               `koanf:"user_agent"`
 	Retry          RetryConfig          `koanf:"retry"`
 	CircuitBreaker CircuitBreakerConfig `koanf:"circuit_breaker"`
 	RateLimit      RateLimitConfig      `koanf:"rate_limit"`
+	HMAC           HMACConfig           `koanf:"hmac"`
+	OAuth          OAuthConfig          `koanf:"oauth"`
 }
 
 // RetryConfig holds retry policy settings with exponential backoff.
 type RetryConfig struct {
+	// Enabled turns retries on. When false every request gets exactly one
+	// attempt regardless of MaxAttempts, and the remaining retry settings
+	// are not validated.
+	Enabled         bool          `koanf:"enabled"`
 	MaxAttempts     int           `koanf:"max_attempts"`
 	InitialInterval time.Duration `koanf:"initial_interval"`
 	MaxInterval     time.Duration `koanf:"max_interval"`
 	Multiplier      float64       `koanf:"multiplier"`
+	// JitterFraction is the maximum random deviation of each backoff delay
+	// as a fraction of the delay, from 0 (deterministic) to 1.
+	JitterFraction float64 `koanf:"jitter_fraction"`
+	// MaxElapsedTime caps the total wall-clock time spent on attempts and
+	// backoff. Zero means retries are bounded by MaxAttempts only.
+	MaxElapsedTime time.Duration `koanf:"max_elapsed_time"`
+	// RetryNonIdempotent allows retrying POST and PATCH requests that carry
+	// no Idempotency-Key header. Off by default to avoid duplicate writes.
+	RetryNonIdempotent bool `koanf:"retry_non_idempotent"`
+	// RetryableStatuses, when non-empty, replaces the default set of
+	// retryable response statuses (429 and every 5xx). Only 4xx and 5xx
+	// codes are accepted.
+	RetryableStatuses []int `koanf:"retryable_statuses"`
 }
 
 // CircuitBreakerConfig holds circuit breaker settings.
@@ -59,10 +168,140 @@ type RateLimitConfig struct {
 	BurstSize         int     `koanf:"burst_size"`
 }
 
+// HMACConfig holds request signing settings for downstreams that authenticate
+// via HMAC-SHA256 signed requests. When Secret is empty, signing is disabled.
+type HMACConfig struct {
+	Secret          string `koanf:"secret"`
+	Header          string `koanf:"header"`
+	TimestampHeader string `koanf:"timestamp_header"`
+}
+
+// OAuthConfig holds OAuth2 client credentials settings for downstreams that
+// require a bearer token. When TokenURL is empty, bearer auth is disabled.
+// Tokens are refreshed RefreshBefore ahead of their expiry.
+type OAuthConfig struct {
+	TokenURL      string        `koanf:"token_url"`
+	ClientID      string        `koanf:"client_id"`
+	ClientSecret  string        `koanf:"client_secret"`
+	Scopes        []string      `koanf:"scopes"`
+	RefreshBefore time.Duration `koanf:"refresh_before"`
+}
+
 // TelemetryConfig holds OpenTelemetry settings.
 type TelemetryConfig struct {
 	Enabled     bool   `koanf:"enabled"`
 	Exporter    string `koanf:"exporter"`
 	Endpoint    string `koanf:"endpoint"`
 	ServiceName string `koanf:"service_name"`
+	// ExportTimeout bounds each span batch and metric export, including the
+	// final flush on shutdown, so an unreachable collector cannot hang them.
+	ExportTimeout time.Duration `koanf:"export_timeout"`
+	// MetricInterval is how often the periodic reader collects and exports
+	// metrics.
+	MetricInterval time.Duration `koanf:"metric_interval"`
+}
+
+// ServiceConfig holds application-service behavior settings.
+type ServiceConfig struct {
+	// DegradeReads makes selected read endpoints (currently ListProjects)
+	// return an empty result flagged as degraded instead of a 502 when the
+	// downstream is unavailable. Writes are never degraded.
+	DegradeReads bool `koanf:"degrade_reads"`
+	// PartialProjectReads makes GetProject return the project, flagged as
+	// degraded with todos_unavailable set, when only its todos fail to load.
+	// By default such a failure fails the request.
+	PartialProjectReads bool `koanf:"partial_project_reads"`
+}
+
+// TodoConfig holds deployment-specific todo settings.
+type TodoConfig struct {
+	// ExtraCategories are accepted as valid todo categories in addition to
+	// the built-in personal, work, and other. Names must not repeat or
+	// collide with a built-in category.
+	ExtraCategories []string `koanf:"extra_categories"`
+	// MaxPerProject caps how many todos a project may hold. Adding or moving
+	// a todo into a full project is rejected with 409. Zero disables the
+	// limit.
+	MaxPerProject int `koanf:"max_per_project"`
+	// DefaultSort orders todo lists whose request names no sort, so clients
+	// see a stable order. It is a sortable field, prefixed with "-" for
+	// descending, checked at startup. Empty keeps the downstream's order.
+	DefaultSort string `koanf:"default_sort"`
+}
+
+// ProjectConfig holds deployment-specific project settings.
+type ProjectConfig struct {
+	// DefaultSort orders project lists whose request names no sort, like
+	// TodoConfig.DefaultSort.
+	DefaultSort string `koanf:"default_sort"`
+	// EnforceOwnership restricts each project, and the todos in it, to the
+	// caller that created it; other callers get 403. It requires
+	// identity.source.
+	EnforceOwnership bool `koanf:"enforce_ownership"`
+}
+
+// AuditConfig holds audit logging settings. Successful project and todo
+// mutations are recorded as JSON lines separate from the application log.
+type AuditConfig struct {
+	// Output is where audit records are written: "stderr", "stdout", or a
+	// file path, which is created if needed and appended to. Empty disables
+	// audit records.
+	Output string `koanf:"output"`
+}
+
+// IdentityConfig holds caller identity settings for the public API. The
+// identity is recorded in audit records as the actor.
+type IdentityConfig struct {
+	// Source selects where the caller's user ID comes from: "header" trusts
+	// Header, which must be set by an authenticating gateway that strips any
+	// client-supplied value; "jwt" verifies an HS256 bearer token. Empty
+	// disables identity extraction.
+	Source string `koanf:"source"`
+	// Header names the trusted request header for the header source.
+	Header string `koanf:"header"`
+	// JWT configures token verification for the jwt source.
+	JWT JWTConfig `koanf:"jwt"`
+	// Required rejects API requests without an identity with 401. It needs
+	// a Source.
+	Required bool `koanf:"required"`
+}
+
+// JWTConfig holds bearer token verification settings. Tokens must not be
+// expired and are verified with exactly one of JWKSURL (RS256) or Secret
+// (HS256).
+type JWTConfig struct {
+	// JWKSURL is the issuer's JSON Web Key Set endpoint. Its keys are
+	// fetched through the instrumented HTTP client and cached for
+	// JWKSCacheTTL; a token signed with an unknown key ID triggers an early
+	// refetch to pick up rotated keys.
+	JWKSURL      string        `koanf:"jwks_url"`
+	JWKSCacheTTL time.Duration `koanf:"jwks_cache_ttl"`
+	// Secret is a shared HS256 key, for issuers without a JWKS endpoint.
+	Secret string `koanf:"secret"`
+	// Issuer and Audience, when set, must match the token's iss and aud
+	// claims. Both are required with JWKSURL.
+	Issuer   string `koanf:"issuer"`
+	Audience string `koanf:"audience"`
+	// Claim names the claim holding the user ID.
+	Claim string `koanf:"claim"`
+}
+
+// FlagsConfig holds feature flag settings.
+type FlagsConfig struct {
+	// Defaults maps flag names to their value for every request.
+	Defaults map[string]bool `koanf:"defaults"`
+	// HeaderOverride lets the X-Feature-Flags request header change flag
+	// values per request. Keep it off in production, where callers are not
+	// trusted to toggle behavior.
+	HeaderOverride bool `koanf:"header_override"`
+}
+
+// StartupConfig holds checks run once before the servers start serving.
+type StartupConfig struct {
+	// ProbeDownstream pings the downstream's client.health_path before
+	// serving and fails startup if it is unreachable or unhealthy, so a bad
+	// deploy crash-loops visibly instead of serving 502s.
+	ProbeDownstream bool `koanf:"probe_downstream"`
+	// ProbeTimeout bounds the probe.
+	ProbeTimeout time.Duration `koanf:"probe_timeout"`
 }
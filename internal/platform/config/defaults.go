@@ -0,0 +1,102 @@
+package config
+
+import "time"
+
+// Defaults returns a Config populated with the built-in defaults. Load
+// starts from these values and overlays YAML files and environment
+// variables, so a key missing from every layer keeps its default instead of
+// becoming the zero value. configs/base.yaml mirrors these values for
+// reference.
+func Defaults() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Host:                  "0.0.0.0",
+			Port:                  8080,
+			ReadTimeout:           5 * time.Second,
+			WriteTimeout:          10 * time.Second,
+			IdleTimeout:           120 * time.Second,
+			ShutdownTimeout:       15 * time.Second,
+			TrustedProxies:        []string{},
+			MaxQueryLength:        4096,
+			MaxConcurrentRequests: 1000,
+			AdminPort:             9090,
+			AdminWriteTimeout:     60 * time.Second,
+			RateLimit: InboundRateLimitConfig{
+				BurstSize:  20,
+				Bypass:     []string{},
+				MaxClients: 10000,
+				IdleTTL:    10 * time.Minute,
+			},
+		},
+		Log: LogConfig{
+			Level:        "info",
+			Format:       "json",
+			RedactFields: []string{},
+		},
+		Client: ClientConfig{
+			BaseURL:               "http://localhost:8081",
+			Timeout:               30 * time.Second,
+			DialTimeout:           5 * time.Second,
+			TLSHandshakeTimeout:   5 * time.Second,
+			ResponseHeaderTimeout: 15 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			MaxResponseBytes:      10 << 20,
+			HealthPath:            "/healthz",
+			Retry: RetryConfig{
+				Enabled:           true,
+				MaxAttempts:       3,
+				InitialInterval:   100 * time.Millisecond,
+				MaxInterval:       10 * time.Second,
+				Multiplier:        2.0,
+				JitterFraction:    0.25,
+				RetryableStatuses: []int{},
+			},
+			CircuitBreaker: CircuitBreakerConfig{
+				MaxFailures:   5,
+				Timeout:       30 * time.Second,
+				HalfOpenLimit: 1,
+			},
+			RateLimit: RateLimitConfig{
+				RequestsPerSecond: 100,
+				BurstSize:         10,
+			},
+			HMAC: HMACConfig{
+				Header:          "X-Signature",
+				TimestampHeader: "X-Signature-Timestamp",
+			},
+			OAuth: OAuthConfig{
+				RefreshBefore: 30 * time.Second,
+			},
+		},
+		Telemetry: TelemetryConfig{
+			Exporter:       "stdout",
+			ServiceName:    "go-service-template",
+			ExportTimeout:  10 * time.Second,
+			MetricInterval: 60 * time.Second,
+		},
+		Todo: TodoConfig{
+			ExtraCategories: []string{},
+			MaxPerProject:   1000,
+			DefaultSort:     "created_at",
+		},
+		Project: ProjectConfig{
+			DefaultSort: "created_at",
+		},
+		Flags: FlagsConfig{
+			Defaults: map[string]bool{},
+		},
+		Audit: AuditConfig{
+			Output: "stderr",
+		},
+		Identity: IdentityConfig{
+			Header: "X-User-ID",
+			JWT: JWTConfig{
+				JWKSCacheTTL: 10 * time.Minute,
+				Claim:        "sub",
+			},
+		},
+		Startup: StartupConfig{
+			ProbeTimeout: 5 * time.Second,
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package config
+
+import "reflect"
+
+// ChangedKeys returns the dotted koanf keys (e.g. "log.level") whose values
+// differ between old and updated, in struct field order. Nested structs are
+// compared field by field; slices and maps are compared as a whole.
+func ChangedKeys(old, updated *Config) []string {
+	return changedKeys(reflect.ValueOf(old).Elem(), reflect.ValueOf(updated).Elem(), "")
+}
+
+func changedKeys(a, b reflect.Value, prefix string) []string {
+	var keys []string
+	t := a.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("koanf")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		key := prefix + tag
+		if f.Type.Kind() == reflect.Struct {
+			keys = append(keys, changedKeys(a.Field(i), b.Field(i), key+".")...)
+			continue
+		}
+		if !reflect.DeepEqual(a.Field(i).Interface(), b.Field(i).Interface()) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
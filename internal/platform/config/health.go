@@ -0,0 +1,42 @@
+package config
+
+import (
+	"context"
+	"fmt"
+)
+
+// HealthChecker reports whether the configuration currently in effect passes
+// validation. Its name carries the active profile so the readiness endpoint
+// shows which profile a pod is running. Together with HealthCheck, Name lets
+// HealthChecker satisfy the ports.HealthChecker interface via structural
+// typing.
+type HealthChecker struct {
+	active  func() *Config
+	profile string
+}
+
+// NewHealthChecker creates a HealthChecker for configuration loaded under
+// profile. active is called on every check and must return the config in
+// effect at that moment, so the check follows SIGHUP reloads rather than the
+// config the process started with.
+func NewHealthChecker(active func() *Config, profile string) *HealthChecker {
+	return &HealthChecker{active: active, profile: profile}
+}
+
+// Name returns "config:{profile}".
+func (h *HealthChecker) Name() string {
+	return "config:" + h.profile
+}
+
+// HealthCheck runs Validate on the active configuration and returns its
+// error, if any. No I/O is performed.
+func (h *HealthChecker) HealthCheck(_ context.Context) error {
+	cfg := h.active()
+	if cfg == nil {
+		return fmt.Errorf("config profile %q: no active config", h.profile)
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("config profile %q invalid: %w", h.profile, err)
+	}
+	return nil
+}
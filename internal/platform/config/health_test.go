@@ -0,0 +1,64 @@
+package config_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/config"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/health"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
+)
+
+// Compile-time interface check. Platform must not import ports in production
+// code, so the check lives in the test file.
+var _ ports.HealthChecker = (*config.HealthChecker)(nil)
+
+func TestHealthChecker_Registered(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	registry := health.New()
+	registry.Register(config.NewHealthChecker(func() *config.Config { return cfg }, "dev"))
+
+	results := registry.CheckAll(context.Background())
+	err, ok := results["config:dev"]
+	if !ok {
+		t.Fatalf("CheckAll() missing checker %q, got %v", "config:dev", results)
+	}
+	if err != nil {
+		t.Errorf("HealthCheck() = %v, want nil", err)
+	}
+}
+
+func TestHealthChecker_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Server.Port = 0
+
+	checker := config.NewHealthChecker(func() *config.Config { return cfg }, "prod")
+	if err := checker.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() = nil, want error for invalid config")
+	}
+}
+
+func TestHealthChecker_FollowsActiveConfig(t *testing.T) {
+	t.Parallel()
+
+	var active atomic.Pointer[config.Config]
+	active.Store(validBaseConfig())
+	checker := config.NewHealthChecker(active.Load, "dev")
+
+	if err := checker.HealthCheck(context.Background()); err != nil {
+		t.Fatalf("HealthCheck() = %v, want nil", err)
+	}
+
+	swapped := validBaseConfig()
+	swapped.Log.Level = "verbose"
+	active.Store(swapped)
+
+	if err := checker.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() = nil after swap, want error for the newly active config")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"reflect"
 	"strings"
 
 	"github.com/knadh/koanf/parsers/yaml"
@@ -22,6 +23,8 @@ type Option func(*loadOptions)
 
 type loadOptions struct {
 	configDir string
+	file      string
+	envOnly   bool
 }
 
 // WithConfigDir sets the directory where config YAML files are located.
@@ -32,7 +35,28 @@ func WithConfigDir(dir string) Option {
 	}
 }
 
-// Load reads configuration using a 3-layer hierarchy (highest precedence last):
+// WithFile loads configuration from the single YAML file at path instead of
+// {configDir}/base.yaml and {configDir}/{profile}.yaml. Use it when the
+// layers are merged into one file at deploy time. Environment variables
+// still apply on top.
+func WithFile(path string) Option {
+	return func(o *loadOptions) {
+		o.file = path
+	}
+}
+
+// WithEnvOnly skips all YAML files and builds the configuration from APP_
+// environment variables and [Defaults] alone, for images that ship no config
+// files. The result is validated as usual. WithEnvOnly takes precedence over
+// WithFile.
+func WithEnvOnly() Option {
+	return func(o *loadOptions) {
+		o.envOnly = true
+	}
+}
+
+// Load reads configuration using a 3-layer hierarchy (highest precedence last)
+// on top of the built-in [Defaults]:
 //
 //  1. Base config ({configDir}/base.yaml)
 //  2. Profile config ({configDir}/{profile}.yaml)
@@ -45,6 +69,10 @@ func WithConfigDir(dir string) Option {
 //	APP_SERVER_READ_TIMEOUT   -> server.read_timeout
 //	APP_LOG_LEVEL             -> log.level
 //	APP_CLIENT_RETRY_MAX_ATTEMPTS -> client.retry.max_attempts
+//
+// Keys are also matched against the fields of [Config], so env vars resolve
+// correctly even for settings absent from the YAML layers. [WithFile]
+// replaces layers 1 and 2 with a single file; [WithEnvOnly] drops them.
 func Load(profile string, opts ...Option) (*Config, error) {
 	if err := validateProfile(profile); err != nil {
 		return nil, err
@@ -57,23 +85,15 @@ func Load(profile string, opts ...Option) (*Config, error) {
 
 	k := koanf.New(".")
 
-	// Layer 1: Base config (shared across all profiles).
-	basePath := filepath.Join(o.configDir, "base.yaml")
-	if err := k.Load(file.Provider(basePath), yaml.Parser()); err != nil {
-		return nil, fmt.Errorf("loading base config %s: %w", basePath, err)
-	}
-
-	// Layer 2: Profile-specific config.
-	profilePath := filepath.Join(o.configDir, profile+".yaml")
-	if err := k.Load(file.Provider(profilePath), yaml.Parser()); err != nil {
-		return nil, fmt.Errorf("loading profile config %s: %w", profilePath, err)
+	if err := loadFiles(k, profile, o); err != nil {
+		return nil, err
 	}
 
 	// Layer 3: Environment variables with APP_ prefix.
 	// Build a reverse lookup from known koanf keys so that env vars like
 	// APP_SERVER_READ_TIMEOUT correctly resolve to "server.read_timeout"
 	// instead of being ambiguously split as "server.read.timeout".
-	envLookup := buildEnvLookup(k.Keys())
+	envLookup := buildEnvLookup(append(k.Keys(), structKeys(reflect.TypeFor[Config](), "")...))
 
 	if err := k.Load(env.Provider(".", env.Opt{
 		Prefix: envPrefix,
@@ -92,9 +112,10 @@ func Load(profile string, opts ...Option) (*Config, error) {
 		return nil, fmt.Errorf("loading env vars: %w", err)
 	}
 
-	// Unmarshal into Config struct.
-	var cfg Config
-	if err := k.Unmarshal("", &cfg); err != nil {
+	// Unmarshal over the defaults so keys absent from every layer keep
+	// their default value.
+	cfg := Defaults()
+	if err := k.Unmarshal("", cfg); err != nil {
 		return nil, fmt.Errorf("unmarshalling config: %w", err)
 	}
 
@@ -103,7 +124,55 @@ func Load(profile string, opts ...Option) (*Config, error) {
 		return nil, fmt.Errorf("validating config: %w", err)
 	}
 
-	return &cfg, nil
+	return cfg, nil
+}
+
+// loadFiles loads the YAML layers selected by o into k: nothing in env-only
+// mode, the single file from WithFile, or base.yaml then {profile}.yaml.
+func loadFiles(k *koanf.Koanf, profile string, o *loadOptions) error {
+	switch {
+	case o.envOnly:
+		return nil
+
+	case o.file != "":
+		if err := k.Load(file.Provider(o.file), yaml.Parser()); err != nil {
+			return fmt.Errorf("loading config %s: %w", o.file, err)
+		}
+		return nil
+	}
+
+	// Layer 1: Base config (shared across all profiles).
+	basePath := filepath.Join(o.configDir, "base.yaml")
+	if err := k.Load(file.Provider(basePath), yaml.Parser()); err != nil {
+		return fmt.Errorf("loading base config %s: %w", basePath, err)
+	}
+
+	// Layer 2: Profile-specific config.
+	profilePath := filepath.Join(o.configDir, profile+".yaml")
+	if err := k.Load(file.Provider(profilePath), yaml.Parser()); err != nil {
+		return fmt.Errorf("loading profile config %s: %w", profilePath, err)
+	}
+	return nil
+}
+
+// structKeys returns the dotted koanf keys of every leaf field in t, a
+// struct type, following koanf tags into nested structs.
+func structKeys(t reflect.Type, prefix string) []string {
+	var keys []string
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("koanf")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		key := prefix + tag
+		if f.Type.Kind() == reflect.Struct {
+			keys = append(keys, structKeys(f.Type, key+".")...)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
 }
 
 // validateProfile checks that the profile name is safe and non-empty.
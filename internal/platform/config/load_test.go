@@ -1,7 +1,10 @@
 package config_test
 
 import (
+	"errors"
+	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
 	"testing"
@@ -47,6 +50,12 @@ func TestLoad_LocalProfile(t *testing.T) {
 	if cfg.Telemetry.Enabled {
 		t.Error("Telemetry.Enabled = true, want false for local")
 	}
+	if !cfg.Server.EnablePprof {
+		t.Error("Server.EnablePprof = false, want true for local")
+	}
+	if !cfg.Flags.HeaderOverride {
+		t.Error("Flags.HeaderOverride = false, want true for local")
+	}
 }
 
 func TestLoad_ProdProfile(t *testing.T) {
@@ -70,6 +79,12 @@ func TestLoad_ProdProfile(t *testing.T) {
 	if cfg.Telemetry.Endpoint == "" {
 		t.Error("Telemetry.Endpoint is empty, want non-empty for prod")
 	}
+	if cfg.Server.EnablePprof {
+		t.Error("Server.EnablePprof = true, want false for prod")
+	}
+	if cfg.Flags.HeaderOverride {
+		t.Error("Flags.HeaderOverride = true, want false for prod")
+	}
 }
 
 func TestLoad_BaseConfigInheritance(t *testing.T) {
@@ -92,15 +107,15 @@ func TestLoad_BaseConfigInheritance(t *testing.T) {
 }
 
 func TestLoad_EnvOverrideSimpleKey(t *testing.T) {
-	t.Setenv("APP_SERVER_PORT", "9090")
+	t.Setenv("APP_SERVER_PORT", "9000")
 
 	cfg, err := config.Load("local", withDir(t))
 	if err != nil {
 		t.Fatalf("Load error: %v", err)
 	}
 
-	if cfg.Server.Port != 9090 {
-		t.Errorf("Server.Port = %d, want 9090 (env override)", cfg.Server.Port)
+	if cfg.Server.Port != 9000 {
+		t.Errorf("Server.Port = %d, want 9000 (env override)", cfg.Server.Port)
 	}
 }
 
@@ -131,6 +146,107 @@ func TestLoad_EnvOverrideDeeplyNestedKey(t *testing.T) {
 	}
 }
 
+func TestLoad_EnvOnly(t *testing.T) {
+	t.Setenv("APP_SERVER_READ_TIMEOUT", "7s")
+	t.Setenv("APP_CLIENT_BASE_URL", "http://todo-api:8081")
+	t.Setenv("APP_CLIENT_CIRCUIT_BREAKER_MAX_FAILURES", "9")
+
+	// An empty config dir proves no YAML file is read.
+	cfg, err := config.Load("local", config.WithConfigDir(t.TempDir()), config.WithEnvOnly())
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+
+	if cfg.Server.ReadTimeout != 7*time.Second {
+		t.Errorf("Server.ReadTimeout = %v, want 7s", cfg.Server.ReadTimeout)
+	}
+	if cfg.Client.BaseURL != "http://todo-api:8081" {
+		t.Errorf("Client.BaseURL = %q, want %q", cfg.Client.BaseURL, "http://todo-api:8081")
+	}
+	if cfg.Client.CircuitBreaker.MaxFailures != 9 {
+		t.Errorf("Client.CircuitBreaker.MaxFailures = %d, want 9", cfg.Client.CircuitBreaker.MaxFailures)
+	}
+	if cfg.Server.Port != config.Defaults().Server.Port {
+		t.Errorf("Server.Port = %d, want default %d", cfg.Server.Port, config.Defaults().Server.Port)
+	}
+}
+
+func TestLoad_EnvOnlyStillValidates(t *testing.T) {
+	t.Setenv("APP_LOG_LEVEL", "verbose")
+
+	_, err := config.Load("local", config.WithConfigDir(t.TempDir()), config.WithEnvOnly())
+	if err == nil {
+		t.Fatal("Load() returned nil error, want validation error")
+	}
+	if !strings.Contains(err.Error(), "log.level") {
+		t.Errorf("error = %q, want it to mention \"log.level\"", err.Error())
+	}
+}
+
+func TestLoad_SingleFile(t *testing.T) {
+	t.Setenv("APP_SERVER_PORT", "9191")
+
+	// base.yaml alone is a complete config.
+	cfg, err := config.Load("local", config.WithFile(filepath.Join(configDir(t), "base.yaml")))
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+	if cfg.Server.Port != 9191 {
+		t.Errorf("Server.Port = %d, want 9191 (env override)", cfg.Server.Port)
+	}
+}
+
+func TestLoad_PartialFileKeepsDefaults(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	partial := "server:\n  port: 9292\n"
+	if err := os.WriteFile(filepath.Join(dir, "base.yaml"), []byte(partial), 0o600); err != nil {
+		t.Fatalf("writing base.yaml: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "local.yaml"), []byte("log:\n  level: debug\n"), 0o600); err != nil {
+		t.Fatalf("writing local.yaml: %v", err)
+	}
+
+	cfg, err := config.Load("local", config.WithConfigDir(dir))
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+
+	if cfg.Server.Port != 9292 {
+		t.Errorf("Server.Port = %d, want 9292", cfg.Server.Port)
+	}
+	if want := config.Defaults().Server.IdleTimeout; cfg.Server.IdleTimeout != want {
+		t.Errorf("Server.IdleTimeout = %v, want default %v", cfg.Server.IdleTimeout, want)
+	}
+	if cfg.Log.Level != "debug" {
+		t.Errorf("Log.Level = %q, want %q", cfg.Log.Level, "debug")
+	}
+	if want := config.Defaults().Log.Format; cfg.Log.Format != want {
+		t.Errorf("Log.Format = %q, want default %q", cfg.Log.Format, want)
+	}
+}
+
+func TestDefaults_MatchBaseYAML(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.Load("local", config.WithFile(filepath.Join(configDir(t), "base.yaml")))
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+	if want := config.Defaults(); !reflect.DeepEqual(cfg, want) {
+		t.Errorf("base.yaml and Defaults() disagree:\n base.yaml = %+v\n Defaults() = %+v", cfg, want)
+	}
+}
+
+func TestDefaults_Valid(t *testing.T) {
+	t.Parallel()
+
+	if err := config.Defaults().Validate(); err != nil {
+		t.Errorf("Defaults().Validate() error = %v, want nil", err)
+	}
+}
+
 func TestLoad_MissingProfile(t *testing.T) {
 	_, err := config.Load("nonexistent", withDir(t))
 	if err == nil {
@@ -233,6 +349,51 @@ func TestValidate_ServerWriteTimeoutNonPositive(t *testing.T) {
 	}
 }
 
+func TestValidate_ServerHealthCacheTTLNegative(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Server.HealthCacheTTL = -time.Second
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for health_cache_ttl<0")
+	}
+	if !strings.Contains(err.Error(), "server.health_cache_ttl") {
+		t.Errorf("error = %q, want it to mention \"server.health_cache_ttl\"", err.Error())
+	}
+}
+
+func TestValidate_ServerAdminWriteTimeoutNonPositive(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Server.AdminWriteTimeout = 0
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for admin_write_timeout=0")
+	}
+	if !strings.Contains(err.Error(), "server.admin_write_timeout") {
+		t.Errorf("error = %q, want it to mention \"server.admin_write_timeout\"", err.Error())
+	}
+}
+
+func TestValidate_ServerShutdownTimeoutNonPositive(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Server.ShutdownTimeout = 0
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for shutdown_timeout=0")
+	}
+	if !strings.Contains(err.Error(), "server.shutdown_timeout") {
+		t.Errorf("error = %q, want it to mention \"server.shutdown_timeout\"", err.Error())
+	}
+}
+
 func TestValidate_InvalidLogLevel(t *testing.T) {
 	t.Parallel()
 
@@ -293,6 +454,187 @@ func TestValidate_ClientTimeoutNonPositive(t *testing.T) {
 	}
 }
 
+func TestValidate_ClientTransportTimeoutsNegative(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		mutate func(*config.ClientConfig)
+		want   string
+	}{
+		{"dial", func(c *config.ClientConfig) { c.DialTimeout = -time.Second }, "client.dial_timeout"},
+		{"tls handshake", func(c *config.ClientConfig) { c.TLSHandshakeTimeout = -time.Second }, "client.tls_handshake_timeout"},
+		{"response header", func(c *config.ClientConfig) { c.ResponseHeaderTimeout = -time.Second }, "client.response_header_timeout"},
+		{"expect continue", func(c *config.ClientConfig) { c.ExpectContinueTimeout = -time.Second }, "client.expect_continue_timeout"},
+		{"max response bytes", func(c *config.ClientConfig) { c.MaxResponseBytes = -1 }, "client.max_response_bytes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := validBaseConfig()
+			tt.mutate(&cfg.Client)
+
+			err := cfg.Validate()
+			if err == nil {
+				t.Fatalf("Validate() returned nil, want error mentioning %q", tt.want)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %q, want it to mention %q", err.Error(), tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_ClientHealthPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path    string
+		wantErr bool
+	}{
+		{path: "/healthz"},
+		{path: ""},
+		{path: "healthz", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		cfg := validBaseConfig()
+		cfg.Client.HealthPath = tt.path
+
+		err := cfg.Validate()
+		if tt.wantErr {
+			if err == nil || !strings.Contains(err.Error(), "client.health_path") {
+				t.Errorf("HealthPath %q: error = %v, want client.health_path error", tt.path, err)
+			}
+		} else if err != nil {
+			t.Errorf("HealthPath %q: error = %v, want nil", tt.path, err)
+		}
+	}
+}
+
+func TestValidate_Identity(t *testing.T) {
+	t.Parallel()
+
+	secret := strings.Repeat("s", 32)
+	tests := []struct {
+		name    string
+		modify  func(*config.IdentityConfig)
+		wantErr string
+	}{
+		{name: "disabled", modify: func(*config.IdentityConfig) {}},
+		{name: "header", modify: func(id *config.IdentityConfig) { id.Source = "header"; id.Required = true }},
+		{name: "jwt", modify: func(id *config.IdentityConfig) { id.Source = "jwt"; id.JWT.Secret = secret }},
+		{
+			name:    "unknown source",
+			modify:  func(id *config.IdentityConfig) { id.Source = "cookie" },
+			wantErr: "identity.source",
+		},
+		{
+			name:    "required without source",
+			modify:  func(id *config.IdentityConfig) { id.Required = true },
+			wantErr: "identity.required",
+		},
+		{
+			name:    "header source without header",
+			modify:  func(id *config.IdentityConfig) { id.Source = "header"; id.Header = "" },
+			wantErr: "identity.header",
+		},
+		{
+			name:    "jwt source with short secret",
+			modify:  func(id *config.IdentityConfig) { id.Source = "jwt"; id.JWT.Secret = "short" },
+			wantErr: "identity.jwt.secret",
+		},
+		{
+			name: "jwt source with jwks",
+			modify: func(id *config.IdentityConfig) {
+				id.Source = "jwt"
+				id.JWT.JWKSURL = "https://issuer.example.com/.well-known/jwks.json"
+				id.JWT.Issuer = "https://issuer.example.com"
+				id.JWT.Audience = "todo-api"
+			},
+		},
+		{
+			name: "jwks with secret",
+			modify: func(id *config.IdentityConfig) {
+				id.Source = "jwt"
+				id.JWT.JWKSURL = "https://issuer.example.com/.well-known/jwks.json"
+				id.JWT.Secret = strings.Repeat("s", 32)
+				id.JWT.Issuer = "https://issuer.example.com"
+				id.JWT.Audience = "todo-api"
+			},
+			wantErr: "mutually exclusive",
+		},
+		{
+			name: "jwks without issuer",
+			modify: func(id *config.IdentityConfig) {
+				id.Source = "jwt"
+				id.JWT.JWKSURL = "https://issuer.example.com/.well-known/jwks.json"
+				id.JWT.Audience = "todo-api"
+			},
+			wantErr: "identity.jwt.issuer",
+		},
+		{
+			name: "jwks url not http",
+			modify: func(id *config.IdentityConfig) {
+				id.Source = "jwt"
+				id.JWT.JWKSURL = "file:///etc/jwks.json"
+				id.JWT.Issuer = "https://issuer.example.com"
+				id.JWT.Audience = "todo-api"
+			},
+			wantErr: "identity.jwt.jwks_url",
+		},
+		{
+			name: "jwks without cache ttl",
+			modify: func(id *config.IdentityConfig) {
+				id.Source = "jwt"
+				id.JWT.JWKSURL = "https://issuer.example.com/.well-known/jwks.json"
+				id.JWT.Issuer = "https://issuer.example.com"
+				id.JWT.Audience = "todo-api"
+				id.JWT.JWKSCacheTTL = 0
+			},
+			wantErr: "identity.jwt.jwks_cache_ttl",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := validBaseConfig()
+			cfg.Identity = config.IdentityConfig{
+				Header: "X-User-ID",
+				JWT:    config.JWTConfig{JWKSCacheTTL: 10 * time.Minute, Claim: "sub"},
+			}
+			tt.modify(&cfg.Identity)
+
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %s error", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate_ClientTransportTimeoutsZeroAllowed(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Client.DialTimeout = 0
+	cfg.Client.TLSHandshakeTimeout = 0
+	cfg.Client.ResponseHeaderTimeout = 0
+	cfg.Client.ExpectContinueTimeout = 0
+
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
+
 func TestValidate_RetryMaxAttemptsLessThanOne(t *testing.T) {
 	t.Parallel()
 
@@ -369,6 +711,59 @@ func TestValidate_RetryInitialIntervalExceedsMaxInterval(t *testing.T) {
 	}
 }
 
+func TestValidate_RetryJitterFraction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		jitter  float64
+		wantErr bool
+	}{
+		{"zero disables jitter", 0, false},
+		{"full jitter", 1, false},
+		{"negative", -0.1, true},
+		{"above one", 1.5, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := validBaseConfig()
+			cfg.Client.Retry.JitterFraction = tt.jitter
+
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "client.retry.jitter_fraction") {
+				t.Errorf("error = %q, want it to mention \"client.retry.jitter_fraction\"", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidate_RetryableStatuses(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Client.Retry.RetryableStatuses = []int{408, 425, 503}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want nil for 4xx/5xx statuses", err)
+	}
+
+	cfg.Client.Retry.RetryableStatuses = []int{408, 302, 600}
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for non-error statuses")
+	}
+	for _, want := range []string{"retryable_statuses[1]", "retryable_statuses[2]"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error = %q, want it to mention %q", err.Error(), want)
+		}
+	}
+}
+
 func TestValidate_CircuitBreakerMaxFailuresLessThanOne(t *testing.T) {
 	t.Parallel()
 
@@ -399,6 +794,206 @@ func TestValidate_CircuitBreakerTimeoutNonPositive(t *testing.T) {
 	}
 }
 
+func TestValidate_HMACHeaderEmptyWithSecret(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Client.HMAC = config.HMACConfig{Secret: "s3cret", TimestampHeader: "X-Signature-Timestamp"}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for hmac secret without header")
+	}
+	if !strings.Contains(err.Error(), "client.hmac.header") {
+		t.Errorf("error = %q, want it to mention \"client.hmac.header\"", err.Error())
+	}
+}
+
+func TestValidate_MaxElapsedTimeBelowInitialInterval(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Client.Retry.MaxElapsedTime = 50 * time.Millisecond
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for max_elapsed_time below initial_interval")
+	}
+	if !strings.Contains(err.Error(), "client.retry.max_elapsed_time") {
+		t.Errorf("error = %q, want it to mention \"client.retry.max_elapsed_time\"", err.Error())
+	}
+}
+
+func TestValidate_RetryDisabledSkipsRetrySettings(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Client.Retry.Enabled = false
+	cfg.Client.Retry.MaxAttempts = 0
+	cfg.Client.Retry.InitialInterval = 0
+	cfg.Client.Retry.Multiplier = 0
+
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil when retries are disabled", err)
+	}
+}
+
+func TestValidate_NegativeMaxQueryLength(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Server.MaxQueryLength = -1
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for negative max_query_length")
+	}
+	if !strings.Contains(err.Error(), "server.max_query_length") {
+		t.Errorf("error = %q, want it to mention \"server.max_query_length\"", err.Error())
+	}
+}
+
+func TestValidate_NegativeMaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Server.MaxConcurrentRequests = -1
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for negative max_concurrent_requests")
+	}
+	if !strings.Contains(err.Error(), "server.max_concurrent_requests") {
+		t.Errorf("error = %q, want it to mention \"server.max_concurrent_requests\"", err.Error())
+	}
+}
+
+func TestValidate_AdminPort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		adminPort int
+	}{
+		{name: "zero", adminPort: 0},
+		{name: "out of range", adminPort: 70000},
+		{name: "same as server.port", adminPort: 8080},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := validBaseConfig()
+			cfg.Server.AdminPort = tt.adminPort
+
+			err := cfg.Validate()
+			if err == nil {
+				t.Fatalf("Validate() returned nil, want error for admin_port %d", tt.adminPort)
+			}
+			if !strings.Contains(err.Error(), "server.admin_port") {
+				t.Errorf("error = %q, want it to mention \"server.admin_port\"", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidate_ServerRateLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		modify func(*config.InboundRateLimitConfig)
+	}{
+		{name: "negative rate", modify: func(rl *config.InboundRateLimitConfig) { rl.RequestsPerSecond = -1 }},
+		{name: "zero burst when enabled", modify: func(rl *config.InboundRateLimitConfig) {
+			rl.RequestsPerSecond = 10
+			rl.BurstSize = 0
+			rl.MaxClients = 100
+		}},
+		{name: "zero max clients when enabled", modify: func(rl *config.InboundRateLimitConfig) {
+			rl.RequestsPerSecond = 10
+			rl.BurstSize = 5
+			rl.MaxClients = 0
+		}},
+		{name: "negative idle ttl", modify: func(rl *config.InboundRateLimitConfig) { rl.IdleTTL = -time.Second }},
+		{name: "invalid bypass CIDR", modify: func(rl *config.InboundRateLimitConfig) { rl.Bypass = []string{"10.0.0.1"} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := validBaseConfig()
+			tt.modify(&cfg.Server.RateLimit)
+
+			err := cfg.Validate()
+			if err == nil {
+				t.Fatal("Validate() returned nil, want error")
+			}
+			if !strings.Contains(err.Error(), "server.rate_limit.") {
+				t.Errorf("error = %q, want it to mention \"server.rate_limit.\"", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidate_NegativeMaxTodosPerProject(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Todo.MaxPerProject = -1
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for negative max_per_project")
+	}
+	if !strings.Contains(err.Error(), "todo.max_per_project") {
+		t.Errorf("error = %q, want it to mention \"todo.max_per_project\"", err.Error())
+	}
+}
+
+func TestValidate_EnforceOwnershipNeedsIdentity(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Project.EnforceOwnership = true
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for enforce_ownership without identity")
+	}
+	if !strings.Contains(err.Error(), "project.enforce_ownership") {
+		t.Errorf("error = %q, want it to mention \"project.enforce_ownership\"", err.Error())
+	}
+
+	cfg.Identity.Source = "header"
+	cfg.Identity.Header = "X-User-ID"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() with identity.source error = %v, want nil", err)
+	}
+}
+
+func TestValidate_StartupProbeTimeout(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Startup = config.StartupConfig{ProbeDownstream: true}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want error for a probe without a timeout")
+	}
+	if !strings.Contains(err.Error(), "startup.probe_timeout") {
+		t.Errorf("error = %q, want it to mention \"startup.probe_timeout\"", err.Error())
+	}
+
+	cfg.Startup.ProbeDownstream = false
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() with the probe off error = %v, want nil", err)
+	}
+}
+
 func TestValidate_OtlpWithoutEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -432,6 +1027,42 @@ func TestValidate_InvalidTelemetryExporter(t *testing.T) {
 	}
 }
 
+func TestValidate_TelemetryExportTimeout(t *testing.T) {
+	t.Parallel()
+
+	for _, timeout := range []time.Duration{0, -time.Second} {
+		cfg := validBaseConfig()
+		cfg.Telemetry.Enabled = true
+		cfg.Telemetry.ExportTimeout = timeout
+
+		err := cfg.Validate()
+		if err == nil {
+			t.Fatalf("Validate() returned nil, want error for export_timeout %v", timeout)
+		}
+		if !strings.Contains(err.Error(), "telemetry.export_timeout") {
+			t.Errorf("error = %q, want it to mention \"telemetry.export_timeout\"", err.Error())
+		}
+	}
+}
+
+func TestValidate_TelemetryMetricInterval(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		cfg := validBaseConfig()
+		cfg.Telemetry.Enabled = true
+		cfg.Telemetry.MetricInterval = interval
+
+		err := cfg.Validate()
+		if err == nil {
+			t.Fatalf("Validate() returned nil, want error for metric_interval %v", interval)
+		}
+		if !strings.Contains(err.Error(), "telemetry.metric_interval") {
+			t.Errorf("error = %q, want it to mention \"telemetry.metric_interval\"", err.Error())
+		}
+	}
+}
+
 func TestValidate_TelemetryDisabledSkipsValidation(t *testing.T) {
 	t.Parallel()
 
@@ -439,12 +1070,47 @@ func TestValidate_TelemetryDisabledSkipsValidation(t *testing.T) {
 	cfg.Telemetry.Enabled = false
 	cfg.Telemetry.Exporter = "invalid"
 	cfg.Telemetry.Endpoint = ""
+	cfg.Telemetry.ExportTimeout = 0
+	cfg.Telemetry.MetricInterval = 0
 
 	if err := cfg.Validate(); err != nil {
 		t.Fatalf("Validate() returned error for disabled telemetry: %v", err)
 	}
 }
 
+func TestValidate_ReportsEveryFailure(t *testing.T) {
+	t.Parallel()
+
+	cfg := validBaseConfig()
+	cfg.Server.Port = 0
+	cfg.Server.ReadTimeout = 0
+	cfg.Log.Level = "verbose"
+	cfg.Client.BaseURL = ""
+	cfg.Client.Retry.MaxAttempts = 0
+	cfg.Todo.MaxPerProject = -1
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() returned nil, want errors")
+	}
+	for _, field := range []string{
+		"server.port",
+		"server.read_timeout",
+		"log.level",
+		"client.base_url",
+		"client.retry.max_attempts",
+		"todo.max_per_project",
+	} {
+		if !strings.Contains(err.Error(), field) {
+			t.Errorf("error = %q, want it to mention %q", err.Error(), field)
+		}
+	}
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) || len(joined.Unwrap()) < 2 {
+		t.Errorf("Validate() error %T does not unwrap to the individual errors", err)
+	}
+}
+
 func TestValidate_ValidConfig(t *testing.T) {
 	t.Parallel()
 
@@ -458,11 +1124,14 @@ func TestValidate_ValidConfig(t *testing.T) {
 func validBaseConfig() *config.Config {
 	return &config.Config{
 		Server: config.ServerConfig{
-			Host:         "0.0.0.0",
-			Port:         8080,
-			ReadTimeout:  5 * time.Second,
-			WriteTimeout: 10 * time.Second,
-			IdleTimeout:  120 * time.Second,
+			Host:              "0.0.0.0",
+			Port:              8080,
+			AdminPort:         9090,
+			ReadTimeout:       5 * time.Second,
+			WriteTimeout:      10 * time.Second,
+			AdminWriteTimeout: 60 * time.Second,
+			IdleTimeout:       120 * time.Second,
+			ShutdownTimeout:   15 * time.Second,
 		},
 		Log: config.LogConfig{
 			Level:  "info",
@@ -472,10 +1141,12 @@ func validBaseConfig() *config.Config {
 			BaseURL: "http://localhost:8081",
 			Timeout: 30 * time.Second,
 			Retry: config.RetryConfig{
+				Enabled:         true,
 				MaxAttempts:     3,
 				InitialInterval: 100 * time.Millisecond,
 				MaxInterval:     10 * time.Second,
 				Multiplier:      2.0,
+				JitterFraction:  0.25,
 			},
 			CircuitBreaker: config.CircuitBreakerConfig{
 				MaxFailures:   5,
@@ -484,8 +1155,30 @@ func validBaseConfig() *config.Config {
 			},
 		},
 		Telemetry: config.TelemetryConfig{
-			Enabled:  false,
-			Exporter: "stdout",
+			Enabled:        false,
+			Exporter:       "stdout",
+			ExportTimeout:  10 * time.Second,
+			MetricInterval: 60 * time.Second,
 		},
 	}
 }
+
+func TestChangedKeys(t *testing.T) {
+	t.Parallel()
+
+	old := validBaseConfig()
+	updated := validBaseConfig()
+	updated.Log.Level = "debug"
+	updated.Server.Port = 9090
+	updated.Client.Retry.MaxAttempts = 5
+
+	got := config.ChangedKeys(old, updated)
+	want := []string{"server.port", "log.level", "client.retry.max_attempts"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedKeys() = %v, want %v", got, want)
+	}
+
+	if got := config.ChangedKeys(old, validBaseConfig()); len(got) != 0 {
+		t.Errorf("ChangedKeys() of equal configs = %v, want empty", got)
+	}
+}
@@ -3,30 +3,102 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
 )
 
 // Validate checks all configuration values and returns aggregated errors.
+// Every failure is reported, one per line, so a misconfigured deploy shows
+// all of its problems at once; the result unwraps to the individual errors.
 func (c *Config) Validate() error {
 	return errors.Join(
 		c.Server.validate(),
 		c.Log.validate(),
 		c.Client.validate(),
 		c.Telemetry.validate(),
+		c.Todo.validate(),
+		c.Identity.validate(),
+		c.validateOwnership(),
+		c.Startup.validate(),
 	)
 }
 
+// validateOwnership checks that ownership enforcement has an identity to
+// enforce against.
+func (c *Config) validateOwnership() error {
+	if c.Project.EnforceOwnership && c.Identity.Source == "" {
+		return errors.New("project.enforce_ownership needs identity.source to be set")
+	}
+	return nil
+}
+
 func (s *ServerConfig) validate() error {
 	var errs []error
 
 	if s.Port < 1 || s.Port > 65535 {
 		errs = append(errs, fmt.Errorf("server.port must be between 1 and 65535, got %d", s.Port))
 	}
+	if s.AdminPort < 1 || s.AdminPort > 65535 {
+		errs = append(errs, fmt.Errorf("server.admin_port must be between 1 and 65535, got %d", s.AdminPort))
+	} else if s.AdminPort == s.Port {
+		errs = append(errs, fmt.Errorf("server.admin_port must differ from server.port, both are %d", s.Port))
+	}
 	if s.ReadTimeout <= 0 {
 		errs = append(errs, errors.New("server.read_timeout must be positive"))
 	}
 	if s.WriteTimeout <= 0 {
 		errs = append(errs, errors.New("server.write_timeout must be positive"))
 	}
+	if s.AdminWriteTimeout <= 0 {
+		errs = append(errs, errors.New("server.admin_write_timeout must be positive"))
+	}
+	if s.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("server.shutdown_timeout must be positive"))
+	}
+	for i, cidr := range s.TrustedProxies {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			errs = append(errs, fmt.Errorf("server.trusted_proxies[%d] must be a valid CIDR, got %q", i, cidr))
+		}
+	}
+	if s.MaxQueryLength < 0 {
+		errs = append(errs, errors.New("server.max_query_length must not be negative"))
+	}
+	if s.MaxConcurrentRequests < 0 {
+		errs = append(errs, errors.New("server.max_concurrent_requests must not be negative"))
+	}
+	if s.HealthCacheTTL < 0 {
+		errs = append(errs, errors.New("server.health_cache_ttl must not be negative"))
+	}
+	errs = append(errs, s.RateLimit.validate())
+
+	return errors.Join(errs...)
+}
+
+func (rl *InboundRateLimitConfig) validate() error {
+	var errs []error
+
+	if rl.RequestsPerSecond < 0 {
+		errs = append(errs, errors.New("server.rate_limit.requests_per_second must not be negative"))
+	}
+	if rl.RequestsPerSecond > 0 {
+		if rl.BurstSize < 1 {
+			errs = append(errs, fmt.Errorf("server.rate_limit.burst_size must be >= 1 when rate limiting is enabled, got %d",
+				rl.BurstSize))
+		}
+		if rl.MaxClients < 1 {
+			errs = append(errs, fmt.Errorf("server.rate_limit.max_clients must be >= 1 when rate limiting is enabled, got %d",
+				rl.MaxClients))
+		}
+	}
+	if rl.IdleTTL < 0 {
+		errs = append(errs, errors.New("server.rate_limit.idle_ttl must not be negative"))
+	}
+	for i, cidr := range rl.Bypass {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			errs = append(errs, fmt.Errorf("server.rate_limit.bypass[%d] must be a valid CIDR, got %q", i, cidr))
+		}
+	}
 
 	return errors.Join(errs...)
 }
@@ -48,6 +120,12 @@ func (l *LogConfig) validate() error {
 		errs = append(errs, fmt.Errorf("log.format must be one of: json, text; got %q", l.Format))
 	}
 
+	for i, f := range l.RedactFields {
+		if strings.TrimSpace(f) == "" {
+			errs = append(errs, fmt.Errorf("log.redact_fields[%d] must not be empty", i))
+		}
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -60,22 +138,28 @@ func (cl *ClientConfig) validate() error {
 	if cl.Timeout <= 0 {
 		errs = append(errs, errors.New("client.timeout must be positive"))
 	}
-	if cl.Retry.MaxAttempts < 1 {
-		errs = append(errs, fmt.Errorf("client.retry.max_attempts must be >= 1, got %d", cl.Retry.MaxAttempts))
+	if cl.DialTimeout < 0 {
+		errs = append(errs, errors.New("client.dial_timeout must not be negative"))
 	}
-	if cl.Retry.InitialInterval <= 0 {
-		errs = append(errs, errors.New("client.retry.initial_interval must be positive"))
+	if cl.TLSHandshakeTimeout < 0 {
+		errs = append(errs, errors.New("client.tls_handshake_timeout must not be negative"))
 	}
-	if cl.Retry.MaxInterval <= 0 {
-		errs = append(errs, errors.New("client.retry.max_interval must be positive"))
+	if cl.ResponseHeaderTimeout < 0 {
+		errs = append(errs, errors.New("client.response_header_timeout must not be negative"))
 	}
-	if cl.Retry.Multiplier <= 0 {
-		errs = append(errs, fmt.Errorf("client.retry.multiplier must be positive, got %f", cl.Retry.Multiplier))
+	if cl.ExpectContinueTimeout < 0 {
+		errs = append(errs, errors.New("client.expect_continue_timeout must not be negative"))
 	}
-	if cl.Retry.InitialInterval > 0 && cl.Retry.MaxInterval > 0 && cl.Retry.InitialInterval > cl.Retry.MaxInterval {
-		errs = append(errs, fmt.Errorf(
-			"client.retry.initial_interval (%v) must not exceed max_interval (%v)",
-			cl.Retry.InitialInterval, cl.Retry.MaxInterval))
+	if cl.MaxResponseBytes < 0 {
+		errs = append(errs, errors.New("client.max_response_bytes must not be negative"))
+	}
+	if cl.HealthPath != "" && !strings.HasPrefix(cl.HealthPath, "/") {
+		errs = append(errs, fmt.Errorf("client.health_path must start with /, got %q", cl.HealthPath))
+	}
+	if cl.Retry.Enabled {
+		if err := cl.Retry.validate(); err != nil {
+			errs = append(errs, err)
+		}
 	}
 	if cl.CircuitBreaker.MaxFailures < 1 {
 		errs = append(errs, fmt.Errorf("client.circuit_breaker.max_failures must be >= 1, got %d",
@@ -88,6 +172,59 @@ func (cl *ClientConfig) validate() error {
 		errs = append(errs, fmt.Errorf("client.rate_limit.burst_size must be >= 1 when rate limiting is enabled, got %d",
 			cl.RateLimit.BurstSize))
 	}
+	if cl.HMAC.Secret != "" && cl.HMAC.Header == "" {
+		errs = append(errs, errors.New("client.hmac.header must not be empty when signing is enabled"))
+	}
+	if cl.HMAC.Secret != "" && cl.HMAC.TimestampHeader == "" {
+		errs = append(errs, errors.New("client.hmac.timestamp_header must not be empty when signing is enabled"))
+	}
+	if cl.OAuth.TokenURL != "" && (cl.OAuth.ClientID == "" || cl.OAuth.ClientSecret == "") {
+		errs = append(errs, errors.New("client.oauth.client_id and client_secret must not be empty when token_url is set"))
+	}
+	if cl.OAuth.RefreshBefore < 0 {
+		errs = append(errs, errors.New("client.oauth.refresh_before must not be negative"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validate checks retry policy settings. Only called when retries are enabled.
+func (r *RetryConfig) validate() error {
+	var errs []error
+
+	if r.MaxAttempts < 1 {
+		errs = append(errs, fmt.Errorf("client.retry.max_attempts must be >= 1, got %d", r.MaxAttempts))
+	}
+	if r.InitialInterval <= 0 {
+		errs = append(errs, errors.New("client.retry.initial_interval must be positive"))
+	}
+	if r.MaxInterval <= 0 {
+		errs = append(errs, errors.New("client.retry.max_interval must be positive"))
+	}
+	if r.Multiplier <= 0 {
+		errs = append(errs, fmt.Errorf("client.retry.multiplier must be positive, got %f", r.Multiplier))
+	}
+	for i, code := range r.RetryableStatuses {
+		if code < 400 || code > 599 {
+			errs = append(errs, fmt.Errorf("client.retry.retryable_statuses[%d] must be a 4xx or 5xx status, got %d", i, code))
+		}
+	}
+	if r.JitterFraction < 0 || r.JitterFraction > 1 {
+		errs = append(errs, fmt.Errorf("client.retry.jitter_fraction must be between 0 and 1, got %g", r.JitterFraction))
+	}
+	if r.InitialInterval > 0 && r.MaxInterval > 0 && r.InitialInterval > r.MaxInterval {
+		errs = append(errs, fmt.Errorf(
+			"client.retry.initial_interval (%v) must not exceed max_interval (%v)",
+			r.InitialInterval, r.MaxInterval))
+	}
+	if r.MaxElapsedTime < 0 {
+		errs = append(errs, errors.New("client.retry.max_elapsed_time must not be negative"))
+	}
+	if r.MaxElapsedTime > 0 && r.MaxElapsedTime < r.InitialInterval {
+		errs = append(errs, fmt.Errorf(
+			"client.retry.max_elapsed_time (%v) must be >= initial_interval (%v)",
+			r.MaxElapsedTime, r.InitialInterval))
+	}
 
 	return errors.Join(errs...)
 }
@@ -109,6 +246,78 @@ func (t *TelemetryConfig) validate() error {
 	if t.Exporter == "otlp" && t.Endpoint == "" {
 		errs = append(errs, errors.New("telemetry.endpoint must not be empty when exporter is otlp"))
 	}
+	if t.ExportTimeout <= 0 {
+		errs = append(errs, errors.New("telemetry.export_timeout must be positive"))
+	}
+	if t.MetricInterval <= 0 {
+		errs = append(errs, errors.New("telemetry.metric_interval must be positive"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (t *TodoConfig) validate() error {
+	if t.MaxPerProject < 0 {
+		return errors.New("todo.max_per_project must not be negative")
+	}
+	return nil
+}
+
+// minJWTSecretBytes is the shortest accepted HS256 secret: the hash size.
+const minJWTSecretBytes = 32
+
+func (id *IdentityConfig) validate() error {
+	var errs []error
+
+	switch id.Source {
+	case "":
+		if id.Required {
+			errs = append(errs, errors.New("identity.required needs identity.source to be set"))
+		}
+	case "header":
+		if strings.TrimSpace(id.Header) == "" {
+			errs = append(errs, errors.New("identity.header must not be empty when identity.source is header"))
+		}
+	case "jwt":
+		errs = append(errs, id.JWT.validate())
+		if strings.TrimSpace(id.JWT.Claim) == "" {
+			errs = append(errs, errors.New("identity.jwt.claim must not be empty when identity.source is jwt"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("identity.source must be one of: header, jwt, or empty; got %q", id.Source))
+	}
 
 	return errors.Join(errs...)
 }
+
+func (j *JWTConfig) validate() error {
+	var errs []error
+
+	switch {
+	case j.JWKSURL != "" && j.Secret != "":
+		errs = append(errs, errors.New("identity.jwt.jwks_url and identity.jwt.secret are mutually exclusive"))
+	case j.JWKSURL != "":
+		if u, err := url.Parse(j.JWKSURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("identity.jwt.jwks_url must be an http(s) URL, got %q", j.JWKSURL))
+		}
+		if j.JWKSCacheTTL <= 0 {
+			errs = append(errs, errors.New("identity.jwt.jwks_cache_ttl must be positive"))
+		}
+		if j.Issuer == "" || j.Audience == "" {
+			errs = append(errs, errors.New("identity.jwt.issuer and audience must be set with jwks_url"))
+		}
+	case len(j.Secret) < minJWTSecretBytes:
+		errs = append(errs, fmt.Errorf("identity.jwt.secret must be at least %d bytes when identity.source is jwt without a jwks_url",
+			minJWTSecretBytes))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *StartupConfig) validate() error {
+	if s.ProbeDownstream && s.ProbeTimeout <= 0 {
+		return fmt.Errorf("startup.probe_timeout must be positive when startup.probe_downstream is set, got %s",
+			s.ProbeTimeout)
+	}
+	return nil
+}
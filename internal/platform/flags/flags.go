@@ -0,0 +1,89 @@
+// Package flags resolves feature flags per request. Defaults come from
+// configuration; where header overrides are allowed (never in production),
+// the X-Feature-Flags request header can switch individual flags on or off
+// for that request only.
+package flags
+
+import (
+	"context"
+	"maps"
+	"strconv"
+	"strings"
+)
+
+// Header carries per-request flag overrides as a comma-separated list of
+// "name" (on) or "name=<bool>" entries, e.g. "envelope,degrade_reads=false".
+// Malformed entries are ignored.
+const Header = "X-Feature-Flags"
+
+type contextKey struct{}
+
+// Provider resolves the flag set for a request from configured defaults and,
+// when enabled, the override header.
+type Provider struct {
+	defaults    map[string]bool
+	allowHeader bool
+}
+
+// Option configures a Provider.
+type Option func(*Provider)
+
+// WithHeaderOverride lets the override header change flag values. Only
+// enable this where callers are trusted, i.e. outside production.
+func WithHeaderOverride(allow bool) Option {
+	return func(p *Provider) {
+		p.allowHeader = allow
+	}
+}
+
+// NewProvider creates a Provider with the given default flag values. The
+// map is copied, so later changes by the caller have no effect.
+func NewProvider(defaults map[string]bool, opts ...Option) *Provider {
+	p := &Provider{defaults: maps.Clone(defaults)}
+	if p.defaults == nil {
+		p.defaults = map[string]bool{}
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Resolve returns the flags for one request: the defaults overlaid with the
+// entries in header, if header overrides are allowed. The result is a fresh
+// map owned by the caller.
+func (p *Provider) Resolve(header string) map[string]bool {
+	set := maps.Clone(p.defaults)
+	if !p.allowHeader || header == "" {
+		return set
+	}
+	for entry := range strings.SplitSeq(header, ",") {
+		name, raw, hasValue := strings.Cut(strings.TrimSpace(entry), "=")
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		on := true
+		if hasValue {
+			v, err := strconv.ParseBool(strings.TrimSpace(raw))
+			if err != nil {
+				continue
+			}
+			on = v
+		}
+		set[name] = on
+	}
+	return set
+}
+
+// WithFlags returns a new context carrying the resolved flag set.
+func WithFlags(ctx context.Context, set map[string]bool) context.Context {
+	return context.WithValue(ctx, contextKey{}, set)
+}
+
+// Enabled reports whether the named flag is on for the request in ctx.
+// Unknown flags, and contexts without a flag set, report false.
+func Enabled(ctx context.Context, name string) bool {
+	set, _ := ctx.Value(contextKey{}).(map[string]bool)
+	return set[name]
+}
@@ -0,0 +1,108 @@
+package flags_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/flags"
+)
+
+func TestProvider_Resolve(t *testing.T) {
+	t.Parallel()
+
+	defaults := map[string]bool{"envelope": true, "degrade_reads": false}
+
+	tests := []struct {
+		name        string
+		allowHeader bool
+		header      string
+		want        map[string]bool
+	}{
+		{
+			name: "defaults without header",
+			want: map[string]bool{"envelope": true, "degrade_reads": false},
+		},
+		{
+			name:        "header turns flags on and off",
+			allowHeader: true,
+			header:      "degrade_reads, envelope=false",
+			want:        map[string]bool{"envelope": false, "degrade_reads": true},
+		},
+		{
+			name:        "header adds unknown flag",
+			allowHeader: true,
+			header:      "beta=true",
+			want:        map[string]bool{"envelope": true, "degrade_reads": false, "beta": true},
+		},
+		{
+			name:        "malformed entries ignored",
+			allowHeader: true,
+			header:      ",=true,envelope=maybe",
+			want:        map[string]bool{"envelope": true, "degrade_reads": false},
+		},
+		{
+			name:   "header ignored when override disallowed",
+			header: "degrade_reads,envelope=false",
+			want:   map[string]bool{"envelope": true, "degrade_reads": false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := flags.NewProvider(defaults, flags.WithHeaderOverride(tt.allowHeader))
+			got := p.Resolve(tt.header)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Resolve(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+			for name, want := range tt.want {
+				if got[name] != want {
+					t.Errorf("Resolve(%q)[%q] = %v, want %v", tt.header, name, got[name], want)
+				}
+			}
+		})
+	}
+}
+
+func TestProvider_ResolveDoesNotMutateDefaults(t *testing.T) {
+	t.Parallel()
+
+	defaults := map[string]bool{"envelope": false}
+	p := flags.NewProvider(defaults, flags.WithHeaderOverride(true))
+
+	_ = p.Resolve("envelope")
+	if got := p.Resolve(""); got["envelope"] {
+		t.Error("Resolve(\"\")[envelope] = true after an override, want false")
+	}
+	if defaults["envelope"] {
+		t.Error("caller's defaults map was modified")
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := flags.WithFlags(context.Background(), map[string]bool{"on": true, "off": false})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		flag string
+		want bool
+	}{
+		{name: "default on", ctx: ctx, flag: "on", want: true},
+		{name: "default off", ctx: ctx, flag: "off", want: false},
+		{name: "unknown flag", ctx: ctx, flag: "missing", want: false},
+		{name: "no flags in context", ctx: context.Background(), flag: "on", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := flags.Enabled(tt.ctx, tt.flag); got != tt.want {
+				t.Errorf("Enabled(%q) = %v, want %v", tt.flag, got, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package health
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
 )
@@ -15,15 +16,56 @@ var _ ports.HealthRegistry = (*Registry)(nil)
 
 // Registry is a thread-safe implementation of [ports.HealthRegistry].
 // Components that implement [ports.HealthChecker] are registered at startup
-// and checked on each readiness probe.
+// and checked on each readiness probe. The last result of each checker is
+// kept, so probes within the cache interval reuse it instead of re-running
+// the check.
 type Registry struct {
-	mu       sync.RWMutex
-	checkers []ports.HealthChecker
+	mu         sync.RWMutex
+	checkers   []ports.HealthChecker
+	activePing bool
+	cacheTTL   time.Duration
+
+	// runMu serializes refreshes so concurrent probes do not stampede an
+	// expensive check. It guards results, indexed like checkers.
+	runMu   sync.Mutex
+	results []cachedResult
+}
+
+// cachedResult is a checker's last result. A result cut short by the
+// caller's context is kept for Snapshot but never reused.
+type cachedResult struct {
+	ports.HealthResult
+	reusable bool
+}
+
+// Option configures optional Registry behavior.
+type Option func(*Registry)
+
+// WithActivePing makes CheckAll call Ping on checkers that implement
+// [ports.Pinger] instead of their passive HealthCheck. Checkers that cannot
+// ping are still checked passively. Disabled by default.
+func WithActivePing(enabled bool) Option {
+	return func(r *Registry) {
+		r.activePing = enabled
+	}
+}
+
+// WithCacheTTL reuses a checker's last result until it is older than d, so
+// frequent probes run each check at most once per d. Zero, the default,
+// runs every check on every call.
+func WithCacheTTL(d time.Duration) Option {
+	return func(r *Registry) {
+		r.cacheTTL = max(0, d)
+	}
 }
 
 // New creates an empty health check registry.
-func New() *Registry {
-	return &Registry{}
+func New(opts ...Option) *Registry {
+	r := &Registry{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 // Register adds a health checker to the registry. Safe for concurrent use.
@@ -34,17 +76,64 @@ func (r *Registry) Register(checker ports.HealthChecker) {
 }
 
 // CheckAll executes all registered health checks and returns results keyed by
-// checker name. Nil values indicate healthy components. The slice is copied
-// under a read lock so checks run without holding the lock.
+// checker name. Nil values indicate healthy components. Results younger than
+// the cache TTL are reused; see Snapshot.
 func (r *Registry) CheckAll(ctx context.Context) map[string]error {
+	snapshot := r.Snapshot(ctx)
+	results := make(map[string]error, len(snapshot))
+	for _, res := range snapshot {
+		results[res.Name] = res.Err
+	}
+	return results
+}
+
+// Snapshot returns the latest result of every registered checker in
+// registration order. Checks with no result yet, or one older than the
+// cache TTL, are run first. The checker slice is copied under a read lock
+// so checks run without holding it.
+func (r *Registry) Snapshot(ctx context.Context) []ports.HealthResult {
 	r.mu.RLock()
 	checkers := make([]ports.HealthChecker, len(r.checkers))
 	copy(checkers, r.checkers)
 	r.mu.RUnlock()
 
-	results := make(map[string]error, len(checkers))
-	for _, c := range checkers {
-		results[c.Name()] = c.HealthCheck(ctx)
+	r.runMu.Lock()
+	defer r.runMu.Unlock()
+
+	for len(r.results) < len(checkers) {
+		r.results = append(r.results, cachedResult{})
 	}
-	return results
+
+	snapshot := make([]ports.HealthResult, len(checkers))
+	for i, c := range checkers {
+		if !r.fresh(r.results[i]) {
+			start := time.Now()
+			err := r.check(ctx, c)
+			r.results[i] = cachedResult{
+				HealthResult: ports.HealthResult{
+					Name:      c.Name(),
+					Err:       err,
+					Duration:  time.Since(start),
+					CheckedAt: start,
+				},
+				reusable: ctx.Err() == nil,
+			}
+		}
+		snapshot[i] = r.results[i].HealthResult
+	}
+	return snapshot
+}
+
+// fresh reports whether res can be reused under the cache TTL. Callers hold
+// r.runMu.
+func (r *Registry) fresh(res cachedResult) bool {
+	return r.cacheTTL > 0 && res.reusable && time.Since(res.CheckedAt) < r.cacheTTL
+}
+
+// check runs a single checker, preferring an active ping when enabled.
+func (r *Registry) check(ctx context.Context, c ports.HealthChecker) error {
+	if p, ok := c.(ports.Pinger); ok && r.activePing {
+		return p.Ping(ctx)
+	}
+	return c.HealthCheck(ctx)
 }
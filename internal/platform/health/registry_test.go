@@ -5,6 +5,7 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -164,3 +165,144 @@ func TestCheckAll_ConcurrentSafety(t *testing.T) {
 
 	wg.Wait()
 }
+
+// pingingChecker is a HealthChecker that also implements ports.Pinger and
+// reports a distinct result from each method.
+type pingingChecker struct {
+	healthErr error
+	pingErr   error
+}
+
+func (pingingChecker) Name() string                        { return "todo-api" }
+func (c pingingChecker) HealthCheck(context.Context) error { return c.healthErr }
+func (c pingingChecker) Ping(context.Context) error        { return c.pingErr }
+
+func TestCheckAll_PassiveByDefault(t *testing.T) {
+	t.Parallel()
+
+	pingErr := errors.New("ping failed")
+	r := health.New()
+	r.Register(pingingChecker{pingErr: pingErr})
+
+	if err := r.CheckAll(context.Background())["todo-api"]; err != nil {
+		t.Errorf("todo-api check = %v, want nil (passive HealthCheck)", err)
+	}
+}
+
+func TestCheckAll_ActivePing(t *testing.T) {
+	t.Parallel()
+
+	pingErr := errors.New("ping failed")
+	r := health.New(health.WithActivePing(true))
+	r.Register(pingingChecker{pingErr: pingErr})
+
+	if err := r.CheckAll(context.Background())["todo-api"]; !errors.Is(err, pingErr) {
+		t.Errorf("todo-api check = %v, want %v", err, pingErr)
+	}
+}
+
+func TestCheckAll_ActivePingFallsBackToHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	checker := mocks.NewMockHealthChecker(t)
+	checker.EXPECT().Name().Return("db")
+	checker.EXPECT().HealthCheck(mock.Anything).Return(nil)
+
+	r := health.New(health.WithActivePing(true))
+	r.Register(checker)
+
+	if err := r.CheckAll(context.Background())["db"]; err != nil {
+		t.Errorf("db check = %v, want nil", err)
+	}
+}
+
+func TestSnapshot_ReflectsFailingChecker(t *testing.T) {
+	t.Parallel()
+
+	healthy := mocks.NewMockHealthChecker(t)
+	healthy.EXPECT().Name().Return("config")
+	healthy.EXPECT().HealthCheck(mock.Anything).Return(nil)
+
+	checkErr := errors.New("connection refused")
+	failing := mocks.NewMockHealthChecker(t)
+	failing.EXPECT().Name().Return("todo-api")
+	failing.EXPECT().HealthCheck(mock.Anything).Return(checkErr)
+
+	r := health.New()
+	r.Register(healthy)
+	r.Register(failing)
+
+	before := time.Now()
+	snapshot := r.Snapshot(context.Background())
+
+	if len(snapshot) != 2 {
+		t.Fatalf("len(Snapshot()) = %d, want 2", len(snapshot))
+	}
+	if snapshot[0].Name != "config" || snapshot[0].Err != nil {
+		t.Errorf("snapshot[0] = %+v, want healthy config", snapshot[0])
+	}
+	got := snapshot[1]
+	if got.Name != "todo-api" || !errors.Is(got.Err, checkErr) {
+		t.Errorf("snapshot[1] = %+v, want todo-api failing with %v", got, checkErr)
+	}
+	if got.CheckedAt.Before(before) {
+		t.Errorf("CheckedAt = %v, want at or after %v", got.CheckedAt, before)
+	}
+	if got.Duration < 0 {
+		t.Errorf("Duration = %v, want non-negative", got.Duration)
+	}
+}
+
+func TestSnapshot_ReusesResultWithinCacheTTL(t *testing.T) {
+	t.Parallel()
+
+	checker := mocks.NewMockHealthChecker(t)
+	checker.EXPECT().Name().Return("todo-api")
+	checker.EXPECT().HealthCheck(mock.Anything).Return(nil).Once()
+
+	r := health.New(health.WithCacheTTL(time.Hour))
+	r.Register(checker)
+
+	first := r.Snapshot(context.Background())
+	second := r.Snapshot(context.Background())
+	r.CheckAll(context.Background())
+
+	if !second[0].CheckedAt.Equal(first[0].CheckedAt) {
+		t.Errorf("CheckedAt changed from %v to %v within the cache TTL", first[0].CheckedAt, second[0].CheckedAt)
+	}
+}
+
+func TestSnapshot_RerunsAfterCacheTTL(t *testing.T) {
+	t.Parallel()
+
+	checker := mocks.NewMockHealthChecker(t)
+	checker.EXPECT().Name().Return("todo-api")
+	checker.EXPECT().HealthCheck(mock.Anything).Return(nil).Twice()
+
+	r := health.New(health.WithCacheTTL(time.Millisecond))
+	r.Register(checker)
+
+	r.Snapshot(context.Background())
+	time.Sleep(5 * time.Millisecond)
+	r.Snapshot(context.Background())
+}
+
+func TestSnapshot_DoesNotReuseCanceledCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	checker := mocks.NewMockHealthChecker(t)
+	checker.EXPECT().Name().Return("todo-api")
+	checker.EXPECT().HealthCheck(mock.Anything).Return(context.Canceled).Once()
+	checker.EXPECT().HealthCheck(mock.Anything).Return(nil).Once()
+
+	r := health.New(health.WithCacheTTL(time.Hour))
+	r.Register(checker)
+
+	r.Snapshot(ctx)
+	if got := r.Snapshot(context.Background()); got[0].Err != nil {
+		t.Errorf("Err = %v, want nil from a fresh run", got[0].Err)
+	}
+}
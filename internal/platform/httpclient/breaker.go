@@ -0,0 +1,88 @@
+package httpclient
+
+import (
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/sony/gobreaker/v2"
+)
+
+// Circuit breaker states reported by BreakerState. BreakerForcedOpen is the
+// operator override set by ForceOpen; the rest mirror gobreaker.State.
+const (
+	BreakerClosed     = "closed"
+	BreakerHalfOpen   = "half-open"
+	BreakerOpen       = "open"
+	BreakerForcedOpen = "forced-open"
+)
+
+// circuitBreaker wraps a gobreaker.CircuitBreaker with operator overrides.
+// gobreaker exposes no way to change state directly, so forcing open is a
+// flag consulted before Execute, and forcing closed swaps in a fresh breaker
+// built from the original settings.
+type circuitBreaker struct {
+	settings   gobreaker.Settings
+	current    atomic.Pointer[gobreaker.CircuitBreaker[struct{}]]
+	forcedOpen atomic.Bool
+}
+
+func newCircuitBreaker(settings gobreaker.Settings) *circuitBreaker {
+	cb := &circuitBreaker{settings: settings}
+	cb.current.Store(gobreaker.NewCircuitBreaker[struct{}](settings))
+	return cb
+}
+
+// execute runs fn through the current breaker. While forced open it rejects
+// without calling fn, wrapping gobreaker.ErrOpenState so callers and metrics
+// treat it like a tripped breaker.
+func (cb *circuitBreaker) execute(fn func() (struct{}, error)) error {
+	if cb.forcedOpen.Load() {
+		return fmt.Errorf("%s: circuit breaker forced open: %w", cb.settings.Name, gobreaker.ErrOpenState)
+	}
+	_, err := cb.current.Load().Execute(fn)
+	return err
+}
+
+// state returns the current gobreaker state, or gobreaker.StateOpen while forced open.
+func (cb *circuitBreaker) state() gobreaker.State {
+	if cb.forcedOpen.Load() {
+		return gobreaker.StateOpen
+	}
+	return cb.current.Load().State()
+}
+
+// ForceOpen makes the client reject every request with
+// gobreaker.ErrOpenState, without calling the downstream, until ForceClose
+// is called. It is meant for operators shedding load from a failing
+// dependency during an incident.
+func (c *Client) ForceOpen() {
+	if !c.breaker.forcedOpen.Swap(true) {
+		c.logger.Warn("circuit breaker forced open", slog.String("breaker", c.serviceName))
+	}
+}
+
+// ForceClose clears any ForceOpen override and resets the breaker to closed
+// with zeroed counts, so traffic resumes immediately instead of waiting out
+// the open timeout. The breaker trips again normally if failures continue.
+func (c *Client) ForceClose() {
+	c.breaker.current.Store(gobreaker.NewCircuitBreaker[struct{}](c.breaker.settings))
+	c.breaker.forcedOpen.Store(false)
+	c.logger.Warn("circuit breaker forced closed", slog.String("breaker", c.serviceName))
+}
+
+// BreakerState reports the circuit breaker state as BreakerClosed,
+// BreakerHalfOpen, BreakerOpen, or BreakerForcedOpen.
+func (c *Client) BreakerState() string {
+	if c.breaker.forcedOpen.Load() {
+		return BreakerForcedOpen
+	}
+	switch c.breaker.current.Load().State() {
+	case gobreaker.StateHalfOpen:
+		return BreakerHalfOpen
+	case gobreaker.StateOpen:
+		return BreakerOpen
+	default:
+		return BreakerClosed
+	}
+}
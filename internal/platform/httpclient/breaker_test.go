@@ -0,0 +1,112 @@
+package httpclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sony/gobreaker/v2"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/httpclient"
+)
+
+// doGet sends a GET through client and returns the error, closing any body.
+func doGet(t *testing.T, client *httpclient.Client, url string) error {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := client.Do(context.Background(), req)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	return err
+}
+
+func TestClient_ForceOpen_RejectsRequests(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		count.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := httpclient.New(testConfig(srv.URL), "todo-api", nil, testLogger())
+	client.ForceOpen()
+
+	err := doGet(t, client, srv.URL+"/forced")
+	if !errors.Is(err, gobreaker.ErrOpenState) {
+		t.Errorf("Do() error = %v, want gobreaker.ErrOpenState", err)
+	}
+	if count.Load() != 0 {
+		t.Error("server was hit while the breaker was forced open")
+	}
+	if got := client.BreakerState(); got != httpclient.BreakerForcedOpen {
+		t.Errorf("BreakerState() = %q, want %q", got, httpclient.BreakerForcedOpen)
+	}
+	if err := client.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() = nil, want error while forced open")
+	}
+}
+
+func TestClient_ForceClose_AllowsRequests(t *testing.T) {
+	t.Parallel()
+
+	var failing atomic.Bool
+	failing.Store(true)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := testConfig(srv.URL)
+	cfg.CircuitBreaker.MaxFailures = 1
+	cfg.CircuitBreaker.Timeout = time.Minute
+	cfg.Retry.MaxAttempts = 1
+
+	client := httpclient.New(cfg, "todo-api", nil, testLogger())
+
+	// Trip the breaker; it would stay open for a minute on its own.
+	_ = doGet(t, client, srv.URL+"/trip")
+	if got := client.BreakerState(); got != httpclient.BreakerOpen {
+		t.Fatalf("BreakerState() = %q, want %q", got, httpclient.BreakerOpen)
+	}
+
+	failing.Store(false)
+	client.ForceClose()
+
+	if got := client.BreakerState(); got != httpclient.BreakerClosed {
+		t.Errorf("BreakerState() = %q, want %q", got, httpclient.BreakerClosed)
+	}
+	if err := doGet(t, client, srv.URL+"/recovered"); err != nil {
+		t.Errorf("Do() error = %v, want nil after ForceClose", err)
+	}
+}
+
+func TestClient_ForceClose_ClearsForceOpen(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := httpclient.New(testConfig(srv.URL), "todo-api", nil, testLogger())
+	client.ForceOpen()
+	client.ForceClose()
+
+	if err := doGet(t, client, srv.URL+"/cleared"); err != nil {
+		t.Errorf("Do() error = %v, want nil after ForceClose", err)
+	}
+}
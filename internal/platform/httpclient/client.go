@@ -4,7 +4,7 @@
 //
 // The client applies middleware-like processing in this order:
 //
-//	Circuit Breaker → Rate Limiter → Header Injection → OTEL Span → Retry → HTTP
+//	Authorize → Circuit Breaker → Rate Limiter → Header Injection → OTEL Span → Retry → HTTP
 //
 // Construction:
 //
@@ -15,10 +15,22 @@
 //	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 //	resp, err := client.Do(ctx, req)
 //
+// When cfg.OAuth.TokenURL is set, a bearer token obtained via the OAuth2
+// client credentials grant is fetched in the authorize step, before the
+// circuit breaker, so token endpoint failures do not count against the
+// downstream's breaker. The token is cached and refreshed shortly before it
+// expires.
+//
+// When cfg.HMAC.Secret is set, each attempt is signed with HMAC-SHA256 after
+// the request body is buffered, so retries carry a fresh timestamp.
+//
 // Context propagation for header injection (set by inbound middleware):
 //
 //	ctx = httpclient.WithRequestID(ctx, "req-123")
 //	ctx = httpclient.WithCorrelationID(ctx, "corr-456")
+//
+// A context deadline, such as the one set by the inbound timeout middleware,
+// is forwarded as the remaining milliseconds in the X-Timeout-Ms header.
 package httpclient
 
 import (
@@ -27,7 +39,9 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
+	"net"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/sony/gobreaker/v2"
@@ -43,6 +57,25 @@ import (
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/telemetry"
 )
 
+// newTransport clones the default transport and applies the connection-phase
+// timeouts from cfg, so a slow connect or handshake fails well before the
+// overall request Timeout.
+func newTransport(cfg *config.ClientConfig) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	dialer := &net.Dialer{Timeout: cfg.DialTimeout, KeepAlive: 30 * time.Second}
+	t.DialContext = dialer.DialContext
+	t.TLSHandshakeTimeout = cfg.TLSHandshakeTimeout
+	t.ResponseHeaderTimeout = cfg.ResponseHeaderTimeout
+	t.ExpectContinueTimeout = cfg.ExpectContinueTimeout
+	return t
+}
+
+// Version is the service version reported in the default User-Agent. It is
+// set at build time:
+//
+//	go build -ldflags "-X github.com/jsamuelsen11/go-service-template-v2/internal/platform/httpclient.Version=1.2.3"
+var Version = "dev"
+
 // Context key types for request metadata propagation.
 type (
 	requestIDKey     struct{}
@@ -65,10 +98,15 @@ func WithCorrelationID(ctx context.Context, id string) context.Context {
 // retryConfig holds the retry policy values extracted from config.RetryConfig
 // using unexported types to avoid leaking the config package through the API.
 type retryConfig struct {
-	maxAttempts     int
-	initialInterval time.Duration
-	maxInterval     time.Duration
-	multiplier      float64
+	enabled            bool // false forces a single attempt
+	maxAttempts        int
+	initialInterval    time.Duration
+	maxInterval        time.Duration
+	multiplier         float64
+	jitterFraction     float64       // maximum backoff deviation, 0 for none
+	maxElapsedTime     time.Duration // zero means no wall-clock cap
+	retryNonIdempotent bool          // retry POST/PATCH without an Idempotency-Key
+	retryableStatuses  map[int]bool  // nil means the default set, see isRetryableStatus
 }
 
 // Client is an instrumented HTTP client with circuit breaker, rate limiting,
@@ -77,20 +115,62 @@ type Client struct {
 	httpClient  *http.Client
 	baseURL     string
 	serviceName string
-	breaker     *gobreaker.CircuitBreaker[struct{}]
+	userAgent   string
+	breaker     *circuitBreaker
 	limiter     *rate.Limiter // nil when rate limiting is disabled
+	signer      *hmacSigner   // nil when request signing is disabled
+	tokens      *TokenSource  // nil when bearer auth is disabled
 	retryCfg    retryConfig
+	randFloat   func() float64 // backoff jitter source in [0, 1); replaced in tests
+	decider     RetryDecider   // nil retries every retryable status
+	healthPath  string         // probed by Ping
 	metrics     *telemetry.Metrics
 	logger      *slog.Logger
 }
 
+// DefaultHealthPath is the downstream path Ping requests unless overridden
+// with WithHealthPath.
+const DefaultHealthPath = "/healthz"
+
+// TimeoutHeader carries the caller's remaining deadline budget in
+// milliseconds when the request context has a deadline.
+const TimeoutHeader = "X-Timeout-Ms"
+
+// Option configures optional Client behavior.
+type Option func(*Client)
+
+// RetryDecider is consulted for each response whose status is retryable and
+// reports whether it should actually be retried. It may read the body, which
+// is buffered and restored for the caller. Returning false stops retrying:
+// the response is returned as if it were the final attempt.
+type RetryDecider func(*http.Response) bool
+
+// WithRetryDecider installs d to veto retries of responses that carry a
+// retryable status but describe a permanent failure, such as a 503 whose
+// body marks the outage as permanent.
+func WithRetryDecider(d RetryDecider) Option {
+	return func(c *Client) {
+		c.decider = d
+	}
+}
+
+// WithHealthPath sets the downstream path Ping requests. An empty path keeps
+// DefaultHealthPath.
+func WithHealthPath(path string) Option {
+	return func(c *Client) {
+		if path != "" {
+			c.healthPath = path
+		}
+	}
+}
+
 // New creates an instrumented HTTP client configured with circuit breaker,
 // retry with exponential backoff, OpenTelemetry tracing, and header injection.
 //
 // The serviceName identifies the downstream service in traces and metrics
 // (e.g., "todo-api"). If metrics is nil, metric recording is skipped.
-func New(cfg *config.ClientConfig, serviceName string, metrics *telemetry.Metrics, logger *slog.Logger) *Client {
-	cb := gobreaker.NewCircuitBreaker[struct{}](gobreaker.Settings{
+func New(cfg *config.ClientConfig, serviceName string, metrics *telemetry.Metrics, logger *slog.Logger, opts ...Option) *Client {
+	cb := newCircuitBreaker(gobreaker.Settings{
 		Name:        serviceName,
 		MaxRequests: toUint32(cfg.CircuitBreaker.HalfOpenLimit),
 		Timeout:     cfg.CircuitBreaker.Timeout,
@@ -111,40 +191,82 @@ func New(cfg *config.ClientConfig, serviceName string, metrics *telemetry.Metric
 		limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit.RequestsPerSecond), cfg.RateLimit.BurstSize)
 	}
 
-	return &Client{
-		httpClient:  &http.Client{Timeout: cfg.Timeout},
+	if !cfg.Retry.Enabled {
+		logger.Info("client retries disabled; every request gets a single attempt",
+			slog.String("service", serviceName),
+		)
+	}
+
+	userAgent := cfg.UserAgent
+	if userAgent == "" {
+		userAgent = fmt.Sprintf("go-service-template/%s (%s)", Version, serviceName)
+	}
+
+	var tokens *TokenSource
+	if cfg.OAuth.TokenURL != "" {
+		tokens = NewTokenSource(cfg.OAuth, cfg.Timeout)
+	}
+
+	c := &Client{
+		httpClient:  &http.Client{Timeout: cfg.Timeout, Transport: newTransport(cfg)},
 		baseURL:     cfg.BaseURL,
 		serviceName: serviceName,
+		userAgent:   userAgent,
 		breaker:     cb,
 		limiter:     limiter,
+		signer:      newHMACSigner(cfg.HMAC),
+		tokens:      tokens,
 		retryCfg: retryConfig{
-			maxAttempts:     cfg.Retry.MaxAttempts,
-			initialInterval: cfg.Retry.InitialInterval,
-			maxInterval:     cfg.Retry.MaxInterval,
-			multiplier:      cfg.Retry.Multiplier,
+			enabled:            cfg.Retry.Enabled,
+			maxAttempts:        cfg.Retry.MaxAttempts,
+			initialInterval:    cfg.Retry.InitialInterval,
+			maxInterval:        cfg.Retry.MaxInterval,
+			multiplier:         cfg.Retry.Multiplier,
+			jitterFraction:     cfg.Retry.JitterFraction,
+			maxElapsedTime:     cfg.Retry.MaxElapsedTime,
+			retryNonIdempotent: cfg.Retry.RetryNonIdempotent,
+			retryableStatuses:  statusSet(cfg.Retry.RetryableStatuses),
 		},
-		metrics: metrics,
-		logger:  logger,
+		randFloat:  secureRandFloat64,
+		healthPath: DefaultHealthPath,
+		metrics:    metrics,
+		logger:     logger,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // Do executes an HTTP request through the full middleware pipeline:
-// Circuit Breaker → Rate Limiter → Header Injection → OTEL Span → Retry → HTTP.
+// Bearer Token → Circuit Breaker → Rate Limiter → Header Injection →
+// OTEL Span → Retry → HTTP.
+//
+// The bearer token is fetched before the circuit breaker so that token
+// endpoint failures do not count toward tripping the downstream breaker.
 //
 // The request's context is used for cancellation, tracing, and to extract
 // Request-ID and Correlation-ID for header propagation.
 //
-// When the request succeeds (non-retryable status), resp is non-nil with an
-// open body that the caller must close. When all retries are exhausted for a
-// retryable status, both resp (with open body) and err are non-nil; the caller
-// should close resp.Body. When the circuit breaker rejects or a network error
-// occurs, resp is nil.
+// When the final response is not a failure, resp is non-nil with an open
+// body that the caller must close. When it has a failure status (429 or
+// 5xx), both resp (with open body) and err are non-nil, so the circuit
+// breaker counts it; the caller should close resp.Body. When the circuit
+// breaker rejects or a network error occurs, resp is nil.
 func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
 	start := time.Now()
 	method := req.Method
 
-	var resp *http.Response
-	_, err := c.breaker.Execute(func() (struct{}, error) {
+	var (
+		resp    *http.Response
+		retries int
+	)
+	if err := c.authorize(ctx, req); err != nil {
+		c.recordMetrics(ctx, method, start, nil, 0, err)
+		return nil, err
+	}
+
+	err := c.breaker.execute(func() (struct{}, error) {
 		if err := c.waitForRateLimit(ctx); err != nil {
 			return struct{}{}, err
 		}
@@ -158,13 +280,14 @@ func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, err
 		// cancellation, deadlines, and trace propagation.
 		req = req.WithContext(spanCtx)
 
-		retryErr := c.doWithRetry(spanCtx, req, &resp)
+		var retryErr error
+		retries, retryErr = c.doWithRetry(spanCtx, req, &resp)
 		c.finishSpan(span, resp, retryErr)
 
 		return struct{}{}, retryErr
 	})
 
-	c.recordMetrics(ctx, method, start, resp, err)
+	c.recordMetrics(ctx, method, start, resp, retries, err)
 
 	return resp, err
 }
@@ -191,10 +314,12 @@ func (c *Client) Name() string {
 //   - "open"      — downstream is unavailable and the breaker is rejecting
 //     requests; returns a descriptive error indicating failure.
 //
+// A breaker forced open with ForceOpen reports as open.
+//
 // This reports downstream status, not service readiness. The service itself
 // is always ready to handle requests even when a downstream is failing.
 func (c *Client) HealthCheck(_ context.Context) error {
-	state := c.breaker.State()
+	state := c.breaker.state()
 	switch state {
 	case gobreaker.StateClosed:
 		return nil
@@ -207,6 +332,34 @@ func (c *Client) HealthCheck(_ context.Context) error {
 	}
 }
 
+// Ping actively checks downstream connectivity with a GET to the health path
+// (DefaultHealthPath unless set by WithHealthPath). Unlike HealthCheck it
+// makes a network call. The probe bypasses the circuit breaker, rate limiter,
+// and retries so it reflects the downstream's current state and never counts
+// toward tripping the breaker. Any 2xx response is healthy; other statuses
+// and transport errors are reported as failing.
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+c.healthPath, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("%s: creating ping request: %w", c.serviceName, err)
+	}
+	if err := c.authorize(ctx, req); err != nil {
+		return fmt.Errorf("%s: %w", c.serviceName, err)
+	}
+	c.injectHeaders(ctx, req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: failing (ping %s: %w)", c.serviceName, c.healthPath, err)
+	}
+	drainResponseBody(resp)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s: failing (ping %s returned HTTP %d)", c.serviceName, c.healthPath, resp.StatusCode)
+	}
+	return nil
+}
+
 // waitForRateLimit blocks until the rate limiter allows the request or the
 // context is canceled. Returns nil immediately when rate limiting is disabled.
 func (c *Client) waitForRateLimit(ctx context.Context) error {
@@ -216,8 +369,26 @@ func (c *Client) waitForRateLimit(ctx context.Context) error {
 	return c.limiter.Wait(ctx)
 }
 
-// injectHeaders adds Request-ID and Correlation-ID headers to the outbound
-// request if present in the context.
+// authorize sets the Authorization header to a bearer token when OAuth is
+// configured. It is a no-op otherwise.
+func (c *Client) authorize(ctx context.Context, req *http.Request) error {
+	if c.tokens == nil {
+		return nil
+	}
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching access token for %s: %w", c.serviceName, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// injectHeaders sets Request-ID and Correlation-ID headers on the outbound
+// request if present in the context, the remaining deadline budget, and a
+// User-Agent unless the caller set one. Headers are set, not added, so a
+// request reused across retries never carries duplicates. HMAC signature
+// headers are added per attempt by signRequest, since they depend on the
+// buffered body.
 func (c *Client) injectHeaders(ctx context.Context, req *http.Request) {
 	if id, ok := ctx.Value(requestIDKey{}).(string); ok && id != "" {
 		req.Header.Set("X-Request-ID", id)
@@ -225,6 +396,32 @@ func (c *Client) injectHeaders(ctx context.Context, req *http.Request) {
 	if id, ok := ctx.Value(correlationIDKey{}).(string); ok && id != "" {
 		req.Header.Set("X-Correlation-ID", id)
 	}
+	setTimeoutHeader(ctx, req)
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+}
+
+// setTimeoutHeader sets TimeoutHeader to the milliseconds left before the
+// context deadline, so the downstream can shed work it cannot finish in
+// time. It is a no-op when the context has no deadline.
+func setTimeoutHeader(ctx context.Context, req *http.Request) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return
+	}
+	remaining := max(time.Until(deadline).Milliseconds(), 0)
+	req.Header.Set(TimeoutHeader, strconv.FormatInt(remaining, 10))
+}
+
+// signRequest sets HMAC signature and timestamp headers on the request when
+// signing is enabled. Called once per attempt so retries are re-signed with a
+// fresh timestamp.
+func (c *Client) signRequest(req *http.Request, body []byte) {
+	if c.signer == nil {
+		return
+	}
+	c.signer.sign(req, body, time.Now())
 }
 
 // startSpan creates an OTEL client span for the outbound request and injects
@@ -262,7 +459,7 @@ func (c *Client) finishSpan(span trace.Span, resp *http.Response, err error) {
 // recordMetrics records client request duration and count metrics.
 // Metrics are recorded outside the circuit breaker so that circuit-open
 // rejections are captured. Safe to call with nil metrics.
-func (c *Client) recordMetrics(ctx context.Context, method string, start time.Time, resp *http.Response, err error) {
+func (c *Client) recordMetrics(ctx context.Context, method string, start time.Time, resp *http.Response, retries int, err error) {
 	if c.metrics == nil {
 		return
 	}
@@ -290,6 +487,12 @@ func (c *Client) recordMetrics(ctx context.Context, method string, start time.Ti
 
 	c.metrics.ClientRequestDuration.Record(ctx, duration, attrs)
 	c.metrics.ClientRequestTotal.Add(ctx, 1, attrs)
+	if retries > 0 {
+		c.metrics.ClientRequestRetries.Add(ctx, int64(retries), metric.WithAttributes(
+			telemetry.AttrHTTPMethod.String(method),
+			telemetry.AttrPeerService.String(c.serviceName),
+		))
+	}
 }
 
 // toUint32 safely converts a non-negative int to uint32, clamping at the
@@ -2,32 +2,47 @@ package httpclient_test
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/sony/gobreaker/v2"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/config"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/httpclient"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/telemetry"
 	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
 )
 
 // Compile-time interface check. Platform must not import ports in production
 // code, so the check lives in the test file.
-var _ ports.HealthChecker = (*httpclient.Client)(nil)
+var (
+	_ ports.HealthChecker  = (*httpclient.Client)(nil)
+	_ ports.Pinger         = (*httpclient.Client)(nil)
+	_ ports.CircuitBreaker = (*httpclient.Client)(nil)
+	_ ports.TokenSource    = (*httpclient.TokenSource)(nil)
+)
 
 func testConfig(baseURL string) *config.ClientConfig {
 	return &config.ClientConfig{
 		BaseURL: baseURL,
 		Timeout: 5 * time.Second,
 		Retry: config.RetryConfig{
+			Enabled:         true,
 			MaxAttempts:     3,
 			InitialInterval: 10 * time.Millisecond,
 			MaxInterval:     100 * time.Millisecond,
@@ -139,19 +154,658 @@ func TestDo_RetryOnRetryableStatus(t *testing.T) {
 	}
 }
 
+func TestDo_MaxElapsedTimeLimitsAttempts(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		count.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := testConfig(srv.URL)
+	cfg.Retry.MaxAttempts = 10
+	cfg.Retry.InitialInterval = 50 * time.Millisecond
+	cfg.Retry.MaxInterval = time.Second
+	cfg.Retry.MaxElapsedTime = 200 * time.Millisecond
+	cfg.CircuitBreaker.MaxFailures = 100
+	client := httpclient.New(cfg, "test-svc", nil, testLogger())
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/slow", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+
+	resp, err := client.Do(context.Background(), req)
+	if err == nil {
+		t.Fatal("Do() error = nil, want error after exhausting elapsed budget")
+	}
+	if resp != nil {
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusServiceUnavailable)
+		}
+	}
+
+	// Backoffs of ~50ms, ~100ms, ~200ms: the third would exceed the budget.
+	got := count.Load()
+	if got < 2 || got >= int32(cfg.Retry.MaxAttempts) {
+		t.Errorf("request count = %d, want between 2 and %d", got, cfg.Retry.MaxAttempts-1)
+	}
+}
+
+func TestDo_StopsRetryingAtRequestDeadline(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		count.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := testConfig(srv.URL)
+	cfg.Retry.MaxAttempts = 5
+	cfg.Retry.InitialInterval = 500 * time.Millisecond
+	cfg.Retry.MaxInterval = 2 * time.Second
+	cfg.CircuitBreaker.MaxFailures = 100
+	client := httpclient.New(cfg, "test-svc", nil, testLogger())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/slow", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(ctx, req)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed >= 100*time.Millisecond {
+		t.Errorf("Do() took %v, want it to return before the 100ms deadline", elapsed)
+	}
+	if got := count.Load(); got != 1 {
+		t.Errorf("request count = %d, want 1", got)
+	}
+}
+
+func TestDo_ConfiguredRetryableStatuses(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		failStatus   int
+		wantAttempts int32
+		wantStatus   int
+		wantErr      bool
+	}{
+		{"configured 408 retries", http.StatusRequestTimeout, 2, http.StatusOK, false},
+		{"unlisted 503 does not retry but fails", http.StatusServiceUnavailable, 1, http.StatusServiceUnavailable, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var count atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if count.Add(1) == 1 {
+					w.WriteHeader(tt.failStatus)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(srv.Close)
+
+			cfg := testConfig(srv.URL)
+			cfg.Retry.RetryableStatuses = []int{http.StatusRequestTimeout, http.StatusTooEarly}
+			client := httpclient.New(cfg, "test-svc", nil, testLogger())
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/retry", http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+
+			resp, err := client.Do(context.Background(), req)
+			if resp == nil {
+				t.Fatalf("Do() resp = nil, err = %v", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := count.Load(); got != tt.wantAttempts {
+				t.Errorf("request count = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestDo_ConfiguredRetryableStatusesBreakerClassification(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		status    int
+		wantTrips bool
+	}{
+		{"unlisted 5xx still trips the breaker", http.StatusInternalServerError, true},
+		{"listed 4xx does not trip the breaker", http.StatusRequestTimeout, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var count atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				count.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			t.Cleanup(srv.Close)
+
+			cfg := testConfig(srv.URL)
+			cfg.Retry.MaxAttempts = 1
+			cfg.Retry.RetryableStatuses = []int{http.StatusRequestTimeout}
+			cfg.CircuitBreaker.MaxFailures = 2
+			client := httpclient.New(cfg, "test-svc", nil, testLogger())
+
+			var lastErr error
+			for range 3 {
+				req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/cb", http.NoBody)
+				resp, err := client.Do(context.Background(), req)
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+				lastErr = err
+			}
+
+			tripped := errors.Is(lastErr, gobreaker.ErrOpenState)
+			if tripped != tt.wantTrips {
+				t.Errorf("third request error = %v, want breaker open %v", lastErr, tt.wantTrips)
+			}
+			wantHits := int32(3)
+			if tt.wantTrips {
+				wantHits = 2
+			}
+			if got := count.Load(); got != wantHits {
+				t.Errorf("server hits = %d, want %d", got, wantHits)
+			}
+		})
+	}
+}
+
+func TestDo_RetryDeciderStopsPermanentFailure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		body         string
+		wantAttempts int32
+		wantStatus   int
+	}{
+		{"permanent 503 is not retried", `{"permanent":true}`, 1, http.StatusServiceUnavailable},
+		{"transient 503 is retried", `{"permanent":false}`, 2, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var count atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if count.Add(1) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					_, _ = io.WriteString(w, tt.body)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(srv.Close)
+
+			decider := func(resp *http.Response) bool {
+				var body struct {
+					Permanent bool `json:"permanent"`
+				}
+				_ = json.NewDecoder(resp.Body).Decode(&body)
+				return !body.Permanent
+			}
+			client := httpclient.New(testConfig(srv.URL), "test-svc", nil, testLogger(),
+				httpclient.WithRetryDecider(decider))
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/retry", http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+
+			resp, err := client.Do(context.Background(), req)
+			if resp == nil {
+				t.Fatalf("Do() resp = nil, err = %v", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := count.Load(); got != tt.wantAttempts {
+				t.Errorf("request count = %d, want %d", got, tt.wantAttempts)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if err == nil {
+					t.Error("Do() error = nil, want the HTTP failure")
+				}
+				// The decider read the body; the caller still gets all of it.
+				got, _ := io.ReadAll(resp.Body)
+				if string(got) != tt.body {
+					t.Errorf("body = %q, want %q", got, tt.body)
+				}
+			}
+		})
+	}
+}
+
+func TestDo_RetryHeadersPerAttempt(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		requests []http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Header.Clone())
+		n := len(requests)
+		mu.Unlock()
+		if n < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := httpclient.New(testConfig(srv.URL), "test-svc", nil, testLogger())
+	ctx := httpclient.WithRequestID(context.Background(), "req-123")
+	ctx = httpclient.WithCorrelationID(ctx, "corr-456")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/retry", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := client.Do(ctx, req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(requests) != 3 {
+		t.Fatalf("attempts = %d, want 3", len(requests))
+	}
+	for i, h := range requests {
+		if got := h.Values("X-Request-ID"); len(got) != 1 || got[0] != "req-123" {
+			t.Errorf("attempt %d X-Request-ID = %q, want exactly one %q", i+1, got, "req-123")
+		}
+		if got := h.Values("X-Correlation-ID"); len(got) != 1 {
+			t.Errorf("attempt %d X-Correlation-ID = %q, want exactly one", i+1, got)
+		}
+		want := strconv.Itoa(i + 1)
+		if got := h.Values(httpclient.AttemptHeader); len(got) != 1 || got[0] != want {
+			t.Errorf("attempt %d %s = %q, want exactly one %q", i+1, httpclient.AttemptHeader, got, want)
+		}
+	}
+}
+
+func TestDo_RetriesDisabled(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		count.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := testConfig(srv.URL)
+	cfg.Retry.Enabled = false
+	cfg.Retry.MaxAttempts = 5
+	client := httpclient.New(cfg, "test-svc", nil, testLogger())
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/flaky", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+
+	resp, err := client.Do(context.Background(), req)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err == nil {
+		t.Fatal("Do() error = nil, want error for 503")
+	}
+	if got := count.Load(); got != 1 {
+		t.Errorf("request count = %d, want 1 (retries disabled)", got)
+	}
+}
+
+func TestDo_RetryOnlyIdempotentByDefault(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		method             string
+		idempotencyKey     string
+		retryNonIdempotent bool
+		wantAttempts       int32
+	}{
+		{name: "GET is retried", method: http.MethodGet, wantAttempts: 3},
+		{name: "DELETE is retried", method: http.MethodDelete, wantAttempts: 3},
+		{name: "POST without key is not retried", method: http.MethodPost, wantAttempts: 1},
+		{name: "PATCH without key is not retried", method: http.MethodPatch, wantAttempts: 1},
+		{name: "POST with idempotency key is retried", method: http.MethodPost, idempotencyKey: "abc-123", wantAttempts: 3},
+		{name: "POST retried when enabled in config", method: http.MethodPost, retryNonIdempotent: true, wantAttempts: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var count atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				count.Add(1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			t.Cleanup(srv.Close)
+
+			cfg := testConfig(srv.URL)
+			cfg.Retry.RetryNonIdempotent = tt.retryNonIdempotent
+			cfg.CircuitBreaker.MaxFailures = 100
+			client := httpclient.New(cfg, "test-svc", nil, testLogger())
+
+			req, err := http.NewRequestWithContext(context.Background(), tt.method, srv.URL+"/items", strings.NewReader("{}"))
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			if tt.idempotencyKey != "" {
+				req.Header.Set("Idempotency-Key", tt.idempotencyKey)
+			}
+
+			resp, err := client.Do(context.Background(), req)
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+			if err == nil {
+				t.Fatal("Do() error = nil, want error for 503")
+			}
+
+			if got := count.Load(); got != tt.wantAttempts {
+				t.Errorf("request count = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
 func TestDo_NoRetryOn4xx(t *testing.T) {
 	t.Parallel()
 
-	var count atomic.Int32
-	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-		count.Add(1)
-		w.WriteHeader(http.StatusBadRequest)
+	var count atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		count.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := httpclient.New(testConfig(srv.URL), "test-svc", nil, testLogger())
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/bad", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+
+	if got := count.Load(); got != 1 {
+		t.Errorf("request count = %d, want 1 (no retries for 4xx)", got)
+	}
+}
+
+func TestDo_MaxRetriesExhausted(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		count.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("unavailable"))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := httpclient.New(testConfig(srv.URL), "test-svc", nil, testLogger())
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/unavail", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+
+	resp, err := client.Do(context.Background(), req)
+	if err == nil {
+		t.Fatal("Do() error = nil, want non-nil after max retries")
+	}
+
+	if got := count.Load(); got != 3 {
+		t.Errorf("request count = %d, want 3", got)
+	}
+
+	// Last attempt's response should have body intact.
+	if resp == nil {
+		t.Fatal("resp is nil, want non-nil with body intact")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != "unavailable" {
+		t.Errorf("body = %q, want %q", string(body), "unavailable")
+	}
+}
+
+func TestDo_RequestBodyPreservedAcrossRetries(t *testing.T) {
+	t.Parallel()
+
+	var (
+		count  atomic.Int32
+		bodies []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		n := count.Add(1)
+		if n <= 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := testConfig(srv.URL)
+	client := httpclient.New(cfg, "test-svc", nil, testLogger())
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, srv.URL+"/body", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if len(bodies) != 2 {
+		t.Fatalf("request count = %d, want 2", len(bodies))
+	}
+
+	for i, b := range bodies {
+		if b != "hello" {
+			t.Errorf("attempt %d body = %q, want %q", i+1, b, "hello")
+		}
+	}
+}
+
+func TestDo_HeaderInjection(t *testing.T) {
+	t.Parallel()
+
+	var gotReqID, gotCorrID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReqID = r.Header.Get("X-Request-ID")
+		gotCorrID = r.Header.Get("X-Correlation-ID")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := httpclient.New(testConfig(srv.URL), "test-svc", nil, testLogger())
+
+	ctx := httpclient.WithRequestID(context.Background(), "req-123")
+	ctx = httpclient.WithCorrelationID(ctx, "corr-456")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/headers", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+
+	resp, err := client.Do(ctx, req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if gotReqID != "req-123" {
+		t.Errorf("X-Request-ID = %q, want %q", gotReqID, "req-123")
+	}
+	if gotCorrID != "corr-456" {
+		t.Errorf("X-Correlation-ID = %q, want %q", gotCorrID, "corr-456")
+	}
+}
+
+func TestDo_TimeoutHeader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		deadline time.Duration // zero means no deadline
+	}{
+		{"forwards remaining budget", 2 * time.Second},
+		{"omitted without deadline", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Values(httpclient.TimeoutHeader)
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(srv.Close)
+
+			client := httpclient.New(testConfig(srv.URL), "test-svc", nil, testLogger())
+
+			ctx := context.Background()
+			if tt.deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.deadline)
+				t.Cleanup(cancel)
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/timeout", http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			resp, err := client.Do(ctx, req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if tt.deadline == 0 {
+				if len(got) != 0 {
+					t.Errorf("%s = %q, want none", httpclient.TimeoutHeader, got)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("%s = %q, want exactly one value", httpclient.TimeoutHeader, got)
+			}
+			ms, err := strconv.ParseInt(got[0], 10, 64)
+			if err != nil || ms <= 0 || ms > tt.deadline.Milliseconds() {
+				t.Errorf("%s = %q, want between 1 and %d", httpclient.TimeoutHeader, got[0], tt.deadline.Milliseconds())
+			}
+		})
+	}
+}
+
+func TestDo_HMACSigning(t *testing.T) {
+	t.Parallel()
+
+	const secret = "test-secret"
+
+	var (
+		count      atomic.Int32
+		signatures []string
+		verified   []bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		sig := r.Header.Get("X-Signature")
+		ts := r.Header.Get("X-Signature-Timestamp")
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(r.Method + "\n" + r.URL.Path + "\n" + string(b) + "\n" + ts))
+		want := hex.EncodeToString(mac.Sum(nil))
+
+		signatures = append(signatures, sig)
+		verified = append(verified, ts != "" && hmac.Equal([]byte(sig), []byte(want)))
+
+		if count.Add(1) <= 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
 	}))
 	t.Cleanup(srv.Close)
 
-	client := httpclient.New(testConfig(srv.URL), "test-svc", nil, testLogger())
+	cfg := testConfig(srv.URL)
+	cfg.HMAC = config.HMACConfig{
+		Secret:          secret,
+		Header:          "X-Signature",
+		TimestampHeader: "X-Signature-Timestamp",
+	}
+	client := httpclient.New(cfg, "test-svc", nil, testLogger())
 
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/bad", http.NoBody)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, srv.URL+"/signed", strings.NewReader(`{"a":1}`))
 	if err != nil {
 		t.Fatalf("creating request: %v", err)
 	}
@@ -162,77 +816,77 @@ func TestDo_NoRetryOn4xx(t *testing.T) {
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode != http.StatusBadRequest {
-		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	if len(signatures) != 2 {
+		t.Fatalf("request count = %d, want 2", len(signatures))
 	}
-
-	if got := count.Load(); got != 1 {
-		t.Errorf("request count = %d, want 1 (no retries for 4xx)", got)
+	for i, sig := range signatures {
+		if sig == "" {
+			t.Errorf("attempt %d: X-Signature header missing", i+1)
+		}
+		if !verified[i] {
+			t.Errorf("attempt %d: signature %q does not verify", i+1, sig)
+		}
 	}
 }
 
-func TestDo_MaxRetriesExhausted(t *testing.T) {
+func TestDo_NoSignatureWhenHMACDisabled(t *testing.T) {
 	t.Parallel()
 
-	var count atomic.Int32
-	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-		count.Add(1)
-		w.WriteHeader(http.StatusServiceUnavailable)
-		_, _ = w.Write([]byte("unavailable"))
+	var gotSig, gotTS string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSig = r.Header.Get("X-Signature")
+		gotTS = r.Header.Get("X-Signature-Timestamp")
+		w.WriteHeader(http.StatusOK)
 	}))
 	t.Cleanup(srv.Close)
 
 	client := httpclient.New(testConfig(srv.URL), "test-svc", nil, testLogger())
 
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/unavail", http.NoBody)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/unsigned", http.NoBody)
 	if err != nil {
 		t.Fatalf("creating request: %v", err)
 	}
 
 	resp, err := client.Do(context.Background(), req)
-	if err == nil {
-		t.Fatal("Do() error = nil, want non-nil after max retries")
-	}
-
-	if got := count.Load(); got != 3 {
-		t.Errorf("request count = %d, want 3", got)
-	}
-
-	// Last attempt's response should have body intact.
-	if resp == nil {
-		t.Fatal("resp is nil, want non-nil with body intact")
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	body, _ := io.ReadAll(resp.Body)
-	if string(body) != "unavailable" {
-		t.Errorf("body = %q, want %q", string(body), "unavailable")
+	if gotSig != "" || gotTS != "" {
+		t.Errorf("signature headers = (%q, %q), want both empty", gotSig, gotTS)
 	}
 }
 
-func TestDo_RequestBodyPreservedAcrossRetries(t *testing.T) {
+func TestDo_AttachesBearerToken(t *testing.T) {
 	t.Parallel()
 
-	var (
-		count  atomic.Int32
-		bodies []string
-	)
-	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		b, _ := io.ReadAll(r.Body)
-		bodies = append(bodies, string(b))
-		n := count.Add(1)
-		if n <= 1 {
-			w.WriteHeader(http.StatusInternalServerError)
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
 			return
 		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"tok-123","token_type":"Bearer","expires_in":3600}`))
+	}))
+	t.Cleanup(tokenSrv.Close)
+
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
 		w.WriteHeader(http.StatusOK)
 	}))
 	t.Cleanup(srv.Close)
 
 	cfg := testConfig(srv.URL)
+	cfg.OAuth = config.OAuthConfig{
+		TokenURL:     tokenSrv.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+	}
 	client := httpclient.New(cfg, "test-svc", nil, testLogger())
 
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL+"/body", strings.NewReader("hello"))
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/auth", http.NoBody)
 	if err != nil {
 		t.Fatalf("creating request: %v", err)
 	}
@@ -243,49 +897,45 @@ func TestDo_RequestBodyPreservedAcrossRetries(t *testing.T) {
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	if len(bodies) != 2 {
-		t.Fatalf("request count = %d, want 2", len(bodies))
-	}
-
-	for i, b := range bodies {
-		if b != "hello" {
-			t.Errorf("attempt %d body = %q, want %q", i+1, b, "hello")
-		}
+	if gotAuth != "Bearer tok-123" {
+		t.Errorf("Authorization = %q, want %q", gotAuth, "Bearer tok-123")
 	}
 }
 
-func TestDo_HeaderInjection(t *testing.T) {
+func TestDo_TokenFailureDoesNotTripBreaker(t *testing.T) {
 	t.Parallel()
 
-	var gotReqID, gotCorrID string
-	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		gotReqID = r.Header.Get("X-Request-ID")
-		gotCorrID = r.Header.Get("X-Correlation-ID")
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(tokenSrv.Close)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
 	t.Cleanup(srv.Close)
 
-	client := httpclient.New(testConfig(srv.URL), "test-svc", nil, testLogger())
-
-	ctx := httpclient.WithRequestID(context.Background(), "req-123")
-	ctx = httpclient.WithCorrelationID(ctx, "corr-456")
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/headers", http.NoBody)
-	if err != nil {
-		t.Fatalf("creating request: %v", err)
+	cfg := testConfig(srv.URL)
+	cfg.CircuitBreaker.MaxFailures = 1
+	cfg.OAuth = config.OAuthConfig{
+		TokenURL:     tokenSrv.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
 	}
+	client := httpclient.New(cfg, "test-svc", nil, testLogger())
 
-	resp, err := client.Do(ctx, req)
-	if err != nil {
-		t.Fatalf("Do() error = %v", err)
+	for range 3 {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/auth", http.NoBody)
+		if err != nil {
+			t.Fatalf("creating request: %v", err)
+		}
+		if _, err := client.Do(context.Background(), req); err == nil {
+			t.Fatal("Do() error = nil, want token fetch error")
+		}
 	}
-	defer func() { _ = resp.Body.Close() }()
 
-	if gotReqID != "req-123" {
-		t.Errorf("X-Request-ID = %q, want %q", gotReqID, "req-123")
-	}
-	if gotCorrID != "corr-456" {
-		t.Errorf("X-Correlation-ID = %q, want %q", gotCorrID, "corr-456")
+	if err := client.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() = %v, want nil (token failures must not open the breaker)", err)
 	}
 }
 
@@ -321,6 +971,84 @@ func TestDo_NoHeadersWithoutContext(t *testing.T) {
 	}
 }
 
+func TestDo_UserAgent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		configUA  string
+		requestUA string
+		check     func(t *testing.T, got string)
+	}{
+		{
+			name: "default includes version and service name",
+			check: func(t *testing.T, got string) {
+				t.Helper()
+				if !strings.HasPrefix(got, "go-service-template/"+httpclient.Version) {
+					t.Errorf("User-Agent = %q, want prefix %q", got, "go-service-template/"+httpclient.Version)
+				}
+				if !strings.Contains(got, "test-svc") {
+					t.Errorf("User-Agent = %q, want it to contain %q", got, "test-svc")
+				}
+			},
+		},
+		{
+			name:     "configured value",
+			configUA: "custom-agent/2.0",
+			check: func(t *testing.T, got string) {
+				t.Helper()
+				if got != "custom-agent/2.0" {
+					t.Errorf("User-Agent = %q, want %q", got, "custom-agent/2.0")
+				}
+			},
+		},
+		{
+			name:      "caller value wins",
+			configUA:  "custom-agent/2.0",
+			requestUA: "caller/1.0",
+			check: func(t *testing.T, got string) {
+				t.Helper()
+				if got != "caller/1.0" {
+					t.Errorf("User-Agent = %q, want %q", got, "caller/1.0")
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotUA string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUA = r.Header.Get("User-Agent")
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(srv.Close)
+
+			cfg := testConfig(srv.URL)
+			cfg.UserAgent = tt.configUA
+			client := httpclient.New(cfg, "test-svc", nil, testLogger())
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/ua", http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			if tt.requestUA != "" {
+				req.Header.Set("User-Agent", tt.requestUA)
+			}
+
+			resp, err := client.Do(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			_ = resp.Body.Close()
+
+			tt.check(t, gotUA)
+		})
+	}
+}
+
 func TestDo_CircuitBreakerOpens(t *testing.T) {
 	t.Parallel()
 
@@ -448,6 +1176,36 @@ func TestDo_ContextCancellation(t *testing.T) {
 	}
 }
 
+func TestDo_DialTimeoutFailsFast(t *testing.T) {
+	t.Parallel()
+
+	// 10.255.255.1 is non-routable: the SYN is never answered, so only the
+	// dial timeout can end the attempt before the overall client timeout.
+	cfg := testConfig("http://10.255.255.1")
+	cfg.Timeout = 30 * time.Second
+	cfg.DialTimeout = 100 * time.Millisecond
+	cfg.Retry.Enabled = false
+	client := httpclient.New(cfg, "test-svc", nil, testLogger())
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://10.255.255.1/slow", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(context.Background(), req)
+	elapsed := time.Since(start)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err == nil {
+		t.Fatal("Do() error = nil, want connect failure")
+	}
+	if elapsed > 5*time.Second {
+		t.Errorf("Do() took %v, want failure well before the %v client timeout", elapsed, cfg.Timeout)
+	}
+}
+
 func TestClient_Name(t *testing.T) {
 	t.Parallel()
 
@@ -533,6 +1291,93 @@ func TestClient_HealthCheck_HalfOpen(t *testing.T) {
 	}
 }
 
+func TestClient_Ping_Healthy(t *testing.T) {
+	t.Parallel()
+
+	var gotPath atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath.Store(r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := httpclient.New(testConfig(srv.URL), "todo-api", nil, testLogger())
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() = %v, want nil", err)
+	}
+	if got := gotPath.Load(); got != httpclient.DefaultHealthPath {
+		t.Errorf("ping path = %v, want %q", got, httpclient.DefaultHealthPath)
+	}
+}
+
+func TestClient_Ping_ServerError(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := httpclient.New(testConfig(srv.URL), "todo-api", nil, testLogger())
+
+	err := client.Ping(context.Background())
+	if err == nil {
+		t.Fatal("Ping() = nil, want error")
+	}
+	if !strings.Contains(err.Error(), "failing") || !strings.Contains(err.Error(), "500") {
+		t.Errorf("Ping() = %q, want error containing %q and %q", err, "failing", "500")
+	}
+	// The probe must not be retried.
+	if got := calls.Load(); got != 1 {
+		t.Errorf("server calls = %d, want 1", got)
+	}
+	// Nor should it count toward tripping the breaker.
+	if err := client.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() after failed ping = %v, want nil", err)
+	}
+}
+
+func TestClient_Ping_Unreachable(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	client := httpclient.New(testConfig(url), "todo-api", nil, testLogger())
+
+	err := client.Ping(context.Background())
+	if err == nil {
+		t.Fatal("Ping() = nil, want error")
+	}
+	if !strings.Contains(err.Error(), "failing") {
+		t.Errorf("Ping() = %q, want error containing %q", err, "failing")
+	}
+}
+
+func TestClient_Ping_CustomPath(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/status" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := httpclient.New(testConfig(srv.URL), "todo-api", nil, testLogger(),
+		httpclient.WithHealthPath("/status"))
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Errorf("Ping() = %v, want nil", err)
+	}
+}
+
 func TestDo_NilMetrics(t *testing.T) {
 	t.Parallel()
 
@@ -560,6 +1405,86 @@ func TestDo_NilMetrics(t *testing.T) {
 	}
 }
 
+// retryCount sums http.client.request.retries across all data points.
+func retryCount(t *testing.T, reader *sdkmetric.ManualReader) int64 {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+
+	var total int64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "http.client.request.retries" {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if !ok {
+				t.Fatalf("http.client.request.retries data = %T, want Sum[int64]", m.Data)
+			}
+			for _, dp := range sum.DataPoints {
+				total += dp.Value
+			}
+		}
+	}
+	return total
+}
+
+func TestDo_RecordsRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		failCount   int
+		wantRetries int64
+	}{
+		{name: "two retries before success", failCount: 2, wantRetries: 2},
+		{name: "no retries on first success", failCount: 0, wantRetries: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var count atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if int(count.Add(1)) <= tt.failCount {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(srv.Close)
+
+			reader := sdkmetric.NewManualReader()
+			mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+			t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+			metrics, err := telemetry.NewMetrics(mp, "test")
+			if err != nil {
+				t.Fatalf("NewMetrics() error = %v", err)
+			}
+
+			client := httpclient.New(testConfig(srv.URL), "test-svc", metrics, testLogger())
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"/retries", http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+
+			resp, err := client.Do(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if got := retryCount(t, reader); got != tt.wantRetries {
+				t.Errorf("http.client.request.retries = %d, want %d", got, tt.wantRetries)
+			}
+		})
+	}
+}
+
 func TestDo_RateLimiterThrottles(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,240 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// maxJWKSResponseSize limits how much of a JWKS response we read.
+const maxJWKSResponseSize = 1 << 20 // 1 MB
+
+// minJWKSRefreshInterval bounds how often an unknown key ID can force a
+// refetch, so tokens with made-up key IDs cannot hammer the issuer.
+const minJWKSRefreshInterval = 30 * time.Second
+
+// minRSAKeyBits is the smallest RSA modulus accepted from a key set.
+const minRSAKeyBits = 2048
+
+// jwksFetchTimeout bounds a key set fetch. Fetches are detached from the
+// cancellation of the request that triggered them, so this is their only
+// deadline besides the client timeout.
+const jwksFetchTimeout = 10 * time.Second
+
+var (
+	// ErrUnknownKey is returned by KeySet.Key when the key set has no
+	// signing key with the requested ID.
+	ErrUnknownKey = errors.New("unknown signing key")
+	// ErrKeySetUnavailable is returned by KeySet.Key when the key set could
+	// not be fetched and no cached key matches, so the token cannot be
+	// judged either way.
+	ErrKeySetUnavailable = errors.New("key set unavailable")
+)
+
+// KeySet fetches a JSON Web Key Set (RFC 7517) through a Client and caches
+// its RSA signing keys for a TTL.
+//
+// A key ID missing from the cache triggers an early refetch, so keys the
+// issuer rotates in are picked up without waiting for the TTL. Fetches the
+// issuer answered are at least minJWKSRefreshInterval apart; a fetch that got
+// no response does not hold off the next one. When a refetch fails, the keys
+// from the last successful fetch stay in use.
+//
+// Concurrent callers share a single in-flight fetch, which runs without
+// holding the cache lock and detached from the caller's cancellation, so a
+// slow issuer or a canceled request does not stall or starve other callers.
+type KeySet struct {
+	client     *Client
+	url        string
+	ttl        time.Duration
+	minRefresh time.Duration
+	now        func() time.Time
+	fetches    singleflight.Group
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetched   time.Time // last successful fetch
+	attempted time.Time // last fetch the issuer responded to
+}
+
+// jwk is the subset of an RFC 7517 JSON Web Key that KeySet reads.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// NewKeySet creates a KeySet that fetches url through client and refetches
+// it once ttl has passed.
+func NewKeySet(client *Client, url string, ttl time.Duration) *KeySet {
+	return &KeySet{
+		client:     client,
+		url:        url,
+		ttl:        ttl,
+		minRefresh: minJWKSRefreshInterval,
+		now:        time.Now,
+	}
+}
+
+// Key returns the RSA public key with the given key ID, fetching the key set
+// when the cache is empty, stale, or lacks kid. It returns an error wrapping
+// ErrUnknownKey when the key set has no such key, or ErrKeySetUnavailable
+// when the key set could not be fetched and kid is not cached.
+func (k *KeySet) Key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	now := k.now()
+	k.mu.Lock()
+	key, ok := k.keys[kid]
+	fresh := k.keys != nil && now.Sub(k.fetched) < k.ttl
+	canRefresh := k.canRefresh(now)
+	k.mu.Unlock()
+
+	// A stale cache or an unknown kid (the issuer may have rotated keys)
+	// both call for a fetch, rate limited by canRefresh.
+	if (ok && fresh) || !canRefresh {
+		if ok {
+			return key, nil
+		}
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, kid)
+	}
+
+	fetchErr := k.refresh(ctx)
+	k.mu.Lock()
+	key, ok = k.keys[kid]
+	k.mu.Unlock()
+	switch {
+	case ok:
+		return key, nil
+	case fetchErr != nil:
+		return nil, fmt.Errorf("%w: resolving key %q: %w", ErrKeySetUnavailable, kid, fetchErr)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, kid)
+	}
+}
+
+// canRefresh reports whether a fetch may run at now. Callers hold k.mu.
+func (k *KeySet) canRefresh(now time.Time) bool {
+	return k.attempted.IsZero() || now.Sub(k.attempted) >= k.minRefresh
+}
+
+// refresh refetches the key set, keeping the cached keys on failure.
+// Concurrent calls share one fetch; a call that starts after another fetch
+// already got a response within minRefresh does nothing.
+func (k *KeySet) refresh(ctx context.Context) error {
+	_, err, _ := k.fetches.Do(k.url, func() (any, error) {
+		k.mu.Lock()
+		canRefresh := k.canRefresh(k.now())
+		k.mu.Unlock()
+		if !canRefresh {
+			return nil, nil
+		}
+
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), jwksFetchTimeout)
+		defer cancel()
+		keys, responded, err := k.fetch(fetchCtx)
+
+		now := k.now()
+		k.mu.Lock()
+		defer k.mu.Unlock()
+		if responded {
+			k.attempted = now
+		}
+		if err != nil {
+			k.client.logger.WarnContext(ctx, "JWKS refresh failed, keeping cached keys",
+				slog.String("url", k.url),
+				slog.Int("cached_keys", len(k.keys)),
+				slog.Any("error", err),
+			)
+			return nil, err
+		}
+		k.keys = keys
+		k.fetched = now
+		return nil, nil
+	})
+	return err
+}
+
+// fetch downloads and parses the key set, skipping keys that are not RSA
+// signing keys. responded reports whether the issuer sent any response, as
+// opposed to the request failing in transport.
+func (k *KeySet) fetch(ctx context.Context) (_ map[string]*rsa.PublicKey, responded bool, _ error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.url, http.NoBody)
+	if err != nil {
+		return nil, false, fmt.Errorf("creating JWKS request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := k.client.Do(ctx, req)
+	if resp == nil {
+		return nil, false, fmt.Errorf("requesting JWKS: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	keys, err := parseKeySet(resp)
+	return keys, true, err
+}
+
+// parseKeySet reads a JWKS response into its RSA signing keys.
+func parseKeySet(resp *http.Response) (map[string]*rsa.PublicKey, error) {
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned HTTP %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading JWKS response: %w", err)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(body, &set); err != nil {
+		return nil, fmt.Errorf("decoding JWKS response: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" || key.Kid == "" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+		pub, err := parseRSAKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("JWKS key %q: %w", key.Kid, err)
+		}
+		keys[key.Kid] = pub
+	}
+	return keys, nil
+}
+
+// parseRSAKey builds an RSA public key from a JWK's modulus and exponent.
+func parseRSAKey(key jwk) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil {
+		return nil, errors.New("modulus is not base64url")
+	}
+	e, err := base64.RawURLEncoding.DecodeString(key.E)
+	if err != nil {
+		return nil, errors.New("exponent is not base64url")
+	}
+	exp := new(big.Int).SetBytes(e)
+	if !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+		return nil, errors.New("exponent is out of range")
+	}
+
+	pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}
+	if pub.N.BitLen() < minRSAKeyBits {
+		return nil, fmt.Errorf("modulus shorter than %d bits", minRSAKeyBits)
+	}
+	return pub, nil
+}
@@ -0,0 +1,323 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/config"
+)
+
+// testJWKSServer serves the public halves of keys as a JWKS, counting
+// requests. Tests swap keys, fail the endpoint, or drop connections between
+// calls, and may hold requests on gate until it is closed.
+type testJWKSServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	keys  map[string]*rsa.PublicKey
+	fail  bool
+	drop  bool
+	gate  chan struct{}
+	count atomic.Int32
+}
+
+func newTestJWKSServer(t *testing.T, keys map[string]*rsa.PublicKey) *testJWKSServer {
+	t.Helper()
+
+	s := &testJWKSServer{keys: keys}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		s.count.Add(1)
+		s.mu.Lock()
+		gate := s.gate
+		s.mu.Unlock()
+		if gate != nil {
+			<-gate
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.drop {
+			if conn, _, err := http.NewResponseController(w).Hijack(); err == nil {
+				_ = conn.Close()
+			}
+			return
+		}
+		if s.fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		set := struct {
+			Keys []jwk `json:"keys"`
+		}{}
+		for kid, pub := range s.keys {
+			set.Keys = append(set.Keys, jwk{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testJWKSServer) set(keys map[string]*rsa.PublicKey, fail bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+	s.fail = fail
+}
+
+func testRSAKey(t *testing.T) *rsa.PublicKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+	if err != nil {
+		t.Fatalf("generating RSA key: %v", err)
+	}
+	return &key.PublicKey
+}
+
+// newTestKeySet returns a KeySet for srv on a client without retries, with
+// a controllable clock.
+func newTestKeySet(srv *testJWKSServer, ttl time.Duration) (*KeySet, *time.Time) {
+	client := New(&config.ClientConfig{
+		BaseURL:        srv.URL,
+		Timeout:        5 * time.Second,
+		CircuitBreaker: config.CircuitBreakerConfig{MaxFailures: 10, Timeout: time.Second, HalfOpenLimit: 1},
+	}, "jwks", nil, slog.New(slog.DiscardHandler))
+
+	ks := NewKeySet(client, srv.URL, ttl)
+	now := time.Now()
+	ks.now = func() time.Time { return now }
+	return ks, &now
+}
+
+func TestKeySet_CachesKeys(t *testing.T) {
+	t.Parallel()
+
+	pub := testRSAKey(t)
+	srv := newTestJWKSServer(t, map[string]*rsa.PublicKey{"k1": pub})
+	ks, _ := newTestKeySet(srv, time.Hour)
+
+	for range 3 {
+		got, err := ks.Key(context.Background(), "k1")
+		if err != nil {
+			t.Fatalf("Key() error = %v", err)
+		}
+		if !got.Equal(pub) {
+			t.Error("Key() returned a different key")
+		}
+	}
+	if got := srv.count.Load(); got != 1 {
+		t.Errorf("JWKS requests = %d, want 1", got)
+	}
+}
+
+func TestKeySet_RefetchesAfterTTL(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestJWKSServer(t, map[string]*rsa.PublicKey{"k1": testRSAKey(t)})
+	ks, now := newTestKeySet(srv, 5*time.Minute)
+
+	if _, err := ks.Key(context.Background(), "k1"); err != nil {
+		t.Fatalf("Key() error = %v", err)
+	}
+	*now = now.Add(5 * time.Minute)
+	if _, err := ks.Key(context.Background(), "k1"); err != nil {
+		t.Fatalf("Key() after TTL error = %v", err)
+	}
+	if got := srv.count.Load(); got != 2 {
+		t.Errorf("JWKS requests = %d, want 2", got)
+	}
+}
+
+func TestKeySet_UnknownKeyRefetchesForRotation(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestJWKSServer(t, map[string]*rsa.PublicKey{"k1": testRSAKey(t)})
+	ks, now := newTestKeySet(srv, time.Hour)
+
+	if _, err := ks.Key(context.Background(), "k1"); err != nil {
+		t.Fatalf("Key() error = %v", err)
+	}
+
+	rotated := testRSAKey(t)
+	srv.set(map[string]*rsa.PublicKey{"k2": rotated}, false)
+
+	// Within the minimum refresh interval the unknown key is not refetched.
+	if _, err := ks.Key(context.Background(), "k2"); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("Key(k2) error = %v, want ErrUnknownKey", err)
+	}
+	if got := srv.count.Load(); got != 1 {
+		t.Fatalf("JWKS requests = %d, want 1 within the refresh interval", got)
+	}
+
+	*now = now.Add(minJWKSRefreshInterval)
+	got, err := ks.Key(context.Background(), "k2")
+	if err != nil {
+		t.Fatalf("Key(k2) after rotation error = %v", err)
+	}
+	if !got.Equal(rotated) {
+		t.Error("Key(k2) returned a different key")
+	}
+}
+
+func TestKeySet_KeepsKeysWhenRefreshFails(t *testing.T) {
+	t.Parallel()
+
+	pub := testRSAKey(t)
+	srv := newTestJWKSServer(t, map[string]*rsa.PublicKey{"k1": pub})
+	ks, now := newTestKeySet(srv, time.Minute)
+
+	if _, err := ks.Key(context.Background(), "k1"); err != nil {
+		t.Fatalf("Key() error = %v", err)
+	}
+
+	srv.set(nil, true)
+	*now = now.Add(time.Minute)
+	got, err := ks.Key(context.Background(), "k1")
+	if err != nil {
+		t.Fatalf("Key() with failing endpoint error = %v, want cached key", err)
+	}
+	if !got.Equal(pub) {
+		t.Error("Key() returned a different key")
+	}
+}
+
+func TestKeySet_FetchFailureWithoutCache(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestJWKSServer(t, nil)
+	srv.set(nil, true)
+	ks, _ := newTestKeySet(srv, time.Minute)
+
+	_, err := ks.Key(context.Background(), "k1")
+	if !errors.Is(err, ErrKeySetUnavailable) {
+		t.Errorf("Key() error = %v, want ErrKeySetUnavailable", err)
+	}
+	if errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Key() error = %v, must not report the key as unknown", err)
+	}
+}
+
+func TestKeySet_CanceledRequestDoesNotBlockNextFetch(t *testing.T) {
+	t.Parallel()
+
+	pub := testRSAKey(t)
+	srv := newTestJWKSServer(t, map[string]*rsa.PublicKey{"k1": pub})
+	ks, _ := newTestKeySet(srv, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ks.Key(ctx, "k1"); err != nil {
+		t.Fatalf("Key() with canceled context error = %v, want fetch detached from cancellation", err)
+	}
+
+	got, err := ks.Key(context.Background(), "k1")
+	if err != nil {
+		t.Fatalf("Key() after canceled request error = %v", err)
+	}
+	if !got.Equal(pub) {
+		t.Error("Key() returned a different key")
+	}
+}
+
+func TestKeySet_UnansweredFetchDoesNotDelayRetry(t *testing.T) {
+	t.Parallel()
+
+	pub := testRSAKey(t)
+	srv := newTestJWKSServer(t, map[string]*rsa.PublicKey{"k1": pub})
+	srv.drop = true
+	ks, _ := newTestKeySet(srv, time.Hour)
+
+	if _, err := ks.Key(context.Background(), "k1"); !errors.Is(err, ErrKeySetUnavailable) {
+		t.Fatalf("Key() with dropped connection error = %v, want ErrKeySetUnavailable", err)
+	}
+
+	// The issuer never responded, so the clock has not advanced and the
+	// next call still fetches.
+	srv.mu.Lock()
+	srv.drop = false
+	srv.mu.Unlock()
+	got, err := ks.Key(context.Background(), "k1")
+	if err != nil {
+		t.Fatalf("Key() after dropped connection error = %v", err)
+	}
+	if !got.Equal(pub) {
+		t.Error("Key() returned a different key")
+	}
+	if got := srv.count.Load(); got != 2 {
+		t.Errorf("JWKS requests = %d, want 2", got)
+	}
+}
+
+func TestKeySet_ConcurrentCallersShareFetch(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestJWKSServer(t, map[string]*rsa.PublicKey{"k1": testRSAKey(t)})
+	gate := make(chan struct{})
+	srv.gate = gate
+	ks, _ := newTestKeySet(srv, time.Hour)
+
+	const callers = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for range callers {
+		wg.Go(func() {
+			_, err := ks.Key(context.Background(), "k1")
+			errs <- err
+		})
+	}
+	// Hold the first fetch until it is in flight, giving the other callers
+	// time to join it.
+	for srv.count.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(gate)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Key() error = %v", err)
+		}
+	}
+	if got := srv.count.Load(); got != 1 {
+		t.Errorf("JWKS requests = %d, want 1", got)
+	}
+}
+
+func TestParseRSAKey_RejectsShortModulus(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generating RSA key: %v", err)
+	}
+	_, err = parseRSAKey(jwk{
+		Kty: "RSA",
+		Kid: "weak",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	})
+	if err == nil {
+		t.Error("parseRSAKey() error = nil, want error for a 1024-bit key")
+	}
+}
@@ -12,66 +12,113 @@ import (
 	"math"
 	"net"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/logging"
 )
 
-// jitterFraction is the maximum jitter as a fraction of the delay (±25%).
-const jitterFraction = 0.25
+// AttemptHeader carries the 1-based attempt number of each outbound request,
+// so the downstream can tell retries from new requests with the same
+// X-Request-ID.
+const AttemptHeader = "X-Attempt"
 
 // doWithRetry executes the HTTP request with retry logic using exponential
-// backoff and ±25% jitter. Request bodies are buffered so they can be
-// replayed on each attempt. The result is written to resp rather than returned
-// to avoid false positives from the bodyclose linter; the caller is
-// responsible for closing the response body.
-func (c *Client) doWithRetry(ctx context.Context, req *http.Request, resp **http.Response) error {
-	if c.retryCfg.maxAttempts <= 0 {
-		return fmt.Errorf("httpclient: maxAttempts must be >= 1, got %d", c.retryCfg.maxAttempts)
+// backoff and jitter. Request bodies are buffered so they can be replayed on
+// each attempt. Retries stop after maxAttempts, or earlier when the next
+// backoff would push the total elapsed time past maxElapsedTime; in either case
+// the last error is returned. Requests that are not safe to replay (see
+// canRetry) get a single attempt, as do all requests when retries are disabled.
+// A RetryDecider can stop retries of a particular response. Each attempt
+// carries its number in AttemptHeader and a refreshed TimeoutHeader, replacing
+// the previous attempt's values. The result is written to resp rather than
+// returned to avoid false positives from the bodyclose linter; the caller is
+// responsible for closing the response body. The number of retries performed
+// (attempts after the first) is returned alongside the error.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request, resp **http.Response) (int, error) {
+	if c.retryCfg.enabled && c.retryCfg.maxAttempts <= 0 {
+		return 0, fmt.Errorf("httpclient: maxAttempts must be >= 1, got %d", c.retryCfg.maxAttempts)
 	}
 
 	bodyBytes, err := bufferRequestBody(req)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	var lastErr error
+	maxAttempts := c.retryCfg.maxAttempts
+	if !c.retryCfg.enabled || !c.canRetry(req) {
+		maxAttempts = 1
+	}
+
+	start := time.Now()
+	var (
+		lastErr error
+		delay   time.Duration
+	)
 
-	for attempt := range c.retryCfg.maxAttempts {
+	for attempt := range maxAttempts {
 		if attempt > 0 {
-			if err := c.waitForRetry(ctx, req, attempt, lastErr); err != nil {
-				return err
+			if err := c.waitForRetry(ctx, req, attempt, delay, lastErr); err != nil {
+				return attempt - 1, err
 			}
 		}
 
 		resetRequestBody(req, bodyBytes)
+		req.Header.Set(AttemptHeader, strconv.Itoa(attempt+1))
+		setTimeoutHeader(ctx, req)
+		c.signRequest(req, bodyBytes)
 
 		r, err := c.httpClient.Do(req)
 		if err != nil {
 			lastErr = err
 			if !isRetryable(err) {
-				return err
+				return attempt, err
+			}
+			delay = backoff(attempt+1, c.retryCfg, c.randFloat)
+			if !c.withinElapsedBudget(start, delay) {
+				return attempt, lastErr
 			}
 			continue
 		}
 
-		if !isRetryableStatus(r.StatusCode) {
+		if !c.retryCfg.retryableStatus(r.StatusCode) {
 			*resp = r
-			return nil
+			return attempt, c.statusError(r.StatusCode)
 		}
 
 		lastErr = fmt.Errorf("HTTP %d from %s", r.StatusCode, c.serviceName)
 
-		// On last attempt, return response with body intact for the caller.
-		if attempt == c.retryCfg.maxAttempts-1 {
+		// On the final attempt, or when the decider vetoes a retry, return
+		// the response with body intact for the caller.
+		delay = backoff(attempt+1, c.retryCfg, c.randFloat)
+		if attempt == maxAttempts-1 || !c.withinElapsedBudget(start, delay) || !c.shouldRetry(r) {
 			*resp = r
-			return lastErr
+			return attempt, c.statusError(r.StatusCode)
 		}
 
 		drainResponseBody(r)
 	}
 
-	return lastErr
+	return maxAttempts - 1, lastErr
+}
+
+// shouldRetry consults the RetryDecider, if any, about a response with a
+// retryable status. The body is buffered so the decider can read it and the
+// caller still receives it in full.
+func (c *Client) shouldRetry(r *http.Response) bool {
+	if c.decider == nil {
+		return true
+	}
+	body, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		// Nothing reliable for the decider to inspect; retry as usual.
+		return true
+	}
+	retry := c.decider(r)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return retry
 }
 
 // bufferRequestBody reads and closes the request body, returning the bytes
@@ -107,12 +154,50 @@ func drainResponseBody(resp *http.Response) {
 	_ = resp.Body.Close()
 }
 
-// waitForRetry calculates the backoff delay, logs the retry attempt at WARN
-// level, and waits for the delay or context cancellation.
-func (c *Client) waitForRetry(ctx context.Context, req *http.Request, attempt int, lastErr error) error {
-	delay := backoff(attempt, c.retryCfg)
+// idempotencyKeyHeader marks a non-idempotent request as safe to replay.
+const idempotencyKeyHeader = "Idempotency-Key"
 
+// canRetry reports whether req may be sent more than once. Idempotent methods
+// (GET, HEAD, OPTIONS, PUT, DELETE) always may; other methods only when they
+// carry an Idempotency-Key header or retryNonIdempotent is enabled.
+func (c *Client) canRetry(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return c.retryCfg.retryNonIdempotent || req.Header.Get(idempotencyKeyHeader) != ""
+}
+
+// withinElapsedBudget reports whether waiting delay more would keep the total
+// time since start within maxElapsedTime. Always true when no cap is set.
+func (c *Client) withinElapsedBudget(start time.Time, delay time.Duration) bool {
+	if c.retryCfg.maxElapsedTime <= 0 {
+		return true
+	}
+	return time.Since(start)+delay <= c.retryCfg.maxElapsedTime
+}
+
+// waitForRetry logs the retry attempt at WARN level and waits for the backoff
+// delay or context cancellation. If the context's deadline would pass before
+// the backoff ends, it returns context.DeadlineExceeded immediately instead
+// of sleeping into a retry that cannot complete.
+func (c *Client) waitForRetry(ctx context.Context, req *http.Request, attempt int, delay time.Duration, lastErr error) error {
 	logger := logging.FromContext(ctx)
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		logger.WarnContext(ctx, "abandoning retry: backoff exceeds request deadline",
+			slog.String("operation", "httpclient.Do"),
+			slog.String("method", req.Method),
+			slog.String("url", req.URL.String()),
+			slog.String("peer_service", c.serviceName),
+			slog.Int("attempt", attempt+1),
+			slog.Duration("backoff", delay),
+			slog.Duration("remaining", time.Until(deadline)),
+			slog.Any("error", lastErr),
+		)
+		return fmt.Errorf("retry backoff of %v exceeds request deadline: %w", delay, context.DeadlineExceeded)
+	}
+
 	logger.WarnContext(ctx, "retrying HTTP request",
 		slog.String("operation", "httpclient.Do"),
 		slog.String("method", req.Method),
@@ -133,9 +218,10 @@ func (c *Client) waitForRetry(ctx context.Context, req *http.Request, attempt in
 }
 
 // backoff calculates the delay for a given retry attempt using exponential
-// backoff with ±25% jitter. The attempt parameter is 1-indexed (attempt 1 is
-// the first retry).
-func backoff(attempt int, cfg retryConfig) time.Duration {
+// backoff with ±cfg.jitterFraction jitter drawn from randFloat, which returns
+// values in [0, 1); with no jitter the delay is deterministic. The attempt
+// parameter is 1-indexed (attempt 1 is the first retry).
+func backoff(attempt int, cfg retryConfig, randFloat func() float64) time.Duration {
 	delay := float64(cfg.initialInterval) * math.Pow(cfg.multiplier, float64(attempt-1))
 
 	// Cap at max interval before applying jitter.
@@ -143,9 +229,11 @@ func backoff(attempt int, cfg retryConfig) time.Duration {
 		delay = float64(cfg.maxInterval)
 	}
 
-	// Apply ±25% jitter to prevent thundering herd.
-	jitter := delay * jitterFraction
-	delay += jitter * (2*secureRandFloat64() - 1)
+	// Apply jitter to prevent thundering herd.
+	if cfg.jitterFraction > 0 {
+		jitter := delay * cfg.jitterFraction
+		delay += jitter * (2*randFloat() - 1)
+	}
 
 	if delay < 0 {
 		delay = 0
@@ -191,11 +279,46 @@ func isRetryable(err error) bool {
 	return true
 }
 
-// isRetryableStatus determines whether an HTTP status code is retryable.
-// Server errors (5xx) and 429 Too Many Requests are retryable.
+// isRetryableStatus determines whether an HTTP status code is retryable by
+// default. Server errors (5xx) and 429 Too Many Requests are retryable. The
+// same statuses are failures (see statusError) whatever the configured
+// retryable set.
 func isRetryableStatus(statusCode int) bool {
 	if statusCode == http.StatusTooManyRequests {
 		return true
 	}
 	return statusCode >= http.StatusInternalServerError
 }
+
+// retryableStatus reports whether statusCode is retryable under the
+// configured status set, or the default set when none is configured. It only
+// decides whether to retry; statusError decides whether the final response
+// is a failure.
+func (r retryConfig) retryableStatus(statusCode int) bool {
+	if r.retryableStatuses == nil {
+		return isRetryableStatus(statusCode)
+	}
+	return r.retryableStatuses[statusCode]
+}
+
+// statusError returns an error for a final response whose status is a
+// failure, 429 or any 5xx, so the circuit breaker counts it. Other statuses,
+// including 4xx codes configured as retryable, return nil.
+func (c *Client) statusError(statusCode int) error {
+	if !isRetryableStatus(statusCode) {
+		return nil
+	}
+	return fmt.Errorf("HTTP %d from %s", statusCode, c.serviceName)
+}
+
+// statusSet returns codes as a set, or nil when codes is empty.
+func statusSet(codes []int) map[int]bool {
+	if len(codes) == 0 {
+		return nil
+	}
+	set := make(map[int]bool, len(codes))
+	for _, code := range codes {
+		set[code] = true
+	}
+	return set
+}
@@ -3,10 +3,16 @@ package httpclient
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"math"
 	"net"
 	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/config"
 )
 
 func TestBackoff_ExponentialIncrease(t *testing.T) {
@@ -16,17 +22,18 @@ func TestBackoff_ExponentialIncrease(t *testing.T) {
 		initialInterval: 100 * time.Millisecond,
 		maxInterval:     10 * time.Second,
 		multiplier:      2.0,
+		jitterFraction:  0.25,
 	}
 
 	// Run multiple samples to account for jitter.
 	const samples = 100
 	for attempt := 1; attempt <= 3; attempt++ {
 		baseDelay := float64(100*time.Millisecond) * pow(2.0, attempt-1)
-		minExpected := time.Duration(baseDelay * (1 - jitterFraction))
-		maxExpected := time.Duration(baseDelay * (1 + jitterFraction))
+		minExpected := time.Duration(baseDelay * (1 - cfg.jitterFraction))
+		maxExpected := time.Duration(baseDelay * (1 + cfg.jitterFraction))
 
 		for range samples {
-			delay := backoff(attempt, cfg)
+			delay := backoff(attempt, cfg, secureRandFloat64)
 			if delay < minExpected || delay > maxExpected {
 				t.Errorf("attempt %d: delay %v not in [%v, %v]", attempt, delay, minExpected, maxExpected)
 			}
@@ -41,14 +48,15 @@ func TestBackoff_CappedAtMaxInterval(t *testing.T) {
 		initialInterval: 100 * time.Millisecond,
 		maxInterval:     500 * time.Millisecond,
 		multiplier:      2.0,
+		jitterFraction:  0.25,
 	}
 
 	// Attempt 10 would be 100ms * 2^9 = 51.2s without cap.
-	maxWithJitter := time.Duration(float64(cfg.maxInterval) * (1 + jitterFraction))
+	maxWithJitter := time.Duration(float64(cfg.maxInterval) * (1 + cfg.jitterFraction))
 
 	const samples = 100
 	for range samples {
-		delay := backoff(10, cfg)
+		delay := backoff(10, cfg, secureRandFloat64)
 		if delay > maxWithJitter {
 			t.Errorf("delay %v exceeds max interval with jitter %v", delay, maxWithJitter)
 		}
@@ -62,21 +70,122 @@ func TestBackoff_JitterWithinBounds(t *testing.T) {
 		initialInterval: 100 * time.Millisecond,
 		maxInterval:     10 * time.Second,
 		multiplier:      2.0,
+		jitterFraction:  0.25,
 	}
 
 	baseDelay := 100 * time.Millisecond
-	minExpected := time.Duration(float64(baseDelay) * (1 - jitterFraction))
-	maxExpected := time.Duration(float64(baseDelay) * (1 + jitterFraction))
+	minExpected := time.Duration(float64(baseDelay) * (1 - cfg.jitterFraction))
+	maxExpected := time.Duration(float64(baseDelay) * (1 + cfg.jitterFraction))
 
 	const samples = 1000
 	for range samples {
-		delay := backoff(1, cfg)
+		delay := backoff(1, cfg, secureRandFloat64)
 		if delay < minExpected || delay > maxExpected {
 			t.Errorf("delay %v not in [%v, %v]", delay, minExpected, maxExpected)
 		}
 	}
 }
 
+func TestBackoff_NoJitterIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	cfg := retryConfig{
+		initialInterval: 100 * time.Millisecond,
+		maxInterval:     10 * time.Second,
+		multiplier:      1.5,
+	}
+
+	for attempt := 1; attempt <= 5; attempt++ {
+		want := float64(100*time.Millisecond) * pow(1.5, attempt-1)
+		got := float64(backoff(attempt, cfg, secureRandFloat64))
+		if math.Abs(got-want) > 1 {
+			t.Errorf("attempt %d: delay %v, want %v", attempt, time.Duration(got), time.Duration(want))
+		}
+	}
+}
+
+func TestBackoff_FixedRandomSource(t *testing.T) {
+	t.Parallel()
+
+	c := New(&config.ClientConfig{
+		BaseURL: "http://localhost",
+		Timeout: time.Second,
+		Retry: config.RetryConfig{
+			Enabled:         true,
+			MaxAttempts:     4,
+			InitialInterval: 100 * time.Millisecond,
+			MaxInterval:     10 * time.Second,
+			Multiplier:      2.0,
+			JitterFraction:  0.2,
+		},
+		CircuitBreaker: config.CircuitBreakerConfig{MaxFailures: 1, Timeout: time.Second, HalfOpenLimit: 1},
+	}, "test-svc", nil, slog.New(slog.DiscardHandler))
+	// 0.75 maps to +50% of the jitter range: +10% with a 0.2 fraction.
+	c.randFloat = func() float64 { return 0.75 }
+
+	want := []time.Duration{110 * time.Millisecond, 220 * time.Millisecond, 440 * time.Millisecond}
+	for i, w := range want {
+		attempt := i + 1
+		if got := backoff(attempt, c.retryCfg, c.randFloat); got != w {
+			t.Errorf("attempt %d: delay %v, want %v", attempt, got, w)
+		}
+	}
+}
+
+func TestDoWithRetry_UsesClientRandomSource(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		times []time.Time
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		times = append(times, time.Now())
+		mu.Unlock()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := New(&config.ClientConfig{
+		BaseURL: srv.URL,
+		Timeout: time.Second,
+		Retry: config.RetryConfig{
+			Enabled:         true,
+			MaxAttempts:     3,
+			InitialInterval: 25 * time.Millisecond,
+			MaxInterval:     time.Second,
+			Multiplier:      2.0,
+			JitterFraction:  1,
+		},
+		CircuitBreaker: config.CircuitBreakerConfig{MaxFailures: 10, Timeout: time.Second, HalfOpenLimit: 1},
+	}, "test-svc", nil, slog.New(slog.DiscardHandler))
+	// 0.99 sits at the top of the ±100% jitter range, nearly doubling each
+	// delay. A random source would land this high only ~1% of the time.
+	c.randFloat = func() float64 { return 0.99 }
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, _ := c.Do(context.Background(), req)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(times) != 3 {
+		t.Fatalf("attempts = %d, want 3", len(times))
+	}
+	for i := 1; i < len(times); i++ {
+		want := backoff(i, c.retryCfg, c.randFloat)
+		if gap := times[i].Sub(times[i-1]); gap < want {
+			t.Errorf("gap before attempt %d = %v, want at least %v", i+1, gap, want)
+		}
+	}
+}
+
 func TestIsRetryable_Nil(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,134 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/config"
+)
+
+// maxTokenResponseSize limits how much of a token endpoint response we read.
+const maxTokenResponseSize = 1 << 16 // 64 KB
+
+// defaultTokenLifetime is how long a token is cached when the response omits
+// expires_in (or sends 0). RFC 6749 makes the field optional; without a
+// fallback such tokens would expire on issue and be refetched per request.
+const defaultTokenLifetime = 5 * time.Minute
+
+// TokenSource fetches OAuth2 access tokens using the client credentials grant
+// and caches them until they are near expiry. It satisfies ports.TokenSource
+// via structural typing.
+//
+// Concurrent callers that find the cached token missing or near expiry are
+// serialized on a mutex, so only the first performs the refresh; the rest
+// reuse its result.
+type TokenSource struct {
+	httpClient    *http.Client
+	tokenURL      string
+	clientID      string
+	clientSecret  string
+	scopes        []string
+	refreshBefore time.Duration
+	now           func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// tokenResponse is the RFC 6749 §5.1 access token response body.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// NewTokenSource creates a TokenSource for the given OAuth settings. Token
+// endpoint requests use a dedicated http.Client with the given timeout.
+func NewTokenSource(cfg config.OAuthConfig, timeout time.Duration) *TokenSource {
+	return &TokenSource{
+		httpClient:    &http.Client{Timeout: timeout},
+		tokenURL:      cfg.TokenURL,
+		clientID:      cfg.ClientID,
+		clientSecret:  cfg.ClientSecret,
+		scopes:        cfg.Scopes,
+		refreshBefore: cfg.RefreshBefore,
+		now:           time.Now,
+	}
+}
+
+// Token returns a valid access token, fetching a new one from the token
+// endpoint when none is cached or the cached token expires within the
+// configured refresh window.
+func (s *TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Add(s.refreshBefore).Before(s.expires) {
+		return s.token, nil
+	}
+
+	tok, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	lifetime := time.Duration(tok.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultTokenLifetime
+	}
+
+	s.token = tok.AccessToken
+	s.expires = s.now().Add(lifetime)
+	return s.token, nil
+}
+
+// fetch performs the client credentials grant against the token endpoint.
+func (s *TokenSource) fetch(ctx context.Context) (*tokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", s.clientID)
+	form.Set("client_secret", s.clientSecret)
+	if len(s.scopes) > 0 {
+		form.Set("scope", strings.Join(s.scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("creating token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("requesting token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading token response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned HTTP %d", resp.StatusCode)
+	}
+
+	var tok tokenResponse
+	if err := json.Unmarshal(body, &tok); err != nil {
+		return nil, fmt.Errorf("decoding token response: %w", err)
+	}
+	if tok.AccessToken == "" {
+		return nil, errors.New("token response missing access_token")
+	}
+
+	return &tok, nil
+}
@@ -0,0 +1,157 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/config"
+)
+
+// newTestTokenServer returns a token endpoint that issues a distinct token
+// per request with the given lifetime, counting requests in count.
+func newTestTokenServer(t *testing.T, expiresIn int, count *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := count.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"tok-%d","token_type":"Bearer","expires_in":%d}`, n, expiresIn)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestTokenSource_CachesToken(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	srv := newTestTokenServer(t, 3600, &count)
+
+	ts := NewTokenSource(config.OAuthConfig{TokenURL: srv.URL, ClientID: "id", ClientSecret: "secret"}, time.Second)
+
+	for range 3 {
+		tok, err := ts.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Token() error = %v", err)
+		}
+		if tok != "tok-1" {
+			t.Errorf("Token() = %q, want %q", tok, "tok-1")
+		}
+	}
+
+	if got := count.Load(); got != 1 {
+		t.Errorf("token requests = %d, want 1", got)
+	}
+}
+
+func TestTokenSource_MissingExpiryUsesDefaultLifetime(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	srv := newTestTokenServer(t, 0, &count)
+
+	ts := NewTokenSource(config.OAuthConfig{
+		TokenURL:      srv.URL,
+		ClientID:      "id",
+		ClientSecret:  "secret",
+		RefreshBefore: 30 * time.Second,
+	}, time.Second)
+
+	now := time.Now()
+	ts.now = func() time.Time { return now }
+
+	for range 3 {
+		if _, err := ts.Token(context.Background()); err != nil {
+			t.Fatalf("Token() error = %v", err)
+		}
+	}
+	if got := count.Load(); got != 1 {
+		t.Errorf("token requests = %d, want 1 (expires_in=0 must not expire on issue)", got)
+	}
+
+	// Past the default lifetime the token is refreshed.
+	now = now.Add(defaultTokenLifetime)
+	if _, err := ts.Token(context.Background()); err != nil {
+		t.Fatalf("Token() error = %v", err)
+	}
+	if got := count.Load(); got != 2 {
+		t.Errorf("token requests = %d, want 2 after default lifetime elapsed", got)
+	}
+}
+
+func TestTokenSource_ExpiredTokenRefreshesOnceUnderConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	srv := newTestTokenServer(t, 60, &count)
+
+	ts := NewTokenSource(config.OAuthConfig{
+		TokenURL:      srv.URL,
+		ClientID:      "id",
+		ClientSecret:  "secret",
+		RefreshBefore: 10 * time.Second,
+	}, time.Second)
+
+	now := time.Now()
+	var clockMu sync.Mutex
+	ts.now = func() time.Time {
+		clockMu.Lock()
+		defer clockMu.Unlock()
+		return now
+	}
+
+	if _, err := ts.Token(context.Background()); err != nil {
+		t.Fatalf("initial Token() error = %v", err)
+	}
+
+	// Move the clock inside the refresh window (60s lifetime - 10s early refresh).
+	clockMu.Lock()
+	now = now.Add(55 * time.Second)
+	clockMu.Unlock()
+
+	const callers = 20
+	var wg sync.WaitGroup
+	tokens := make([]string, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			tok, err := ts.Token(context.Background())
+			if err != nil {
+				t.Errorf("Token() error = %v", err)
+			}
+			tokens[idx] = tok
+		}(i)
+	}
+	wg.Wait()
+
+	if got := count.Load(); got != 2 {
+		t.Errorf("token requests = %d, want 2 (initial + exactly one refresh)", got)
+	}
+	for i, tok := range tokens {
+		if tok != "tok-2" {
+			t.Errorf("caller %d token = %q, want %q", i, tok, "tok-2")
+		}
+	}
+}
+
+func TestTokenSource_ErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(srv.Close)
+
+	ts := NewTokenSource(config.OAuthConfig{TokenURL: srv.URL, ClientID: "id", ClientSecret: "bad"}, time.Second)
+
+	if _, err := ts.Token(context.Background()); err == nil {
+		t.Fatal("Token() error = nil, want error for 401 response")
+	}
+}
@@ -5,6 +5,11 @@
 //
 //	logger := logging.New("info", "json", os.Stderr)
 //
+// Runtime level changes (the returned *slog.LevelVar backs the handler):
+//
+//	logger, level := logging.NewWithLevel("info", "json", os.Stderr)
+//	_ = logging.SetLevel(level, "debug")
+//
 // Context propagation (used by middleware to enrich with request metadata):
 //
 //	ctx = logging.WithLogger(ctx, logger)
@@ -25,6 +30,7 @@ package logging
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"log/slog"
 	"strings"
@@ -33,6 +39,23 @@ import (
 // contextKey is the unexported key type for storing loggers in context.
 type contextKey struct{}
 
+// options holds optional settings for New and NewWithLevel.
+type options struct {
+	redactFields []string
+}
+
+// Option configures New and NewWithLevel.
+type Option func(*options)
+
+// WithRedactFields adds attribute names whose values are redacted in addition
+// to the built-in sensitive fields. Matching is case-insensitive and applies
+// to attributes at any group depth.
+func WithRedactFields(fields ...string) Option {
+	return func(o *options) {
+		o.redactFields = append(o.redactFields, fields...)
+	}
+}
+
 // New creates a configured *slog.Logger.
 //
 // The level parameter sets the minimum log level. Valid values are "debug",
@@ -43,23 +66,39 @@ type contextKey struct{}
 // slog.NewJSONHandler.
 //
 // When level is "debug", source code location is included in log output.
-func New(level, format string, w io.Writer) *slog.Logger {
+func New(level, format string, w io.Writer, opts ...Option) *slog.Logger {
+	logger, _ := NewWithLevel(level, format, w, opts...)
+	return logger
+}
+
+// NewWithLevel is like New but also returns the *slog.LevelVar backing the
+// handler's minimum level, so the level can be changed at runtime (e.g., from
+// an admin endpoint) without rebuilding the logger.
+func NewWithLevel(level, format string, w io.Writer, opts ...Option) (*slog.Logger, *slog.LevelVar) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	lvl := parseLevel(level)
 
-	opts := &slog.HandlerOptions{
-		Level:       lvl,
+	levelVar := new(slog.LevelVar)
+	levelVar.Set(lvl)
+
+	handlerOpts := &slog.HandlerOptions{
+		Level:       levelVar,
 		AddSource:   lvl == slog.LevelDebug,
-		ReplaceAttr: newRedactAttr(),
+		ReplaceAttr: newRedactAttr(o.redactFields),
 	}
 
 	var handler slog.Handler
 	if format == "text" {
-		handler = slog.NewTextHandler(w, opts)
+		handler = slog.NewTextHandler(w, handlerOpts)
 	} else {
-		handler = slog.NewJSONHandler(w, opts)
+		handler = slog.NewJSONHandler(w, handlerOpts)
 	}
 
-	return slog.New(handler)
+	return slog.New(handler), levelVar
 }
 
 // WithLogger returns a new context with the given logger stored in it.
@@ -76,19 +115,41 @@ func FromContext(ctx context.Context) *slog.Logger {
 	return slog.Default()
 }
 
-// parseLevel converts a level string to slog.Level.
-// Unrecognized values default to slog.LevelInfo.
-func parseLevel(level string) slog.Level {
-	switch strings.ToLower(level) {
+// SetLevel parses level and stores it in v, changing the minimum level of
+// every logger built on v. Returns an error, leaving v unchanged, if level is
+// not recognized.
+func SetLevel(v *slog.LevelVar, level string) error {
+	lvl, err := ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	v.Set(lvl)
+	return nil
+}
+
+// ParseLevel converts a level string ("debug", "info", "warn", or "error",
+// case-insensitive) to slog.Level. Unrecognized values return an error.
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
 	case "debug":
-		return slog.LevelDebug
+		return slog.LevelDebug, nil
 	case "info":
-		return slog.LevelInfo
+		return slog.LevelInfo, nil
 	case "warn":
-		return slog.LevelWarn
+		return slog.LevelWarn, nil
 	case "error":
-		return slog.LevelError
+		return slog.LevelError, nil
 	default:
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q", level)
+	}
+}
+
+// parseLevel converts a level string to slog.Level.
+// Unrecognized values default to slog.LevelInfo.
+func parseLevel(level string) slog.Level {
+	lvl, err := ParseLevel(level)
+	if err != nil {
 		return slog.LevelInfo
 	}
+	return lvl
 }
@@ -159,6 +159,74 @@ func TestNew_LevelCaseInsensitive(t *testing.T) {
 	}
 }
 
+// --- NewWithLevel tests ---
+
+func TestNewWithLevel_MutatingVarChangesFiltering(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger, level := logging.NewWithLevel("warn", "json", &buf)
+
+	logger.Info("filtered")
+	if buf.Len() != 0 {
+		t.Fatalf("info record emitted at warn level: %s", buf.String())
+	}
+
+	level.Set(slog.LevelDebug)
+	logger.Debug("visible")
+	if !strings.Contains(buf.String(), "visible") {
+		t.Errorf("output = %q, want debug record after lowering level", buf.String())
+	}
+
+	buf.Reset()
+	level.Set(slog.LevelError)
+	logger.Warn("filtered again")
+	if buf.Len() != 0 {
+		t.Errorf("warn record emitted after raising level to error: %s", buf.String())
+	}
+}
+
+func TestNewWithLevel_InitialLevel(t *testing.T) {
+	t.Parallel()
+
+	_, level := logging.NewWithLevel("error", "json", &bytes.Buffer{})
+	if got := level.Level(); got != slog.LevelError {
+		t.Errorf("Level() = %v, want %v", got, slog.LevelError)
+	}
+}
+
+// --- SetLevel tests ---
+
+func TestSetLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    slog.Level
+		wantErr bool
+	}{
+		{name: "debug", input: "debug", want: slog.LevelDebug},
+		{name: "upper case", input: "WARN", want: slog.LevelWarn},
+		{name: "unknown leaves level unchanged", input: "verbose", want: slog.LevelInfo, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := new(slog.LevelVar)
+			err := logging.SetLevel(v, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetLevel(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got := v.Level(); got != tt.want {
+				t.Errorf("Level() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // --- Context tests ---
 
 func TestFromContext_WithLogger(t *testing.T) {
@@ -254,6 +322,30 @@ func TestNew_DefenseInDepthBearerRegex(t *testing.T) {
 	}
 }
 
+func TestNew_RedactsConfiguredFields(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := logging.New("info", "json", &buf, logging.WithRedactFields("ssn"))
+
+	logger.Info("customer",
+		slog.String("SSN", "123-45-6789"),
+		slog.Group("profile", slog.String("ssn", "987-65-4321")),
+		slog.String("name", "Ada"),
+	)
+
+	out := buf.String()
+	if strings.Contains(out, "123-45-6789") || strings.Contains(out, "987-65-4321") {
+		t.Errorf("output = %q, want ssn values redacted at top level and in groups", out)
+	}
+	if !strings.Contains(out, "[REDACTED]") {
+		t.Error("log output missing [REDACTED] marker")
+	}
+	if !strings.Contains(out, `"name":"Ada"`) {
+		t.Errorf("output = %q, want unrelated field to pass through", out)
+	}
+}
+
 func TestNew_DoesNotRedactNonSensitiveFields(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package logging
 import (
 	"log/slog"
 	"regexp"
+	"strings"
 
 	"github.com/m-mizutani/masq"
 )
@@ -30,13 +31,16 @@ var jwtPattern = regexp.MustCompile(`[a-zA-Z0-9\-_]{10,}\.[a-zA-Z0-9\-_]{10,}\.[
 var apiKeyInlinePattern = regexp.MustCompile(`(?i)(api[_\-]?key|apikey)\s*[:=]\s*\S+`)
 
 // fixedRedactOptions is the number of masq options beyond the dynamic
-// SensitiveHeaders set (3 field names + 2 prefixes + 3 regexes).
-const fixedRedactOptions = 8
+// SensitiveHeaders set (3 field names + 2 prefixes + 3 regexes + 1 censor
+// for configured extra fields).
+const fixedRedactOptions = 9
 
 // newRedactAttr returns a masq-powered ReplaceAttr function for use in
 // slog.HandlerOptions. It redacts by field name for known sensitive fields
-// and by regex for values that escape call-site redaction.
-func newRedactAttr() func([]string, slog.Attr) slog.Attr {
+// and by regex for values that escape call-site redaction. extraFields are
+// matched case-insensitively; slog passes attributes nested in groups to
+// ReplaceAttr individually, so they are covered at any depth.
+func newRedactAttr(extraFields []string) func([]string, slog.Attr) slog.Attr {
 	opts := make([]masq.Option, 0, fixedRedactOptions+len(SensitiveHeaders))
 
 	// Sensitive header names shared with the HTTP middleware layer.
@@ -60,5 +64,21 @@ func newRedactAttr() func([]string, slog.Attr) slog.Attr {
 		masq.WithRegex(apiKeyInlinePattern),
 	)
 
+	if len(extraFields) > 0 {
+		opts = append(opts, masq.WithCensor(fieldNameFoldCensor(extraFields)))
+	}
+
 	return masq.New(opts...)
 }
+
+// fieldNameFoldCensor returns a masq censor matching any of names
+// case-insensitively.
+func fieldNameFoldCensor(names []string) masq.Censor {
+	set := make(map[string]bool, len(names))
+	for _, n := range names {
+		set[strings.ToLower(strings.TrimSpace(n))] = true
+	}
+	return func(fieldName string, _ any, _ string) bool {
+		return set[strings.ToLower(fieldName)]
+	}
+}
@@ -0,0 +1,152 @@
+// Package paging parses list pagination parameters and builds the page
+// metadata returned with list responses, so every list endpoint pages the
+// same way.
+//
+// A page is addressed by limit and either offset or cursor. The cursor is an
+// opaque token for the next page, returned in Meta.NextCursor; clients pass
+// it back unchanged instead of computing offsets.
+package paging
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultLimit is the page size when the request names none.
+	DefaultLimit = 50
+	// MaxLimit is the largest page size a request may ask for.
+	MaxLimit = 100
+)
+
+// cursorPrefix marks a decoded cursor, so arbitrary base64 is rejected.
+const cursorPrefix = "offset:"
+
+// Page is a validated page request.
+type Page struct {
+	Limit  int
+	Offset int
+	// Cursor is the token the page was requested with, empty when the
+	// request used offset. Offset is already decoded from it.
+	Cursor string
+}
+
+// Meta describes the page a list response holds.
+type Meta struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+	// Total is the number of items across all pages.
+	Total int `json:"total"`
+	// NextCursor requests the following page, and is empty on the last.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// ValidationError reports invalid pagination parameters, keyed by parameter
+// name.
+type ValidationError struct {
+	Fields map[string]string
+}
+
+func (e *ValidationError) Error() string {
+	keys := make([]string, 0, len(e.Fields))
+	for k := range e.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + ": " + e.Fields[k]
+	}
+	return "invalid pagination: " + strings.Join(parts, "; ")
+}
+
+// Parse builds a Page from the limit, offset, and cursor query parameters.
+// A missing limit is DefaultLimit and a missing offset is 0. Every invalid
+// parameter is reported together in a *ValidationError.
+func Parse(q url.Values) (Page, error) {
+	page := Page{Limit: DefaultLimit}
+	fields := make(map[string]string)
+
+	if raw := q.Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 || n > MaxLimit {
+			fields["limit"] = fmt.Sprintf("must be an integer between 1 and %d", MaxLimit)
+		} else {
+			page.Limit = n
+		}
+	}
+
+	rawOffset, rawCursor := q.Get("offset"), q.Get("cursor")
+	switch {
+	case rawOffset != "" && rawCursor != "":
+		fields["cursor"] = "must not be combined with offset"
+	case rawOffset != "":
+		n, err := strconv.Atoi(rawOffset)
+		if err != nil || n < 0 {
+			fields["offset"] = "must be a non-negative integer"
+		} else {
+			page.Offset = n
+		}
+	case rawCursor != "":
+		n, ok := decodeCursor(rawCursor)
+		if !ok {
+			fields["cursor"] = "is not a valid cursor"
+		} else {
+			page.Offset = n
+			page.Cursor = rawCursor
+		}
+	}
+
+	if len(fields) > 0 {
+		return Page{}, &ValidationError{Fields: fields}
+	}
+	return page, nil
+}
+
+// Bounds returns the slice bounds of the page within total items, clamped so
+// a page past the end is empty.
+func (p Page) Bounds(total int) (start, end int) {
+	start = min(p.Offset, total)
+	end = min(start+p.Limit, total)
+	return start, end
+}
+
+// Meta returns the metadata for the page within total items.
+func (p Page) Meta(total int) Meta {
+	m := Meta{Limit: p.Limit, Offset: p.Offset, Total: total}
+	if _, end := p.Bounds(total); end < total {
+		m.NextCursor = encodeCursor(end)
+	}
+	return m
+}
+
+// Slice returns the items on page p, together with its metadata.
+func Slice[T any](items []T, p Page) ([]T, Meta) {
+	start, end := p.Bounds(len(items))
+	return items[start:end], p.Meta(len(items))
+}
+
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, false
+	}
+	digits, ok := strings.CutPrefix(string(raw), cursorPrefix)
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,144 @@
+package paging_test
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/paging"
+)
+
+func TestParse_Defaults(t *testing.T) {
+	t.Parallel()
+
+	page, err := paging.Parse(url.Values{})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if page.Limit != paging.DefaultLimit || page.Offset != 0 || page.Cursor != "" {
+		t.Errorf("Parse() = %+v, want limit %d and offset 0", page, paging.DefaultLimit)
+	}
+}
+
+func TestParse_Valid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		query      string
+		wantLimit  int
+		wantOffset int
+	}{
+		{"limit and offset", "limit=10&offset=20", 10, 20},
+		{"minimum limit", "limit=1", 1, 0},
+		{"maximum limit", "limit=100", paging.MaxLimit, 0},
+		{"zero offset", "offset=0", paging.DefaultLimit, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			q, _ := url.ParseQuery(tt.query)
+			page, err := paging.Parse(q)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.query, err)
+			}
+			if page.Limit != tt.wantLimit || page.Offset != tt.wantOffset {
+				t.Errorf("Parse(%q) = %+v, want limit %d offset %d", tt.query, page, tt.wantLimit, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		query      string
+		wantFields []string
+	}{
+		{"zero limit", "limit=0", []string{"limit"}},
+		{"limit over maximum", "limit=101", []string{"limit"}},
+		{"non-numeric limit", "limit=ten", []string{"limit"}},
+		{"negative offset", "offset=-1", []string{"offset"}},
+		{"non-numeric offset", "offset=x", []string{"offset"}},
+		{"garbage cursor", "cursor=%25%25", []string{"cursor"}},
+		{"cursor without prefix", "cursor=MTA", []string{"cursor"}},
+		{"cursor with offset", "cursor=b2Zmc2V0OjEw&offset=5", []string{"cursor"}},
+		{"several at once", "limit=0&offset=-1", []string{"limit", "offset"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			q, _ := url.ParseQuery(tt.query)
+			_, err := paging.Parse(q)
+
+			var verr *paging.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Parse(%q) error = %v, want *ValidationError", tt.query, err)
+			}
+			if len(verr.Fields) != len(tt.wantFields) {
+				t.Errorf("Fields = %v, want keys %v", verr.Fields, tt.wantFields)
+			}
+			for _, f := range tt.wantFields {
+				if _, ok := verr.Fields[f]; !ok {
+					t.Errorf("Fields = %v, missing %q", verr.Fields, f)
+				}
+			}
+			if !strings.Contains(err.Error(), tt.wantFields[0]) {
+				t.Errorf("Error() = %q, want it to name %q", err.Error(), tt.wantFields[0])
+			}
+		})
+	}
+}
+
+func TestSlice_FollowsNextCursor(t *testing.T) {
+	t.Parallel()
+
+	items := []int{1, 2, 3, 4, 5}
+	q := url.Values{"limit": {"2"}}
+
+	var got []int
+	for range len(items) {
+		page, err := paging.Parse(q)
+		if err != nil {
+			t.Fatalf("Parse(%v) error = %v", q, err)
+		}
+		pageItems, meta := paging.Slice(items, page)
+		got = append(got, pageItems...)
+		if meta.Total != len(items) || meta.Limit != 2 {
+			t.Errorf("Meta = %+v, want total %d and limit 2", meta, len(items))
+		}
+		if meta.NextCursor == "" {
+			break
+		}
+		q = url.Values{"limit": {"2"}, "cursor": {meta.NextCursor}}
+	}
+
+	if len(got) != len(items) {
+		t.Fatalf("walked %v, want %v", got, items)
+	}
+	for i := range items {
+		if got[i] != items[i] {
+			t.Errorf("walked %v, want %v", got, items)
+			break
+		}
+	}
+}
+
+func TestSlice_PastEnd(t *testing.T) {
+	t.Parallel()
+
+	pageItems, meta := paging.Slice([]string{"a", "b"}, paging.Page{Limit: 10, Offset: 5})
+	if len(pageItems) != 0 {
+		t.Errorf("items = %v, want empty", pageItems)
+	}
+	if meta.NextCursor != "" || meta.Total != 2 || meta.Offset != 5 {
+		t.Errorf("Meta = %+v, want total 2, offset 5, no next cursor", meta)
+	}
+}
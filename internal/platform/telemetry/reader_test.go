@@ -0,0 +1,61 @@
+package telemetry
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// countingExporter is a metric exporter that counts Export calls.
+type countingExporter struct {
+	exports atomic.Int32
+}
+
+func (e *countingExporter) Temporality(k sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(k)
+}
+
+func (e *countingExporter) Aggregation(k sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(k)
+}
+
+func (e *countingExporter) Export(context.Context, *metricdata.ResourceMetrics) error {
+	e.exports.Add(1)
+	return nil
+}
+
+func (e *countingExporter) ForceFlush(context.Context) error { return nil }
+func (e *countingExporter) Shutdown(context.Context) error   { return nil }
+
+func TestNewPeriodicReader_UsesMetricInterval(t *testing.T) {
+	t.Parallel()
+
+	exp := &countingExporter{}
+	reader := newPeriodicReader(exp, newOptions([]Option{WithMetricInterval(20 * time.Millisecond)}))
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+
+	// The SDK default interval is 60s, so any export before the deadline
+	// proves the configured interval was applied.
+	deadline := time.Now().Add(2 * time.Second)
+	for exp.exports.Load() < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("exports = %d after 2s, want >= 2 with a 20ms interval", exp.exports.Load())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestWithMetricInterval_NonPositiveKeepsDefault(t *testing.T) {
+	t.Parallel()
+
+	for _, d := range []time.Duration{0, -time.Second} {
+		if got := newOptions([]Option{WithMetricInterval(d)}).metricInterval; got != 0 {
+			t.Errorf("WithMetricInterval(%v) interval = %v, want 0", d, got)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -32,6 +33,7 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/propagation"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
@@ -46,17 +48,73 @@ const (
 // Attribute keys for metric labels, as specified in ARCHITECTURE.md.
 const (
 	AttrHTTPMethod  = attribute.Key("http.method")
+	AttrHTTPRoute   = attribute.Key("http.route")
 	AttrHTTPStatus  = attribute.Key("http.status_code")
 	AttrPeerService = attribute.Key("peer.service")
 	AttrResult      = attribute.Key("result")
+	AttrEntity      = attribute.Key("entity")
+	AttrOperation   = attribute.Key("operation")
 )
 
+// Option configures InitTracer and InitMeter.
+type Option func(*options)
+
+type options struct {
+	exportTimeout  time.Duration // zero keeps the SDK defaults
+	metricInterval time.Duration // zero keeps the SDK default (60s)
+}
+
+// WithExportTimeout bounds each export: the OTLP exporter's request timeout,
+// the span batcher's export timeout, and the periodic metric reader's
+// collect-and-export timeout. Non-positive values keep the SDK defaults.
+func WithExportTimeout(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.exportTimeout = d
+		}
+	}
+}
+
+// WithMetricInterval sets how often InitMeter's periodic reader collects and
+// exports metrics. Non-positive values keep the SDK default.
+func WithMetricInterval(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.metricInterval = d
+		}
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
 // Metrics holds pre-registered OpenTelemetry metric instruments.
 type Metrics struct {
 	ServerRequestDuration metric.Float64Histogram
 	ServerRequestTotal    metric.Int64Counter
 	ClientRequestDuration metric.Float64Histogram
 	ClientRequestTotal    metric.Int64Counter
+	ClientRequestRetries  metric.Int64Counter
+	EntityOperationTotal  metric.Int64Counter
+}
+
+// RecordEntityOperation adds n to entity.operation.total with the given
+// entity and operation attributes. It is a no-op on a nil *Metrics, so callers
+// need not check whether telemetry is enabled. This method lets Metrics
+// satisfy the ports.EntityMetrics interface via structural typing.
+func (m *Metrics) RecordEntityOperation(ctx context.Context, entity, operation string, n int) {
+	if m == nil || n <= 0 {
+		return
+	}
+	m.EntityOperationTotal.Add(ctx, int64(n), metric.WithAttributes(
+		AttrEntity.String(entity),
+		AttrOperation.String(operation),
+	))
 }
 
 // InitTracer creates and registers a global TracerProvider.
@@ -67,19 +125,26 @@ type Metrics struct {
 // an error.
 //
 // The returned TracerProvider must be shut down when the application exits.
-func InitTracer(ctx context.Context, serviceName, exporter, endpoint string) (*sdktrace.TracerProvider, error) {
+func InitTracer(ctx context.Context, serviceName, exporter, endpoint string, opts ...Option) (*sdktrace.TracerProvider, error) {
+	o := newOptions(opts)
+
 	res, err := newResource(serviceName)
 	if err != nil {
 		return nil, fmt.Errorf("creating resource: %w", err)
 	}
 
-	spanExporter, err := newSpanExporter(ctx, exporter, endpoint)
+	spanExporter, err := newSpanExporter(ctx, exporter, endpoint, o.exportTimeout)
 	if err != nil {
 		return nil, fmt.Errorf("creating span exporter: %w", err)
 	}
 
+	var batchOpts []sdktrace.BatchSpanProcessorOption
+	if o.exportTimeout > 0 {
+		batchOpts = append(batchOpts, sdktrace.WithExportTimeout(o.exportTimeout))
+	}
+
 	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithBatcher(spanExporter, batchOpts...),
 		sdktrace.WithResource(res),
 	)
 
@@ -99,27 +164,43 @@ func InitTracer(ctx context.Context, serviceName, exporter, endpoint string) (*s
 // stdout exporter for development. Unrecognized values return an error.
 //
 // The returned MeterProvider must be shut down when the application exits.
-func InitMeter(ctx context.Context, serviceName, exporter, endpoint string) (*sdkmetric.MeterProvider, error) {
+func InitMeter(ctx context.Context, serviceName, exporter, endpoint string, opts ...Option) (*sdkmetric.MeterProvider, error) {
+	o := newOptions(opts)
+
 	res, err := newResource(serviceName)
 	if err != nil {
 		return nil, fmt.Errorf("creating resource: %w", err)
 	}
 
-	metricExporter, err := newMetricExporter(ctx, exporter, endpoint)
+	metricExporter, err := newMetricExporter(ctx, exporter, endpoint, o.exportTimeout)
 	if err != nil {
 		return nil, fmt.Errorf("creating metric exporter: %w", err)
 	}
 
-	mp := sdkmetric.NewMeterProvider(
-		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
-		sdkmetric.WithResource(res),
-	)
+	mp := NewMeterProvider(newPeriodicReader(metricExporter, o), res)
 
 	otel.SetMeterProvider(mp)
 
 	return mp, nil
 }
 
+// NewMeterProvider creates a MeterProvider that collects through reader.
+// Exemplars use the trace-based filter: measurements recorded with a context
+// carrying a sampled span (as the server middleware does) attach that span's
+// trace and span IDs to histogram data points such as
+// http.server.request.duration, linking metrics back to traces. If res is
+// nil, the SDK default resource is used.
+func NewMeterProvider(reader sdkmetric.Reader, res *resource.Resource) *sdkmetric.MeterProvider {
+	opts := []sdkmetric.Option{
+		sdkmetric.WithReader(reader),
+		sdkmetric.WithExemplarFilter(exemplar.TraceBasedFilter),
+	}
+	if res != nil {
+		opts = append(opts, sdkmetric.WithResource(res))
+	}
+	return sdkmetric.NewMeterProvider(opts...)
+}
+
 // NewMetrics creates and registers all metric instruments using the given MeterProvider.
 // The instrumentationName scopes the meter (typically the service name or module path).
 func NewMetrics(mp *sdkmetric.MeterProvider, instrumentationName string) (*Metrics, error) {
@@ -161,14 +242,47 @@ func NewMetrics(mp *sdkmetric.MeterProvider, instrumentationName string) (*Metri
 		return nil, fmt.Errorf("creating http.client.request.total: %w", err)
 	}
 
+	clientRetries, err := meter.Int64Counter(
+		"http.client.request.retries",
+		metric.WithDescription("Total number of retried outgoing HTTP request attempts"),
+		metric.WithUnit("{retry}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating http.client.request.retries: %w", err)
+	}
+
+	entityTotal, err := meter.Int64Counter(
+		"entity.operation.total",
+		metric.WithDescription("Total number of successful entity mutations"),
+		metric.WithUnit("{operation}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating entity.operation.total: %w", err)
+	}
+
 	return &Metrics{
 		ServerRequestDuration: serverDuration,
 		ServerRequestTotal:    serverTotal,
 		ClientRequestDuration: clientDuration,
 		ClientRequestTotal:    clientTotal,
+		ClientRequestRetries:  clientRetries,
+		EntityOperationTotal:  entityTotal,
 	}, nil
 }
 
+// newPeriodicReader creates the periodic reader for InitMeter, applying the
+// configured export timeout and interval.
+func newPeriodicReader(exporter sdkmetric.Exporter, o options) *sdkmetric.PeriodicReader {
+	var readerOpts []sdkmetric.PeriodicReaderOption
+	if o.exportTimeout > 0 {
+		readerOpts = append(readerOpts, sdkmetric.WithTimeout(o.exportTimeout))
+	}
+	if o.metricInterval > 0 {
+		readerOpts = append(readerOpts, sdkmetric.WithInterval(o.metricInterval))
+	}
+	return sdkmetric.NewPeriodicReader(exporter, readerOpts...)
+}
+
 func newResource(serviceName string) (*resource.Resource, error) {
 	return resource.Merge(
 		resource.Default(),
@@ -179,10 +293,10 @@ func newResource(serviceName string) (*resource.Resource, error) {
 	)
 }
 
-func newSpanExporter(ctx context.Context, exporter, endpoint string) (sdktrace.SpanExporter, error) {
+func newSpanExporter(ctx context.Context, exporter, endpoint string, timeout time.Duration) (sdktrace.SpanExporter, error) {
 	switch exporter {
 	case ExporterOTLP:
-		opts, err := otlpHTTPOptions(endpoint)
+		opts, err := otlpHTTPOptions(endpoint, timeout)
 		if err != nil {
 			return nil, err
 		}
@@ -198,10 +312,10 @@ func newSpanExporter(ctx context.Context, exporter, endpoint string) (sdktrace.S
 	}
 }
 
-func newMetricExporter(ctx context.Context, exporter, endpoint string) (sdkmetric.Exporter, error) {
+func newMetricExporter(ctx context.Context, exporter, endpoint string, timeout time.Duration) (sdkmetric.Exporter, error) {
 	switch exporter {
 	case ExporterOTLP:
-		opts, err := otlpHTTPOptions(endpoint)
+		opts, err := otlpHTTPOptions(endpoint, timeout)
 		if err != nil {
 			return nil, err
 		}
@@ -226,8 +340,8 @@ type otlpOption struct {
 // otlpHTTPOptions parses the endpoint URL and returns matched trace/metric
 // option pairs. The endpoint must be a valid URL with a host component
 // (e.g. "http://otel-collector:4318"). Any path component is preserved
-// via WithURLPath.
-func otlpHTTPOptions(endpoint string) ([]otlpOption, error) {
+// via WithURLPath. A positive timeout sets the exporters' request timeout.
+func otlpHTTPOptions(endpoint string, timeout time.Duration) ([]otlpOption, error) {
 	if endpoint == "" {
 		return nil, errors.New("otlp endpoint must not be empty")
 	}
@@ -261,5 +375,12 @@ func otlpHTTPOptions(endpoint string) ([]otlpOption, error) {
 		})
 	}
 
+	if timeout > 0 {
+		opts = append(opts, otlpOption{
+			trace:  otlptracehttp.WithTimeout(timeout),
+			metric: otlpmetrichttp.WithTimeout(timeout),
+		})
+	}
+
 	return opts, nil
 }
@@ -6,10 +6,18 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/jsamuelsen11/go-service-template-v2/internal/platform/telemetry"
+	"github.com/jsamuelsen11/go-service-template-v2/internal/ports"
 )
 
+// Compile-time interface check. Platform must not import ports in production
+// code, so the check lives in the test file.
+var _ ports.EntityMetrics = (*telemetry.Metrics)(nil)
+
 func TestInitTracer_Stdout(t *testing.T) {
 	ctx := context.Background()
 
@@ -178,4 +186,101 @@ func TestNewMetrics(t *testing.T) {
 	if metrics.ClientRequestTotal == nil {
 		t.Error("ClientRequestTotal is nil")
 	}
+	if metrics.ClientRequestRetries == nil {
+		t.Error("ClientRequestRetries is nil")
+	}
+}
+
+func TestMetrics_RecordEntityOperation(t *testing.T) {
+	ctx := context.Background()
+
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = mp.Shutdown(ctx) })
+
+	metrics, err := telemetry.NewMetrics(mp, "test")
+	if err != nil {
+		t.Fatalf("NewMetrics error = %v", err)
+	}
+
+	metrics.RecordEntityOperation(ctx, "todo", "create", 1)
+	metrics.RecordEntityOperation(ctx, "todo", "create", 2)
+	metrics.RecordEntityOperation(ctx, "project", "delete", 0)
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("Collect error = %v", err)
+	}
+
+	counts := make(map[string]int64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "entity.operation.total" {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if !ok {
+				t.Fatalf("entity.operation.total data = %T, want Sum[int64]", m.Data)
+			}
+			for _, dp := range sum.DataPoints {
+				entity, _ := dp.Attributes.Value(telemetry.AttrEntity)
+				op, _ := dp.Attributes.Value(telemetry.AttrOperation)
+				counts[entity.AsString()+"/"+op.AsString()] += dp.Value
+			}
+		}
+	}
+
+	if got := counts["todo/create"]; got != 3 {
+		t.Errorf(`entity.operation.total{entity="todo",operation="create"} = %d, want 3`, got)
+	}
+	if len(counts) != 1 {
+		t.Errorf("recorded %v, want only todo/create", counts)
+	}
+}
+
+func TestMetrics_RecordEntityOperation_NilSafe(t *testing.T) {
+	var metrics *telemetry.Metrics
+	metrics.RecordEntityOperation(context.Background(), "todo", "create", 1)
+}
+
+func TestNewMeterProvider_RecordsExemplarWithTraceID(t *testing.T) {
+	ctx := context.Background()
+
+	reader := sdkmetric.NewManualReader()
+	mp := telemetry.NewMeterProvider(reader, nil)
+	t.Cleanup(func() { _ = mp.Shutdown(ctx) })
+
+	tp := sdktrace.NewTracerProvider()
+	t.Cleanup(func() { _ = tp.Shutdown(ctx) })
+
+	metrics, err := telemetry.NewMetrics(mp, "test-service")
+	if err != nil {
+		t.Fatalf("NewMetrics error = %v", err)
+	}
+
+	spanCtx, span := tp.Tracer("test").Start(ctx, "request")
+	metrics.ServerRequestDuration.Record(spanCtx, 0.25)
+	span.End()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("Collect error = %v", err)
+	}
+	if len(rm.ScopeMetrics) == 0 || len(rm.ScopeMetrics[0].Metrics) == 0 {
+		t.Fatal("no metrics collected")
+	}
+
+	hist, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+	if !ok || len(hist.DataPoints) == 0 {
+		t.Fatalf("unexpected data: %#v", rm.ScopeMetrics[0].Metrics[0].Data)
+	}
+
+	exemplars := hist.DataPoints[0].Exemplars
+	if len(exemplars) == 0 {
+		t.Fatal("data point has no exemplars")
+	}
+	wantTraceID := span.SpanContext().TraceID()
+	if got := exemplars[0].TraceID; string(got) != string(wantTraceID[:]) {
+		t.Errorf("exemplar TraceID = %x, want %s", got, wantTraceID)
+	}
 }
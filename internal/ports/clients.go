@@ -20,6 +20,12 @@ type TodoClient interface {
 	// Returns domain.ErrNotFound if the todo does not exist.
 	GetTodo(ctx context.Context, id int64) (*todo.Todo, error)
 
+	// GetTodosByIDs returns the todos with the given IDs in a single call.
+	// Duplicate IDs are ignored and IDs that do not exist are omitted from
+	// the result. Returns domain.ErrValidation if more than todo.MaxFilterIDs
+	// distinct IDs are requested.
+	GetTodosByIDs(ctx context.Context, ids []int64) ([]todo.Todo, error)
+
 	// CreateTodo creates a new todo and returns the created entity.
 	// The todo's ProjectID field maps to the downstream group_id.
 	CreateTodo(ctx context.Context, todo *todo.Todo) (*todo.Todo, error)
@@ -32,9 +38,11 @@ type TodoClient interface {
 	// Returns domain.ErrNotFound if the todo does not exist.
 	DeleteTodo(ctx context.Context, id int64) error
 
-	// ListProjects returns all projects (mapped from downstream groups).
+	// ListProjects returns all projects (mapped from downstream groups),
+	// ordered by filter.Sort when set. Archived projects are always
+	// returned; filter.IncludeArchived is left to the caller.
 	// Returned projects do not include their todos.
-	ListProjects(ctx context.Context) ([]project.Project, error)
+	ListProjects(ctx context.Context, filter project.Filter) ([]project.Project, error)
 
 	// GetProject returns a single project by ID (mapped from downstream group).
 	// Returns domain.ErrNotFound if the project does not exist.
@@ -56,3 +64,11 @@ type TodoClient interface {
 	// Returns domain.ErrNotFound if the project does not exist.
 	GetProjectTodos(ctx context.Context, projectID int64, filter todo.Filter) ([]todo.Todo, error)
 }
+
+// TokenSource supplies bearer tokens for authenticating outbound requests.
+// Implementations cache tokens and refresh them before expiry; concurrent
+// callers must not trigger redundant refreshes.
+type TokenSource interface {
+	// Token returns a valid access token, refreshing it if necessary.
+	Token(ctx context.Context) (string, error)
+}
@@ -1,6 +1,9 @@
 package ports
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // HealthChecker is implemented by any component that can report its health.
 // Examples: downstream API clients, database connections, cache connections.
@@ -15,6 +18,33 @@ type HealthChecker interface {
 	HealthCheck(ctx context.Context) error
 }
 
+// Pinger is optionally implemented by a HealthChecker that can actively probe
+// its dependency over the network. HealthCheck is expected to be cheap and
+// passive; Ping trades a round trip for an up-to-date answer.
+type Pinger interface {
+	// Ping issues a lightweight request to the dependency and returns nil if
+	// it responded healthily, or an error describing the failure.
+	Ping(ctx context.Context) error
+}
+
+// CircuitBreaker is implemented by a downstream client whose circuit breaker
+// operators can override during incident recovery.
+type CircuitBreaker interface {
+	// Name returns the downstream identifier the breaker protects.
+	Name() string
+
+	// ForceOpen rejects every request to the downstream until ForceClose.
+	ForceOpen()
+
+	// ForceClose clears any ForceOpen override and resets the breaker to
+	// closed so traffic resumes immediately.
+	ForceClose()
+
+	// BreakerState reports the breaker state: "closed", "half-open",
+	// "open", or "forced-open".
+	BreakerState() string
+}
+
 // HealthRegistry manages registration and execution of health checkers.
 // Used by the readiness endpoint handler to determine service readiness.
 type HealthRegistry interface {
@@ -24,4 +54,25 @@ type HealthRegistry interface {
 	// CheckAll executes all registered health checks and returns results
 	// keyed by checker name. Nil values indicate healthy components.
 	CheckAll(ctx context.Context) map[string]error
+
+	// Snapshot returns the most recent result of each registered checker,
+	// in registration order, running any check whose cached result is
+	// stale first.
+	Snapshot(ctx context.Context) []HealthResult
+}
+
+// HealthResult is the outcome of one health checker's most recent run.
+type HealthResult struct {
+	// Name is the checker's Name.
+	Name string
+
+	// Err is the check's error, or nil if the checker was healthy.
+	Err error
+
+	// Duration is how long the check took.
+	Duration time.Duration
+
+	// CheckedAt is when the check ran. Results are reused until they are
+	// older than the registry's cache interval.
+	CheckedAt time.Time
 }
@@ -0,0 +1,12 @@
+package ports
+
+import "context"
+
+// EntityMetrics records counts of successful entity mutations. Implemented by
+// the telemetry platform package; called by application services after a
+// create, update, or delete succeeds.
+type EntityMetrics interface {
+	// RecordEntityOperation counts n successful operations of the given kind
+	// (e.g., "create") on the given entity (e.g., "todo").
+	RecordEntityOperation(ctx context.Context, entity, operation string, n int)
+}
@@ -12,8 +12,10 @@ import (
 // A project is a named collection of todos that maps to the downstream "group"
 // concept through the anti-corruption layer.
 type ProjectService interface {
-	// ListProjects returns all projects without populating their todos.
-	ListProjects(ctx context.Context) ([]project.Project, error)
+	// ListProjects returns the projects matching filter without populating
+	// their todos. Archived projects are excluded unless
+	// filter.IncludeArchived is set.
+	ListProjects(ctx context.Context, filter project.Filter) ([]project.Project, error)
 
 	// GetProject returns a single project by ID with its todos populated.
 	// Returns domain.ErrNotFound if the project does not exist.
@@ -29,6 +31,24 @@ type ProjectService interface {
 	// Returns domain.ErrNotFound if the project does not exist.
 	UpdateProject(ctx context.Context, id int64, project *project.Project) (*project.Project, error)
 
+	// PatchProject applies a partial update: patch receives a copy of the
+	// stored project (without todos) and returns the complete entity to
+	// save, which is validated as in UpdateProject. A patch that changes
+	// nothing returns the stored project without writing.
+	// Returns domain.ErrNotFound if the project does not exist.
+	PatchProject(ctx context.Context, id int64, patch func(*project.Project) *project.Project) (*project.Project, error)
+
+	// ArchiveProject marks a project archived, hiding it from default
+	// listings, and returns the updated entity. Archiving an archived
+	// project returns it unchanged.
+	// Returns domain.ErrNotFound if the project does not exist.
+	ArchiveProject(ctx context.Context, id int64) (*project.Project, error)
+
+	// UnarchiveProject clears a project's archived flag and returns the
+	// updated entity. Unarchiving an active project returns it unchanged.
+	// Returns domain.ErrNotFound if the project does not exist.
+	UnarchiveProject(ctx context.Context, id int64) (*project.Project, error)
+
 	// DeleteProject deletes a project. Todos in the project become ungrouped.
 	// Returns domain.ErrNotFound if the project does not exist.
 	DeleteProject(ctx context.Context, id int64) error
@@ -36,22 +56,99 @@ type ProjectService interface {
 	// AddTodo creates a new todo within the specified project.
 	// Returns domain.ErrNotFound if the project does not exist.
 	// Returns domain.ErrValidation if the todo fails validation.
+	// AddTodo, UpdateTodo, and RemoveTodo honor the appctx dry-run flag:
+	// validation and the read-only checks (project existence, ownership,
+	// capacity) run as usual, but no downstream writes are made.
 	AddTodo(ctx context.Context, projectID int64, todo *todo.Todo) (*todo.Todo, error)
 
 	// UpdateTodo updates an existing todo within the specified project.
 	// Returns domain.ErrNotFound if the project or todo does not exist.
 	UpdateTodo(ctx context.Context, projectID, todoID int64, todo *todo.Todo) (*todo.Todo, error)
 
+	// PatchTodo applies a partial update to a todo within the specified
+	// project: patch receives a copy of the stored todo and returns the
+	// complete entity to save. Only the project and that one todo are read.
+	// A patch that changes nothing returns the stored todo without writing.
+	// Honors the dry-run flag like UpdateTodo.
+	// Returns domain.ErrNotFound if the project or todo does not exist.
+	PatchTodo(ctx context.Context, projectID, todoID int64, patch func(*todo.Todo) *todo.Todo) (*todo.Todo, error)
+
 	// RemoveTodo deletes a todo from the specified project.
 	// Returns domain.ErrNotFound if the project or todo does not exist.
 	RemoveTodo(ctx context.Context, projectID, todoID int64) error
 
-	// BulkUpdateTodos updates multiple todos within the specified project
-	// concurrently. Uses partial success semantics: each update succeeds or
-	// fails independently. Returns a hard error only for request-level
-	// failures (project not found, validation). Individual update failures
-	// are collected in BulkUpdateResult.Errors.
+	// MoveTodo moves a todo from one project to another and returns the
+	// updated todo.
+	// Returns domain.ErrNotFound if either project does not exist or the todo
+	// does not belong to fromProjectID.
+	// Returns domain.ErrValidation if both project IDs are the same.
+	MoveTodo(ctx context.Context, fromProjectID, toProjectID, todoID int64) (*todo.Todo, error)
+
+	// BulkUpdateTodos updates multiple todos within the specified project as
+	// a unit: if any update fails, the completed updates are rolled back and
+	// the error is returned. On success BulkUpdateResult.Updated holds every
+	// todo and Errors is empty.
+	// Returns domain.ErrNotFound if the project does not exist or any todo
+	// does not belong to it.
+	// Returns domain.ErrValidation if updates is empty, too large, contains
+	// duplicate IDs, or holds an invalid todo.
 	BulkUpdateTodos(ctx context.Context, projectID int64, updates []TodoUpdate) (*BulkUpdateResult, error)
+
+	// BulkUpdateTodosEach updates multiple todos within the specified project
+	// independently: each update succeeds or fails on its own with no
+	// rollback, and failures are collected in BulkUpdateResult.Errors.
+	// Returns a hard error only for request-level failures, as in
+	// BulkUpdateTodos.
+	BulkUpdateTodosEach(ctx context.Context, projectID int64, updates []TodoUpdate) (*BulkUpdateResult, error)
+
+	// BulkRemoveTodos deletes multiple todos from the specified project as a
+	// unit: if any delete fails, the completed deletes are rolled back.
+	// Returns domain.ErrNotFound if the project does not exist or any todo
+	// does not belong to it.
+	// Returns domain.ErrValidation if todoIDs is empty, too large, or
+	// contains non-positive or duplicate IDs.
+	BulkRemoveTodos(ctx context.Context, projectID int64, todoIDs []int64) error
+
+	// BulkRemoveTodosEach deletes multiple todos from the specified project
+	// independently: each delete succeeds or fails on its own with no
+	// rollback, and failures are collected in BulkRemoveResult.Errors.
+	// Returns a hard error only for request-level failures, as in
+	// BulkRemoveTodos.
+	BulkRemoveTodosEach(ctx context.Context, projectID int64, todoIDs []int64) (*BulkRemoveResult, error)
+}
+
+// TodoService defines the service port for standalone todo operations that
+// are not scoped to a project. Implemented by the application layer; called
+// by inbound adapters (handlers).
+type TodoService interface {
+	// ListTodos returns todos matching the given filter criteria.
+	// Returns domain.ErrValidation if the filter is invalid.
+	ListTodos(ctx context.Context, filter todo.Filter) ([]todo.Todo, error)
+
+	// GetTodo returns a single todo by ID.
+	// Returns domain.ErrNotFound if the todo does not exist.
+	GetTodo(ctx context.Context, id int64) (*todo.Todo, error)
+
+	// CreateTodo creates a new todo and returns the created entity with
+	// server-assigned fields (ID, timestamps).
+	// Returns domain.ErrValidation if the todo fails validation.
+	CreateTodo(ctx context.Context, todo *todo.Todo) (*todo.Todo, error)
+
+	// UpdateTodo updates an existing todo and returns the updated entity.
+	// Returns domain.ErrNotFound if the todo does not exist.
+	// Returns domain.ErrValidation if the todo fails validation.
+	UpdateTodo(ctx context.Context, id int64, todo *todo.Todo) (*todo.Todo, error)
+
+	// PatchTodo applies a partial update: patch receives a copy of the stored
+	// todo and returns the complete entity to save, which is validated as in
+	// UpdateTodo. A patch that changes nothing returns the stored todo
+	// without writing.
+	// Returns domain.ErrNotFound if the todo does not exist.
+	PatchTodo(ctx context.Context, id int64, patch func(*todo.Todo) *todo.Todo) (*todo.Todo, error)
+
+	// DeleteTodo deletes a todo by ID.
+	// Returns domain.ErrNotFound if the todo does not exist.
+	DeleteTodo(ctx context.Context, id int64) error
 }
 
 // TodoUpdate pairs a todo ID with the updated todo data for bulk operations.
@@ -60,7 +157,8 @@ type TodoUpdate struct {
 	Todo   *todo.Todo
 }
 
-// BulkUpdateError records a single failed todo update within a bulk operation.
+// BulkUpdateError records a single failed todo within a bulk update or
+// remove operation.
 type BulkUpdateError struct {
 	TodoID int64
 	Err    error
@@ -72,3 +170,10 @@ type BulkUpdateResult struct {
 	Updated []todo.Todo
 	Errors  []BulkUpdateError
 }
+
+// BulkRemoveResult holds the outcomes of an independent bulk remove.
+// Removed lists the deleted todo IDs; Errors contains per-item failures.
+type BulkRemoveResult struct {
+	Removed []int64
+	Errors  []BulkUpdateError
+}
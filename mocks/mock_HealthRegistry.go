@@ -103,6 +103,54 @@ func (_c *MockHealthRegistry_Register_Call) RunAndReturn(run func(ports.HealthCh
 	return _c
 }
 
+// Snapshot provides a mock function with given fields: ctx
+func (_m *MockHealthRegistry) Snapshot(ctx context.Context) []ports.HealthResult {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 []ports.HealthResult
+	if rf, ok := ret.Get(0).(func(context.Context) []ports.HealthResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ports.HealthResult)
+		}
+	}
+
+	return r0
+}
+
+// MockHealthRegistry_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type MockHealthRegistry_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockHealthRegistry_Expecter) Snapshot(ctx interface{}) *MockHealthRegistry_Snapshot_Call {
+	return &MockHealthRegistry_Snapshot_Call{Call: _e.mock.On("Snapshot", ctx)}
+}
+
+func (_c *MockHealthRegistry_Snapshot_Call) Run(run func(ctx context.Context)) *MockHealthRegistry_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockHealthRegistry_Snapshot_Call) Return(_a0 []ports.HealthResult) *MockHealthRegistry_Snapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHealthRegistry_Snapshot_Call) RunAndReturn(run func(context.Context) []ports.HealthResult) *MockHealthRegistry_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockHealthRegistry creates a new instance of MockHealthRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockHealthRegistry(t interface {
@@ -86,6 +86,173 @@ func (_c *MockProjectService_AddTodo_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ArchiveProject provides a mock function with given fields: ctx, id
+func (_m *MockProjectService) ArchiveProject(ctx context.Context, id int64) (*project.Project, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveProject")
+	}
+
+	var r0 *project.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*project.Project, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *project.Project); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*project.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectService_ArchiveProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveProject'
+type MockProjectService_ArchiveProject_Call struct {
+	*mock.Call
+}
+
+// ArchiveProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockProjectService_Expecter) ArchiveProject(ctx interface{}, id interface{}) *MockProjectService_ArchiveProject_Call {
+	return &MockProjectService_ArchiveProject_Call{Call: _e.mock.On("ArchiveProject", ctx, id)}
+}
+
+func (_c *MockProjectService_ArchiveProject_Call) Run(run func(ctx context.Context, id int64)) *MockProjectService_ArchiveProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectService_ArchiveProject_Call) Return(_a0 *project.Project, _a1 error) *MockProjectService_ArchiveProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectService_ArchiveProject_Call) RunAndReturn(run func(context.Context, int64) (*project.Project, error)) *MockProjectService_ArchiveProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkRemoveTodos provides a mock function with given fields: ctx, projectID, todoIDs
+func (_m *MockProjectService) BulkRemoveTodos(ctx context.Context, projectID int64, todoIDs []int64) error {
+	ret := _m.Called(ctx, projectID, todoIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkRemoveTodos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) error); ok {
+		r0 = rf(ctx, projectID, todoIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectService_BulkRemoveTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkRemoveTodos'
+type MockProjectService_BulkRemoveTodos_Call struct {
+	*mock.Call
+}
+
+// BulkRemoveTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID int64
+//   - todoIDs []int64
+func (_e *MockProjectService_Expecter) BulkRemoveTodos(ctx interface{}, projectID interface{}, todoIDs interface{}) *MockProjectService_BulkRemoveTodos_Call {
+	return &MockProjectService_BulkRemoveTodos_Call{Call: _e.mock.On("BulkRemoveTodos", ctx, projectID, todoIDs)}
+}
+
+func (_c *MockProjectService_BulkRemoveTodos_Call) Run(run func(ctx context.Context, projectID int64, todoIDs []int64)) *MockProjectService_BulkRemoveTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectService_BulkRemoveTodos_Call) Return(_a0 error) *MockProjectService_BulkRemoveTodos_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectService_BulkRemoveTodos_Call) RunAndReturn(run func(context.Context, int64, []int64) error) *MockProjectService_BulkRemoveTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkRemoveTodosEach provides a mock function with given fields: ctx, projectID, todoIDs
+func (_m *MockProjectService) BulkRemoveTodosEach(ctx context.Context, projectID int64, todoIDs []int64) (*ports.BulkRemoveResult, error) {
+	ret := _m.Called(ctx, projectID, todoIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkRemoveTodosEach")
+	}
+
+	var r0 *ports.BulkRemoveResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) (*ports.BulkRemoveResult, error)); ok {
+		return rf(ctx, projectID, todoIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) *ports.BulkRemoveResult); ok {
+		r0 = rf(ctx, projectID, todoIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ports.BulkRemoveResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, projectID, todoIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectService_BulkRemoveTodosEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkRemoveTodosEach'
+type MockProjectService_BulkRemoveTodosEach_Call struct {
+	*mock.Call
+}
+
+// BulkRemoveTodosEach is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID int64
+//   - todoIDs []int64
+func (_e *MockProjectService_Expecter) BulkRemoveTodosEach(ctx interface{}, projectID interface{}, todoIDs interface{}) *MockProjectService_BulkRemoveTodosEach_Call {
+	return &MockProjectService_BulkRemoveTodosEach_Call{Call: _e.mock.On("BulkRemoveTodosEach", ctx, projectID, todoIDs)}
+}
+
+func (_c *MockProjectService_BulkRemoveTodosEach_Call) Run(run func(ctx context.Context, projectID int64, todoIDs []int64)) *MockProjectService_BulkRemoveTodosEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectService_BulkRemoveTodosEach_Call) Return(_a0 *ports.BulkRemoveResult, _a1 error) *MockProjectService_BulkRemoveTodosEach_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectService_BulkRemoveTodosEach_Call) RunAndReturn(run func(context.Context, int64, []int64) (*ports.BulkRemoveResult, error)) *MockProjectService_BulkRemoveTodosEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BulkUpdateTodos provides a mock function with given fields: ctx, projectID, updates
 func (_m *MockProjectService) BulkUpdateTodos(ctx context.Context, projectID int64, updates []ports.TodoUpdate) (*ports.BulkUpdateResult, error) {
 	ret := _m.Called(ctx, projectID, updates)
@@ -146,6 +313,66 @@ func (_c *MockProjectService_BulkUpdateTodos_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// BulkUpdateTodosEach provides a mock function with given fields: ctx, projectID, updates
+func (_m *MockProjectService) BulkUpdateTodosEach(ctx context.Context, projectID int64, updates []ports.TodoUpdate) (*ports.BulkUpdateResult, error) {
+	ret := _m.Called(ctx, projectID, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateTodosEach")
+	}
+
+	var r0 *ports.BulkUpdateResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []ports.TodoUpdate) (*ports.BulkUpdateResult, error)); ok {
+		return rf(ctx, projectID, updates)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []ports.TodoUpdate) *ports.BulkUpdateResult); ok {
+		r0 = rf(ctx, projectID, updates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ports.BulkUpdateResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []ports.TodoUpdate) error); ok {
+		r1 = rf(ctx, projectID, updates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectService_BulkUpdateTodosEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateTodosEach'
+type MockProjectService_BulkUpdateTodosEach_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateTodosEach is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID int64
+//   - updates []ports.TodoUpdate
+func (_e *MockProjectService_Expecter) BulkUpdateTodosEach(ctx interface{}, projectID interface{}, updates interface{}) *MockProjectService_BulkUpdateTodosEach_Call {
+	return &MockProjectService_BulkUpdateTodosEach_Call{Call: _e.mock.On("BulkUpdateTodosEach", ctx, projectID, updates)}
+}
+
+func (_c *MockProjectService_BulkUpdateTodosEach_Call) Run(run func(ctx context.Context, projectID int64, updates []ports.TodoUpdate)) *MockProjectService_BulkUpdateTodosEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]ports.TodoUpdate))
+	})
+	return _c
+}
+
+func (_c *MockProjectService_BulkUpdateTodosEach_Call) Return(_a0 *ports.BulkUpdateResult, _a1 error) *MockProjectService_BulkUpdateTodosEach_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectService_BulkUpdateTodosEach_Call) RunAndReturn(run func(context.Context, int64, []ports.TodoUpdate) (*ports.BulkUpdateResult, error)) *MockProjectService_BulkUpdateTodosEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateProject provides a mock function with given fields: ctx, _a1
 func (_m *MockProjectService) CreateProject(ctx context.Context, _a1 *project.Project) (*project.Project, error) {
 	ret := _m.Called(ctx, _a1)
@@ -311,9 +538,9 @@ func (_c *MockProjectService_GetProject_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// ListProjects provides a mock function with given fields: ctx
-func (_m *MockProjectService) ListProjects(ctx context.Context) ([]project.Project, error) {
-	ret := _m.Called(ctx)
+// ListProjects provides a mock function with given fields: ctx, filter
+func (_m *MockProjectService) ListProjects(ctx context.Context, filter project.Filter) ([]project.Project, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListProjects")
@@ -321,19 +548,19 @@ func (_m *MockProjectService) ListProjects(ctx context.Context) ([]project.Proje
 
 	var r0 []project.Project
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]project.Project, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, project.Filter) ([]project.Project, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []project.Project); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, project.Filter) []project.Project); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]project.Project)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, project.Filter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -348,13 +575,14 @@ type MockProjectService_ListProjects_Call struct {
 
 // ListProjects is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockProjectService_Expecter) ListProjects(ctx interface{}) *MockProjectService_ListProjects_Call {
-	return &MockProjectService_ListProjects_Call{Call: _e.mock.On("ListProjects", ctx)}
+//   - filter project.Filter
+func (_e *MockProjectService_Expecter) ListProjects(ctx interface{}, filter interface{}) *MockProjectService_ListProjects_Call {
+	return &MockProjectService_ListProjects_Call{Call: _e.mock.On("ListProjects", ctx, filter)}
 }
 
-func (_c *MockProjectService_ListProjects_Call) Run(run func(ctx context.Context)) *MockProjectService_ListProjects_Call {
+func (_c *MockProjectService_ListProjects_Call) Run(run func(ctx context.Context, filter project.Filter)) *MockProjectService_ListProjects_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(project.Filter))
 	})
 	return _c
 }
@@ -364,7 +592,189 @@ func (_c *MockProjectService_ListProjects_Call) Return(_a0 []project.Project, _a
 	return _c
 }
 
-func (_c *MockProjectService_ListProjects_Call) RunAndReturn(run func(context.Context) ([]project.Project, error)) *MockProjectService_ListProjects_Call {
+func (_c *MockProjectService_ListProjects_Call) RunAndReturn(run func(context.Context, project.Filter) ([]project.Project, error)) *MockProjectService_ListProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveTodo provides a mock function with given fields: ctx, fromProjectID, toProjectID, todoID
+func (_m *MockProjectService) MoveTodo(ctx context.Context, fromProjectID int64, toProjectID int64, todoID int64) (*todo.Todo, error) {
+	ret := _m.Called(ctx, fromProjectID, toProjectID, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTodo")
+	}
+
+	var r0 *todo.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (*todo.Todo, error)); ok {
+		return rf(ctx, fromProjectID, toProjectID, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) *todo.Todo); ok {
+		r0 = rf(ctx, fromProjectID, toProjectID, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*todo.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(ctx, fromProjectID, toProjectID, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectService_MoveTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTodo'
+type MockProjectService_MoveTodo_Call struct {
+	*mock.Call
+}
+
+// MoveTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromProjectID int64
+//   - toProjectID int64
+//   - todoID int64
+func (_e *MockProjectService_Expecter) MoveTodo(ctx interface{}, fromProjectID interface{}, toProjectID interface{}, todoID interface{}) *MockProjectService_MoveTodo_Call {
+	return &MockProjectService_MoveTodo_Call{Call: _e.mock.On("MoveTodo", ctx, fromProjectID, toProjectID, todoID)}
+}
+
+func (_c *MockProjectService_MoveTodo_Call) Run(run func(ctx context.Context, fromProjectID int64, toProjectID int64, todoID int64)) *MockProjectService_MoveTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectService_MoveTodo_Call) Return(_a0 *todo.Todo, _a1 error) *MockProjectService_MoveTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectService_MoveTodo_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (*todo.Todo, error)) *MockProjectService_MoveTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchProject provides a mock function with given fields: ctx, id, patch
+func (_m *MockProjectService) PatchProject(ctx context.Context, id int64, patch func(*project.Project) *project.Project) (*project.Project, error) {
+	ret := _m.Called(ctx, id, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchProject")
+	}
+
+	var r0 *project.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, func(*project.Project) *project.Project) (*project.Project, error)); ok {
+		return rf(ctx, id, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, func(*project.Project) *project.Project) *project.Project); ok {
+		r0 = rf(ctx, id, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*project.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, func(*project.Project) *project.Project) error); ok {
+		r1 = rf(ctx, id, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectService_PatchProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchProject'
+type MockProjectService_PatchProject_Call struct {
+	*mock.Call
+}
+
+// PatchProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - patch func(*project.Project) *project.Project
+func (_e *MockProjectService_Expecter) PatchProject(ctx interface{}, id interface{}, patch interface{}) *MockProjectService_PatchProject_Call {
+	return &MockProjectService_PatchProject_Call{Call: _e.mock.On("PatchProject", ctx, id, patch)}
+}
+
+func (_c *MockProjectService_PatchProject_Call) Run(run func(ctx context.Context, id int64, patch func(*project.Project) *project.Project)) *MockProjectService_PatchProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(func(*project.Project) *project.Project))
+	})
+	return _c
+}
+
+func (_c *MockProjectService_PatchProject_Call) Return(_a0 *project.Project, _a1 error) *MockProjectService_PatchProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectService_PatchProject_Call) RunAndReturn(run func(context.Context, int64, func(*project.Project) *project.Project) (*project.Project, error)) *MockProjectService_PatchProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchTodo provides a mock function with given fields: ctx, projectID, todoID, patch
+func (_m *MockProjectService) PatchTodo(ctx context.Context, projectID int64, todoID int64, patch func(*todo.Todo) *todo.Todo) (*todo.Todo, error) {
+	ret := _m.Called(ctx, projectID, todoID, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchTodo")
+	}
+
+	var r0 *todo.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, func(*todo.Todo) *todo.Todo) (*todo.Todo, error)); ok {
+		return rf(ctx, projectID, todoID, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, func(*todo.Todo) *todo.Todo) *todo.Todo); ok {
+		r0 = rf(ctx, projectID, todoID, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*todo.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, func(*todo.Todo) *todo.Todo) error); ok {
+		r1 = rf(ctx, projectID, todoID, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectService_PatchTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchTodo'
+type MockProjectService_PatchTodo_Call struct {
+	*mock.Call
+}
+
+// PatchTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID int64
+//   - todoID int64
+//   - patch func(*todo.Todo) *todo.Todo
+func (_e *MockProjectService_Expecter) PatchTodo(ctx interface{}, projectID interface{}, todoID interface{}, patch interface{}) *MockProjectService_PatchTodo_Call {
+	return &MockProjectService_PatchTodo_Call{Call: _e.mock.On("PatchTodo", ctx, projectID, todoID, patch)}
+}
+
+func (_c *MockProjectService_PatchTodo_Call) Run(run func(ctx context.Context, projectID int64, todoID int64, patch func(*todo.Todo) *todo.Todo)) *MockProjectService_PatchTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(func(*todo.Todo) *todo.Todo))
+	})
+	return _c
+}
+
+func (_c *MockProjectService_PatchTodo_Call) Return(_a0 *todo.Todo, _a1 error) *MockProjectService_PatchTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectService_PatchTodo_Call) RunAndReturn(run func(context.Context, int64, int64, func(*todo.Todo) *todo.Todo) (*todo.Todo, error)) *MockProjectService_PatchTodo_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -417,6 +827,65 @@ func (_c *MockProjectService_RemoveTodo_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// UnarchiveProject provides a mock function with given fields: ctx, id
+func (_m *MockProjectService) UnarchiveProject(ctx context.Context, id int64) (*project.Project, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnarchiveProject")
+	}
+
+	var r0 *project.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*project.Project, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *project.Project); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*project.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectService_UnarchiveProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnarchiveProject'
+type MockProjectService_UnarchiveProject_Call struct {
+	*mock.Call
+}
+
+// UnarchiveProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockProjectService_Expecter) UnarchiveProject(ctx interface{}, id interface{}) *MockProjectService_UnarchiveProject_Call {
+	return &MockProjectService_UnarchiveProject_Call{Call: _e.mock.On("UnarchiveProject", ctx, id)}
+}
+
+func (_c *MockProjectService_UnarchiveProject_Call) Run(run func(ctx context.Context, id int64)) *MockProjectService_UnarchiveProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectService_UnarchiveProject_Call) Return(_a0 *project.Project, _a1 error) *MockProjectService_UnarchiveProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectService_UnarchiveProject_Call) RunAndReturn(run func(context.Context, int64) (*project.Project, error)) *MockProjectService_UnarchiveProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateProject provides a mock function with given fields: ctx, id, _a2
 func (_m *MockProjectService) UpdateProject(ctx context.Context, id int64, _a2 *project.Project) (*project.Project, error) {
 	ret := _m.Called(ctx, id, _a2)
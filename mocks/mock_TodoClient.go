@@ -415,9 +415,68 @@ func (_c *MockTodoClient_GetTodo_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
-// ListProjects provides a mock function with given fields: ctx
-func (_m *MockTodoClient) ListProjects(ctx context.Context) ([]project.Project, error) {
-	ret := _m.Called(ctx)
+// GetTodosByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockTodoClient) GetTodosByIDs(ctx context.Context, ids []int64) ([]todo.Todo, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodosByIDs")
+	}
+
+	var r0 []todo.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]todo.Todo, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []todo.Todo); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoClient_GetTodosByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodosByIDs'
+type MockTodoClient_GetTodosByIDs_Call struct {
+	*mock.Call
+}
+
+// GetTodosByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockTodoClient_Expecter) GetTodosByIDs(ctx interface{}, ids interface{}) *MockTodoClient_GetTodosByIDs_Call {
+	return &MockTodoClient_GetTodosByIDs_Call{Call: _e.mock.On("GetTodosByIDs", ctx, ids)}
+}
+
+func (_c *MockTodoClient_GetTodosByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockTodoClient_GetTodosByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockTodoClient_GetTodosByIDs_Call) Return(_a0 []todo.Todo, _a1 error) *MockTodoClient_GetTodosByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoClient_GetTodosByIDs_Call) RunAndReturn(run func(context.Context, []int64) ([]todo.Todo, error)) *MockTodoClient_GetTodosByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProjects provides a mock function with given fields: ctx, filter
+func (_m *MockTodoClient) ListProjects(ctx context.Context, filter project.Filter) ([]project.Project, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListProjects")
@@ -425,19 +484,19 @@ func (_m *MockTodoClient) ListProjects(ctx context.Context) ([]project.Project,
 
 	var r0 []project.Project
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]project.Project, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, project.Filter) ([]project.Project, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []project.Project); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, project.Filter) []project.Project); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]project.Project)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, project.Filter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -452,13 +511,14 @@ type MockTodoClient_ListProjects_Call struct {
 
 // ListProjects is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockTodoClient_Expecter) ListProjects(ctx interface{}) *MockTodoClient_ListProjects_Call {
-	return &MockTodoClient_ListProjects_Call{Call: _e.mock.On("ListProjects", ctx)}
+//   - filter project.Filter
+func (_e *MockTodoClient_Expecter) ListProjects(ctx interface{}, filter interface{}) *MockTodoClient_ListProjects_Call {
+	return &MockTodoClient_ListProjects_Call{Call: _e.mock.On("ListProjects", ctx, filter)}
 }
 
-func (_c *MockTodoClient_ListProjects_Call) Run(run func(ctx context.Context)) *MockTodoClient_ListProjects_Call {
+func (_c *MockTodoClient_ListProjects_Call) Run(run func(ctx context.Context, filter project.Filter)) *MockTodoClient_ListProjects_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(project.Filter))
 	})
 	return _c
 }
@@ -468,7 +528,7 @@ func (_c *MockTodoClient_ListProjects_Call) Return(_a0 []project.Project, _a1 er
 	return _c
 }
 
-func (_c *MockTodoClient_ListProjects_Call) RunAndReturn(run func(context.Context) ([]project.Project, error)) *MockTodoClient_ListProjects_Call {
+func (_c *MockTodoClient_ListProjects_Call) RunAndReturn(run func(context.Context, project.Filter) ([]project.Project, error)) *MockTodoClient_ListProjects_Call {
 	_c.Call.Return(run)
 	return _c
 }